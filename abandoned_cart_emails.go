@@ -0,0 +1,129 @@
+package bigcommerce
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// AbandonedCartEmailSettings are the abandoned cart notification settings of a channel
+type AbandonedCartEmailSettings struct {
+	// EnableNotification sends the abandoned cart emails to shoppers
+	EnableNotification bool `json:"enable_notification"`
+	// NotifyMerchant sends the merchant a notification when a cart is converted after an email
+	NotifyMerchant bool `json:"notify_merchant"`
+	// MinimumCartValue is the cart total below which no emails are sent
+	MinimumCartValue float64 `json:"minimum_cart_value"`
+	// OnlyToCustomers only emails shoppers with an account
+	OnlyToCustomers bool `json:"only_to_customers"`
+	// DeleteCartsAfterDays deletes abandoned carts after this many days, 0 keeps them
+	DeleteCartsAfterDays int `json:"delete_carts_after_days"`
+}
+
+// AbandonedCartEmailTemplate is the content of an abandoned cart email, in Handlebars
+type AbandonedCartEmailTemplate struct {
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// AbandonedCartEmail is one email of the abandoned cart sequence
+type AbandonedCartEmail struct {
+	ID       int64 `json:"id,omitempty"`
+	IsActive bool  `json:"is_active"`
+	// NotifyAtMinutes is the time after the cart was abandoned the email is sent
+	NotifyAtMinutes int `json:"notify_at_minutes"`
+	// CouponCode is a coupon offered in the email, empty for none
+	CouponCode string                     `json:"coupon_code,omitempty"`
+	Template   AbandonedCartEmailTemplate `json:"template"`
+}
+
+// GetAbandonedCartEmailSettings returns the abandoned cart email settings of a channel, 0 for the default channel
+func (bc *Client) GetAbandonedCartEmailSettings(channelID int64) (*AbandonedCartEmailSettings, error) {
+	var settingsResponse struct {
+		Data AbandonedCartEmailSettings `json:"data"`
+	}
+	err := bc.getJSON(abandonedCartSettingsURL(channelID), &settingsResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &settingsResponse.Data, nil
+}
+
+// UpdateAbandonedCartEmailSettings saves the abandoned cart email settings of a channel, 0 for the default channel
+func (bc *Client) UpdateAbandonedCartEmailSettings(channelID int64, settings AbandonedCartEmailSettings) (*AbandonedCartEmailSettings, error) {
+	var settingsResponse struct {
+		Data AbandonedCartEmailSettings `json:"data"`
+	}
+	err := bc.sendJSON(http.MethodPut, abandonedCartSettingsURL(channelID), settings, &settingsResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &settingsResponse.Data, nil
+}
+
+// GetAbandonedCartEmails returns the emails of the abandoned cart sequence
+func (bc *Client) GetAbandonedCartEmails() ([]AbandonedCartEmail, error) {
+	var emailsResponse struct {
+		Data []AbandonedCartEmail `json:"data"`
+	}
+	err := bc.getJSON("/v3/marketing/abandoned-cart-emails", &emailsResponse)
+	if err != nil {
+		if err == ErrNoContent {
+			return []AbandonedCartEmail{}, nil
+		}
+		return nil, err
+	}
+	return emailsResponse.Data, nil
+}
+
+// GetAbandonedCartEmail returns an abandoned cart email by ID
+func (bc *Client) GetAbandonedCartEmail(emailID int64) (*AbandonedCartEmail, error) {
+	var emailResponse struct {
+		Data AbandonedCartEmail `json:"data"`
+	}
+	err := bc.getJSON(newURL("/v3/marketing/abandoned-cart-emails").ID(emailID).String(), &emailResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &emailResponse.Data, nil
+}
+
+// CreateAbandonedCartEmail adds an email to the abandoned cart sequence
+func (bc *Client) CreateAbandonedCartEmail(email AbandonedCartEmail) (*AbandonedCartEmail, error) {
+	email.ID = 0
+	var emailResponse struct {
+		Data AbandonedCartEmail `json:"data"`
+	}
+	err := bc.sendJSON(http.MethodPost, "/v3/marketing/abandoned-cart-emails", email, &emailResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &emailResponse.Data, nil
+}
+
+// UpdateAbandonedCartEmail updates an abandoned cart email, email ID is required
+func (bc *Client) UpdateAbandonedCartEmail(email AbandonedCartEmail) (*AbandonedCartEmail, error) {
+	if email.ID == 0 {
+		return nil, fmt.Errorf("abandoned cart email ID is required")
+	}
+	var emailResponse struct {
+		Data AbandonedCartEmail `json:"data"`
+	}
+	err := bc.sendJSON(http.MethodPut, newURL("/v3/marketing/abandoned-cart-emails").ID(email.ID).String(), email, &emailResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &emailResponse.Data, nil
+}
+
+// DeleteAbandonedCartEmail removes an email from the abandoned cart sequence
+func (bc *Client) DeleteAbandonedCartEmail(emailID int64) error {
+	return bc.sendJSON(http.MethodDelete, newURL("/v3/marketing/abandoned-cart-emails").ID(emailID).String(), nil, nil)
+}
+
+func abandonedCartSettingsURL(channelID int64) string {
+	u := newURL("/v3/marketing/abandoned-cart-emails/settings")
+	if channelID != 0 {
+		u.Int("channel_id", channelID)
+	}
+	return u.String()
+}
@@ -2,7 +2,6 @@ package bigcommerce
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
@@ -56,31 +55,11 @@ func (bc *Client) GetAddresses(customerID int64) ([]Address, error) {
 // customerID is bigcommerce customer id
 // page: the page number to download
 func (bc *Client) GetAddressPage(customerID int64, page int) ([]Address, bool, error) {
-	url := "/v3/customers/addresses?customer_id:in=" + strconv.FormatInt(customerID, 10) + "&page=" + strconv.Itoa(page)
-
-	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
-	if err != nil {
-		return nil, false, err
-	}
-
-	defer res.Body.Close()
-	body, err := processBody(res)
-	if err != nil {
-		return nil, false, err
-	}
-
-	var pp struct {
-		Data []Address `json:"data"`
-		Meta struct {
-			Pagination Pagination `json:"pagination"`
-		} `json:"meta"`
-	}
-	err = json.Unmarshal(body, &pp)
+	p, err := ListPage[Address](bc, newURL("/v3/customers/addresses").Int("customer_id:in", customerID).Int("page", int64(page)).String())
 	if err != nil {
 		return nil, false, err
 	}
-	return pp.Data, pp.Meta.Pagination.CurrentPage < pp.Meta.Pagination.TotalPages, nil
+	return p.Data, p.More(), nil
 }
 
 // CreateAddress creates a new address for a customer from given data, ignoring ID (duplicating address)
@@ -88,10 +67,10 @@ func (bc *Client) CreateAddress(customerID int64, address *Address) (*Address, e
 	url := "/v3/customers/addresses"
 	// extra safety feature so we don't edit other customers' address
 	address.CustomerID = customerID
-	addressJSON, _ := json.Marshal([]Address{*address})
+	addressJSON, _ := bc.marshal([]Address{*address})
 	//	log.Printf("addressJSON: %s", string(addressJSON))
 	req := bc.getAPIRequest(http.MethodPost, url, bytes.NewReader(addressJSON))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +82,7 @@ func (bc *Client) CreateAddress(customerID int64, address *Address) (*Address, e
 	if err != nil {
 		return nil, err
 	}
-	err = json.Unmarshal(body, &addr)
+	err = bc.unmarshal(body, &addr)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing body: %s %s", err, string(body))
 	}
@@ -121,10 +100,10 @@ func (bc *Client) UpdateAddress(customerID int64, address *Address) (*Address, e
 	if address.ID == 0 {
 		return nil, fmt.Errorf("address ID is required")
 	}
-	addressJSON, _ := json.Marshal([]Address{*address})
+	addressJSON, _ := bc.marshal([]Address{*address})
 	//	log.Printf("addressJSON: %s", string(addressJSON))
 	req := bc.getAPIRequest(http.MethodPut, url, bytes.NewReader(addressJSON))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -136,7 +115,7 @@ func (bc *Client) UpdateAddress(customerID int64, address *Address) (*Address, e
 	if err != nil {
 		return nil, err
 	}
-	err = json.Unmarshal(body, &addr)
+	err = bc.unmarshal(body, &addr)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing body: %s %s", err, string(body))
 	}
@@ -150,7 +129,7 @@ func (bc *Client) UpdateAddress(customerID int64, address *Address) (*Address, e
 func (bc *Client) DeleteAddress(customerID, addressID int64) error {
 	url := "/v3/customers/addresses?id:in=" + strconv.FormatInt(addressID, 10)
 	req := bc.getAPIRequest(http.MethodDelete, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return err
 	}
@@ -164,7 +143,7 @@ func (bc *Client) DeleteAddress(customerID, addressID int64) error {
 		return err
 	}
 	var addr Address
-	err = json.Unmarshal(body, &addr)
+	err = bc.unmarshal(body, &addr)
 	if err != nil {
 		log.Printf("error parsing body: %s %s", err, string(body))
 		return err
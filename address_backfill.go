@@ -0,0 +1,212 @@
+package bigcommerce
+
+import (
+	"strconv"
+	"strings"
+)
+
+// addressBatchSize is the number of addresses BigCommerce accepts in one batch update
+const addressBatchSize = 10
+
+// Country is a country of the BigCommerce reference data
+type Country struct {
+	ID          int64  `json:"id"`
+	Country     string `json:"country"`
+	CountryIso2 string `json:"country_iso2"`
+	CountryIso3 string `json:"country_iso3"`
+}
+
+// CountryState is a state or province of a country in the BigCommerce reference data
+type CountryState struct {
+	ID                int64  `json:"id"`
+	State             string `json:"state"`
+	StateAbbreviation string `json:"state_abbreviation"`
+	CountryID         int64  `json:"country_id"`
+}
+
+// GetCountries returns all countries BigCommerce knows
+func (bc *Client) GetCountries() ([]Country, error) {
+	cs := []Country{}
+	for page := 1; ; page++ {
+		var csp []Country
+		err := bc.getJSON(newURL("/v2/countries").Int("page", int64(page)).Int("limit", v2PageLimit).String(), &csp)
+		if err == ErrNoContent {
+			return cs, nil
+		}
+		if err != nil {
+			return cs, err
+		}
+		cs = append(cs, csp...)
+		if len(csp) < v2PageLimit {
+			return cs, nil
+		}
+	}
+}
+
+// GetCountryStates returns the states of a country, empty for countries without states
+func (bc *Client) GetCountryStates(countryID int64) ([]CountryState, error) {
+	ss := []CountryState{}
+	for page := 1; ; page++ {
+		var ssp []CountryState
+		err := bc.getJSON(newURL("/v2/countries").ID(countryID).Segment("states").Int("page", int64(page)).Int("limit", v2PageLimit).String(), &ssp)
+		if err == ErrNoContent {
+			return ss, nil
+		}
+		if err != nil {
+			return ss, err
+		}
+		ss = append(ss, ssp...)
+		if len(ssp) < v2PageLimit {
+			return ss, nil
+		}
+	}
+}
+
+// AddressFix is a change BackfillAddressCountries made (or would make) to a customer address
+type AddressFix struct {
+	Address         Address
+	CountryCode     string
+	StateOrProvince string
+	// Problem is set when the address couldn't be fixed, e.g. an unknown country name, it isn't updated then
+	Problem string
+}
+
+// BackfillProgress is reported after every page of addresses
+type BackfillProgress struct {
+	Scanned    int
+	Fixed      int
+	Unresolved int
+}
+
+// BackfillOptions configures BackfillAddressCountries
+type BackfillOptions struct {
+	// DryRun only reports the fixes, nothing is updated
+	DryRun bool
+	// Progress, when set, is called after every page of addresses
+	Progress func(p BackfillProgress)
+}
+
+// BackfillAddressCountries scans all customer addresses for a missing country_code or a state given by its
+// abbreviation, and fills in the ISO2 code and full state name from the countries reference data.
+// It returns the fixes, including the addresses it couldn't resolve
+func (bc *Client) BackfillAddressCountries(opts BackfillOptions) ([]AddressFix, error) {
+	countries, err := bc.GetCountries()
+	if err != nil {
+		return nil, err
+	}
+	r := &addressResolver{client: bc, countries: countries, states: map[int64][]CountryState{}}
+	fixes := []AddressFix{}
+	progress := BackfillProgress{}
+	for page := 1; ; page++ {
+		addresses, more, err := bc.getAllAddressesPage(page)
+		if err != nil && err != ErrNoContent {
+			return fixes, err
+		}
+		updates := []map[string]interface{}{}
+		for _, a := range addresses {
+			progress.Scanned++
+			fix, err := r.resolve(a)
+			if err != nil {
+				return fixes, err
+			}
+			if fix == nil {
+				continue
+			}
+			fixes = append(fixes, *fix)
+			if fix.Problem != "" {
+				progress.Unresolved++
+				continue
+			}
+			progress.Fixed++
+			updates = append(updates, map[string]interface{}{
+				"id":                a.ID,
+				"country_code":      fix.CountryCode,
+				"state_or_province": fix.StateOrProvince,
+			})
+		}
+		if !opts.DryRun {
+			for start := 0; start < len(updates); start += addressBatchSize {
+				end := start + addressBatchSize
+				if end > len(updates) {
+					end = len(updates)
+				}
+				err = bc.putJSON("/v3/customers/addresses", updates[start:end])
+				if err != nil {
+					return fixes, err
+				}
+			}
+		}
+		if opts.Progress != nil {
+			opts.Progress(progress)
+		}
+		if !more {
+			return fixes, nil
+		}
+	}
+}
+
+// getAllAddressesPage returns a page of the addresses of all customers
+func (bc *Client) getAllAddressesPage(page int) ([]Address, bool, error) {
+	p, err := ListPage[Address](bc, newURL("/v3/customers/addresses").Int("page", int64(page)).Int("limit", 250).String())
+	if err != nil {
+		return nil, false, err
+	}
+	return p.Data, p.More(), nil
+}
+
+// addressResolver looks up countries and, lazily, their states
+type addressResolver struct {
+	client    *Client
+	countries []Country
+	states    map[int64][]CountryState
+}
+
+// resolve returns the fix for an address, nil if it needs none
+func (r *addressResolver) resolve(a Address) (*AddressFix, error) {
+	var country *Country
+	for i, c := range r.countries {
+		if a.CountryCode != "" && strings.EqualFold(c.CountryIso2, a.CountryCode) ||
+			a.CountryCode == "" && (strings.EqualFold(c.Country, strings.TrimSpace(a.Country)) || strings.EqualFold(c.CountryIso3, strings.TrimSpace(a.Country))) {
+			country = &r.countries[i]
+			break
+		}
+	}
+	fix := &AddressFix{Address: a, CountryCode: a.CountryCode, StateOrProvince: a.StateOrProvince}
+	if country == nil {
+		if a.CountryCode != "" {
+			return nil, nil // a code we can't check, leave it alone
+		}
+		fix.Problem = "unknown country " + strconv.Quote(a.Country)
+		return fix, nil
+	}
+	fix.CountryCode = country.CountryIso2
+
+	state := strings.TrimSpace(a.StateOrProvince)
+	if state != "" {
+		states, ok := r.states[country.ID]
+		if !ok {
+			var err error
+			states, err = r.client.GetCountryStates(country.ID)
+			if err != nil {
+				return nil, err
+			}
+			r.states[country.ID] = states
+		}
+		matched := false
+		for _, s := range states {
+			if strings.EqualFold(s.State, state) || strings.EqualFold(s.StateAbbreviation, state) {
+				fix.StateOrProvince = s.State
+				matched = true
+				break
+			}
+		}
+		if len(states) > 0 && !matched {
+			fix.Problem = "unknown state " + strconv.Quote(a.StateOrProvince) + " in " + country.CountryIso2
+			return fix, nil
+		}
+	}
+	if fix.CountryCode == a.CountryCode && fix.StateOrProvince == a.StateOrProvince {
+		return nil, nil
+	}
+	return fix, nil
+}
@@ -4,30 +4,36 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"sync"
+	"time"
 )
 
 type Adjustment struct {
 	Reason string           `json:"reason"`
 	Items  []AdjustmentItem `json:"items"`
+	// Reference is an external reference (PO number, order ID) kept in the audit trail only
+	Reference string `json:"-"`
 }
 
 type AdjustmentItem struct {
-	LocationId int    `json:"location_id"`
-	VariantId  int    `json:"variant_id,omitempty"`
+	LocationId int64  `json:"location_id"`
+	VariantId  int64  `json:"variant_id,omitempty"`
 	Quantity   int    `json:"quantity"`
 	Sku        string `json:"sku,omitempty"`
-	ProductId  int    `json:"product_id,omitempty"`
+	ProductId  int64  `json:"product_id,omitempty"`
 }
 
 // AdjustInventoryRelative changes the stock value relative to it's current value
 func (bc *Client) AdjustInventoryRelative(adjustment *Adjustment) error {
 	url := "/v3/inventory/adjustments/relative"
 
-	reqJSON, _ := json.Marshal(adjustment)
+	reqJSON, _ := bc.marshal(adjustment)
 	req := bc.getAPIRequest(http.MethodPost, url, bytes.NewReader(reqJSON))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
+		bc.recordAdjustment(AdjustmentModeRelative, adjustment, err)
 		return err
 	}
 
@@ -35,20 +41,21 @@ func (bc *Client) AdjustInventoryRelative(adjustment *Adjustment) error {
 	body, err := processBody(res)
 
 	if err != nil {
-		return fmt.Errorf("error processing response body: %v %s", err, string(body))
+		err = fmt.Errorf("error processing response body: %w %s", err, string(body))
 	}
-
-	return nil
+	bc.recordAdjustment(AdjustmentModeRelative, adjustment, err)
+	return err
 }
 
 // AdjustInventoryAbsolute sets the stock value to a specific value
 func (bc *Client) AdjustInventoryAbsolute(adjustment *Adjustment) error {
 	url := "/v3/inventory/adjustments/absolute"
 
-	reqJSON, _ := json.Marshal(adjustment)
+	reqJSON, _ := bc.marshal(adjustment)
 	req := bc.getAPIRequest(http.MethodPut, url, bytes.NewReader(reqJSON))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
+		bc.recordAdjustment(AdjustmentModeAbsolute, adjustment, err)
 		return err
 	}
 
@@ -56,8 +63,153 @@ func (bc *Client) AdjustInventoryAbsolute(adjustment *Adjustment) error {
 	body, err := processBody(res)
 
 	if err != nil {
-		return fmt.Errorf("error processing response body: %v %s", err, string(body))
+		err = fmt.Errorf("error processing response body: %w %s", err, string(body))
 	}
+	bc.recordAdjustment(AdjustmentModeAbsolute, adjustment, err)
+	return err
+}
+
+const (
+	AdjustmentModeRelative = "relative"
+	AdjustmentModeAbsolute = "absolute"
+)
+
+// AdjustmentRecord is an audit trail entry for an inventory adjustment made through the client
+type AdjustmentRecord struct {
+	Time      time.Time        `json:"time"`
+	Mode      string           `json:"mode"`
+	Reason    string           `json:"reason"`
+	Reference string           `json:"reference,omitempty"`
+	Items     []AdjustmentItem `json:"items"`
+	Error     string           `json:"error,omitempty"`
+}
+
+// AdjustmentSink stores adjustment records, set Client.AdjustmentSink to keep an audit trail
+// BigCommerce keeps no adjustment history, so this is the only record of why stock changed
+type AdjustmentSink interface {
+	Record(record AdjustmentRecord) error
+}
+
+// AdjustmentQuery filters adjustment records, zero values match everything
+type AdjustmentQuery struct {
+	Sku        string
+	ProductID  int64
+	VariantID  int64
+	LocationID int64
+	Reference  string
+	Since      time.Time
+	Until      time.Time
+	FailedOnly bool
+}
+
+// Match returns true if the record matches the query
+func (q AdjustmentQuery) Match(r AdjustmentRecord) bool {
+	if q.Reference != "" && r.Reference != q.Reference {
+		return false
+	}
+	if !q.Since.IsZero() && r.Time.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && !r.Time.Before(q.Until) {
+		return false
+	}
+	if q.FailedOnly && r.Error == "" {
+		return false
+	}
+	if q.Sku == "" && q.ProductID == 0 && q.VariantID == 0 && q.LocationID == 0 {
+		return true
+	}
+	for _, item := range r.Items {
+		if (q.Sku == "" || item.Sku == q.Sku) &&
+			(q.ProductID == 0 || item.ProductId == q.ProductID) &&
+			(q.VariantID == 0 || item.VariantId == q.VariantID) &&
+			(q.LocationID == 0 || item.LocationId == q.LocationID) {
+			return true
+		}
+	}
+	return false
+}
+
+// MemoryAdjustmentSink keeps adjustment records in memory
+type MemoryAdjustmentSink struct {
+	mu      sync.Mutex
+	records []AdjustmentRecord
+}
 
+// Record adds a record to the sink
+func (s *MemoryAdjustmentSink) Record(record AdjustmentRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = append(s.records, record)
 	return nil
 }
+
+// Query returns the records matching q, oldest first
+func (s *MemoryAdjustmentSink) Query(q AdjustmentQuery) []AdjustmentRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ret := []AdjustmentRecord{}
+	for _, r := range s.records {
+		if q.Match(r) {
+			ret = append(ret, r)
+		}
+	}
+	return ret
+}
+
+// JSONLinesAdjustmentSink writes each record as a JSON line, e.g. to an append-only log file
+type JSONLinesAdjustmentSink struct {
+	mu sync.Mutex
+	W  io.Writer
+}
+
+// Record writes the record as one JSON line
+func (s *JSONLinesAdjustmentSink) Record(record AdjustmentRecord) error {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.W.Write(append(b, '\n'))
+	return err
+}
+
+// QueryAdjustments reads JSON lines written by JSONLinesAdjustmentSink and returns the records matching q
+func QueryAdjustments(r io.Reader, q AdjustmentQuery) ([]AdjustmentRecord, error) {
+	ret := []AdjustmentRecord{}
+	dec := json.NewDecoder(r)
+	for {
+		var record AdjustmentRecord
+		err := dec.Decode(&record)
+		if err == io.EOF {
+			return ret, nil
+		}
+		if err != nil {
+			return ret, err
+		}
+		if q.Match(record) {
+			ret = append(ret, record)
+		}
+	}
+}
+
+func (bc *Client) recordAdjustment(mode string, adjustment *Adjustment, err error) {
+	if bc.AdjustmentSink == nil {
+		return
+	}
+	record := AdjustmentRecord{
+		Time:      time.Now(),
+		Mode:      mode,
+		Reason:    adjustment.Reason,
+		Reference: adjustment.Reference,
+		Items:     adjustment.Items,
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	serr := bc.AdjustmentSink.Record(record)
+	if serr != nil {
+		bc.logf("error recording inventory adjustment: %v", serr)
+	}
+}
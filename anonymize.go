@@ -0,0 +1,64 @@
+package bigcommerce
+
+import (
+	"errors"
+	"strconv"
+	"time"
+)
+
+// AnonymizedNamespace is the order metafield namespace AnonymizeOrder records its run in
+const AnonymizedNamespace = "anonymized"
+
+// AnonymizedName replaces names on anonymized orders
+const AnonymizedName = "Anonymized"
+
+// AnonymizedEmail returns the placeholder email of an anonymized order, on the reserved .invalid domain
+func AnonymizedEmail(orderID int64) string {
+	return "order-" + strconv.FormatInt(orderID, 10) + "@example.invalid"
+}
+
+// AnonymizeOrder scrubs the personal data of an order that the API can update, for test and staging
+// stores cloned from production: names, company, street, phone and email of the billing and shipping
+// addresses and the customer message. City, state, zip and country are kept so tax and shipping stay
+// meaningful. The order's IP address and messages can't be changed through the API and are left as is.
+// The time of anonymization is recorded in an order metafield
+func (bc *Client) AnonymizeOrder(orderID int64) error {
+	email := AnonymizedEmail(orderID)
+	url := "/v2/orders/" + strconv.FormatInt(orderID, 10)
+	err := bc.putJSON(url, map[string]interface{}{
+		"customer_message": "",
+		"billing_address":  anonymizedAddress(email),
+	})
+	if err != nil {
+		return err
+	}
+	addresses, err := bc.GetOrderShippingAddresses(orderID)
+	if err != nil && err != ErrNoContent && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	for _, a := range addresses {
+		err = bc.putJSON(url+"/shipping_addresses/"+strconv.FormatInt(a.ID, 10), anonymizedAddress(email))
+		if err != nil {
+			return err
+		}
+	}
+	_, err = bc.CreateOrderMetafield(orderID, Metafield{
+		Namespace: AnonymizedNamespace,
+		Key:       "anonymized_at",
+		Value:     time.Now().UTC().Format(time.RFC3339),
+	})
+	return err
+}
+
+// anonymizedAddress returns the address fields to overwrite, location fields are left out to keep them
+func anonymizedAddress(email string) map[string]interface{} {
+	return map[string]interface{}{
+		"first_name": AnonymizedName,
+		"last_name":  AnonymizedName,
+		"company":    "",
+		"street_1":   AnonymizedName,
+		"street_2":   "",
+		"phone":      "",
+		"email":      email,
+	}
+}
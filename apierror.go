@@ -0,0 +1,182 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Errors matched by APIError with errors.Is, by status code
+var (
+	ErrBadRequest          = errors.New("400 bad request")
+	ErrUnauthorized        = errors.New("401 unauthorized")
+	ErrForbidden           = errors.New("403 forbidden")
+	ErrUnprocessableEntity = errors.New("422 unprocessable entity")
+	ErrTooManyRequests     = errors.New("429 too many requests")
+	ErrServerError         = errors.New("5xx server error")
+)
+
+// ErrNonJSONResponse is matched by NonJSONResponseError, returned when BigCommerce answers with e.g. an HTML
+// maintenance or Cloudflare page instead of JSON
+var ErrNonJSONResponse = errors.New("non-JSON response from BigCommerce API")
+
+// NonJSONResponseError is returned for responses that are not JSON, whatever their status code.
+// It matches ErrNonJSONResponse and, like APIError, the sentinel error of its status code with errors.Is
+type NonJSONResponseError struct {
+	StatusCode  int
+	Status      string
+	Method      string
+	URL         string
+	ContentType string
+	// Snippet is the start of the body, with whitespace collapsed
+	Snippet string
+	// Body is the raw response body
+	Body []byte
+}
+
+// Error returns the status, content type and the start of the body
+func (e *NonJSONResponseError) Error() string {
+	status := e.Status
+	if status == "" {
+		status = http.StatusText(e.StatusCode)
+	}
+	return fmt.Sprintf("%s: %s response instead of JSON: %s", status, e.ContentType, e.Snippet)
+}
+
+// Is matches ErrNonJSONResponse and the sentinel error of the status code
+func (e *NonJSONResponseError) Is(target error) bool {
+	return target == ErrNonJSONResponse || (&APIError{StatusCode: e.StatusCode}).Is(target)
+}
+
+// nonJSONSnippetLength is the length of NonJSONResponseError.Snippet
+const nonJSONSnippetLength = 200
+
+// newNonJSONResponseError returns an error when a response has a body that is neither labelled nor shaped as JSON
+func newNonJSONResponseError(res *http.Response, body []byte) *NonJSONResponseError {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 || trimmed[0] == '{' || trimmed[0] == '[' {
+		return nil
+	}
+	contentType := res.Header.Get("Content-Type")
+	if strings.Contains(contentType, "json") {
+		return nil
+	}
+	snippet := strings.Join(strings.Fields(string(trimmed)), " ")
+	if r := []rune(snippet); len(r) > nonJSONSnippetLength {
+		snippet = string(r[:nonJSONSnippetLength]) + "..."
+	}
+	e := &NonJSONResponseError{
+		StatusCode:  res.StatusCode,
+		Status:      res.Status,
+		ContentType: contentType,
+		Snippet:     snippet,
+		Body:        body,
+	}
+	if res.Request != nil {
+		e.Method = res.Request.Method
+		e.URL = res.Request.URL.String()
+	}
+	return e
+}
+
+// APIError is returned for responses with an error status code, it carries the error payload BigCommerce sent:
+//
+//	var apiErr *bigcommerce.APIError
+//	if errors.As(err, &apiErr) {
+//		log.Println(apiErr.StatusCode, apiErr.Errors)
+//	}
+//	if errors.Is(err, bigcommerce.ErrNotFound) { ... }
+type APIError struct {
+	StatusCode int
+	Status     string
+	Method     string
+	URL        string
+	// Title is the title of v3 errors or the message of v2 errors
+	Title string
+	Type  string
+	// Errors holds the v3 field errors, by field
+	Errors map[string]string
+	// Body is the raw response body
+	Body []byte
+}
+
+// Error returns the status with the title and field errors BigCommerce sent
+func (e *APIError) Error() string {
+	msg := e.Status
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+	if e.Title != "" {
+		msg += ": " + e.Title
+	}
+	if len(e.Errors) > 0 {
+		fields := make([]string, 0, len(e.Errors))
+		for field, err := range e.Errors {
+			fields = append(fields, field+": "+err)
+		}
+		sort.Strings(fields)
+		msg += " (" + strings.Join(fields, ", ") + ")"
+	}
+	return msg
+}
+
+// Is matches the sentinel error of the status code, e.g. ErrNotFound for 404
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrUnprocessableEntity:
+		return e.StatusCode == http.StatusUnprocessableEntity
+	case ErrTooManyRequests:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServerError:
+		return e.StatusCode >= 500
+	}
+	return false
+}
+
+// newAPIError reads the error payload of a response, v3 sends an object with title and errors,
+// v2 a list of objects with a message
+func newAPIError(res *http.Response, body []byte) *APIError {
+	e := &APIError{
+		StatusCode: res.StatusCode,
+		Status:     res.Status,
+		Body:       body,
+	}
+	if res.Request != nil {
+		e.Method = res.Request.Method
+		e.URL = res.Request.URL.String()
+	}
+	var v3 ErrorResult
+	if json.Unmarshal(body, &v3) == nil {
+		e.Title = v3.Title
+		e.Type = v3.Type
+		e.Errors = v3.Errors
+		return e
+	}
+	var v2 []struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(body, &v2) == nil {
+		msgs := []string{}
+		for _, m := range v2 {
+			if m.Message != "" {
+				msgs = append(msgs, m.Message)
+			}
+		}
+		e.Title = strings.Join(msgs, "; ")
+	}
+	return e
+}
@@ -20,7 +20,11 @@ type App struct {
 	AppClientSecret string
 	HTTPClient      HTTPClient
 	MaxRetries      int
-	ChannelID       int
+	ChannelID       int64
+	// LoginURL is where auth codes are exchanged for tokens, DefaultLoginURL when empty
+	LoginURL string
+	// RequiredScopes are the OAuth scopes Install checks the store granted, e.g. "store_v2_orders"
+	RequiredScopes []string
 }
 
 // New returns a new BigCommerce API object with the given hostname, client ID, and client secret
@@ -38,12 +42,11 @@ func NewApp(hostname, appClientID, appClientSecret string) *App {
 	}
 }
 
-func (a *App) NewClient(storeHash, xAuthToken string) *Client {
-	return &Client{
-		StoreHash:  storeHash,
-		XAuthToken: xAuthToken,
-		MaxRetries: 1,
-		HTTPClient: a.HTTPClient,
-		ChannelID:  1,
+// NewClient returns a client for a store with the defaults of NewClient and the app's HTTPClient,
+// options change the defaults
+func (a *App) NewClient(storeHash, xAuthToken string, opts ...Option) *Client {
+	if a.HTTPClient != nil {
+		opts = append([]Option{WithHTTPClient(a.HTTPClient)}, opts...)
 	}
+	return NewClient(storeHash, xAuthToken, opts...)
 }
@@ -0,0 +1,22 @@
+package bigcommerce
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestAppNewClientDefaults(t *testing.T) {
+	app := NewApp("app.example.com", "client-id", "client-secret")
+	httpClient := &http.Client{}
+	app.HTTPClient = httpClient
+	bc := app.NewClient("store", "token")
+	if bc.Retry == nil || *bc.Retry != *DefaultRetryPolicy() {
+		t.Errorf("got retry policy %+v, want the default", bc.Retry)
+	}
+	if bc.HTTPClient != httpClient || bc.ChannelID != 1 || bc.MaxRetries != 1 {
+		t.Errorf("got HTTPClient %v, channel %d and %d retries, want the app's client, channel 1 and 1 retry", bc.HTTPClient, bc.ChannelID, bc.MaxRetries)
+	}
+	if bc = app.NewClient("store", "token", WithRetryPolicy(nil)); bc.Retry != nil {
+		t.Error("options don't override the defaults")
+	}
+}
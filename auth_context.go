@@ -27,7 +27,7 @@ func (bc *App) GetAuthContext(requestURLQuery url.Values) (*AuthContext, error)
 		return nil, err
 	}
 
-	res, err := bc.HTTPClient.Post("https://login.bigcommerce.com/oauth2/token",
+	res, err := bc.HTTPClient.Post(bc.loginURL()+"/oauth2/token",
 		"application/json",
 		bytes.NewReader(reqb),
 	)
@@ -41,6 +41,9 @@ func (bc *App) GetAuthContext(requestURLQuery url.Values) (*AuthContext, error)
 	}
 	res.Body.Close()
 
+	if res.StatusCode > 299 && !strings.Contains(string(bytes), "invalid_") {
+		return nil, newAPIError(res, bytes)
+	}
 	if strings.Contains(string(bytes), "invalid_") {
 		return nil, fmt.Errorf("%s", string(bytes))
 	}
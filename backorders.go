@@ -0,0 +1,190 @@
+package bigcommerce
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// BackorderAction is what happens with an order that has oversold lines
+type BackorderAction int
+
+const (
+	// BackorderShip means nothing is oversold, the order ships as is
+	BackorderShip BackorderAction = iota
+	// BackorderHold moves the order to the policy's hold status until stock arrives
+	BackorderHold
+	// BackorderPartialShip ships what is in stock, the decision's Items, and leaves the rest for later
+	BackorderPartialShip
+	// BackorderNotify only calls the policy's Notify, the caller decides
+	BackorderNotify
+)
+
+func (a BackorderAction) String() string {
+	switch a {
+	case BackorderShip:
+		return "ship"
+	case BackorderHold:
+		return "hold"
+	case BackorderPartialShip:
+		return "partial ship"
+	case BackorderNotify:
+		return "notify"
+	}
+	return "unknown"
+}
+
+// BackorderPolicy configures ApplyBackorderPolicy
+type BackorderPolicy struct {
+	// Action is taken when lines are oversold
+	Action BackorderAction
+	// HoldStatusID is the order status BackorderHold sets, OrderStatusManualVerificationRequired when 0
+	HoldStatusID int64
+	// Notify, when set, is called with every decision that has oversold lines, whatever the action
+	Notify func(decision *BackorderDecision) error
+}
+
+// OversoldLine is an order line with more left to ship than is available to sell
+type OversoldLine struct {
+	OrderProductID int64  `json:"order_product_id"`
+	VariantID      int64  `json:"variant_id"`
+	Sku            string `json:"sku"`
+	// Ordered is the quantity left to ship, Available what stock covers of it and Short the rest
+	Ordered   int `json:"ordered"`
+	Available int `json:"available"`
+	Short     int `json:"short"`
+}
+
+// BackorderDecision is the outcome of checking an order against stock
+type BackorderDecision struct {
+	OrderID    int64           `json:"order_id"`
+	LocationID int64           `json:"location_id"`
+	Action     BackorderAction `json:"action"`
+	Oversold   []OversoldLine  `json:"oversold"`
+	// Items are the quantities stock covers, the shipment items of a partial shipment
+	Items []ShipmentItem `json:"items"`
+}
+
+// CheckBackorders compares the unshipped lines of an order with the stock available to sell at a location
+// and decides by policy, without changing anything
+func (bc *Client) CheckBackorders(orderID, locationID int64, policy BackorderPolicy) (*BackorderDecision, error) {
+	products, err := bc.GetOrderProducts(orderID)
+	if err != nil {
+		return nil, err
+	}
+	variantIDs := []int64{}
+	for _, p := range products {
+		if p.VariantID != 0 {
+			variantIDs = append(variantIDs, p.VariantID)
+		}
+	}
+	available := map[int64]int{}
+	if len(variantIDs) > 0 {
+		it := bc.InventoryForLocationIterator(locationID, map[string]string{"variant_id:in": joinIDs(variantIDs)})
+		for it.Next() {
+			inv := it.Inventory()
+			available[inv.Identity.VariantID] = inv.AvailableToSell
+		}
+		if it.Err() != nil {
+			return nil, it.Err()
+		}
+	}
+	d := policy.Decide(products, available)
+	d.OrderID = orderID
+	d.LocationID = locationID
+	return d, nil
+}
+
+// ApplyBackorderPolicy checks an order with CheckBackorders and carries out the decision: BackorderHold sets
+// the hold status and adds the short SKUs to the staff notes, and Notify is called for oversold orders.
+// A partial shipment is left to the caller, create it from the decision's Items
+func (bc *Client) ApplyBackorderPolicy(orderID, locationID int64, policy BackorderPolicy) (*BackorderDecision, error) {
+	d, err := bc.CheckBackorders(orderID, locationID, policy)
+	if err != nil {
+		return nil, err
+	}
+	if len(d.Oversold) == 0 {
+		return d, nil
+	}
+	if d.Action == BackorderHold {
+		statusID := policy.HoldStatusID
+		if statusID == 0 {
+			statusID = OrderStatusManualVerificationRequired
+		}
+		var order struct {
+			StaffNotes string `json:"staff_notes"`
+		}
+		err = bc.getJSON(newURL("/v2/orders").ID(orderID).String(), &order)
+		if err != nil {
+			return d, err
+		}
+		notes := d.notes()
+		if order.StaffNotes != "" {
+			notes = order.StaffNotes + "\n" + notes
+		}
+		err = bc.UpdateOrder(orderID, &UpdateOrder{StatusID: statusID, StaffNotes: notes})
+		if err != nil {
+			return d, fmt.Errorf("error holding backordered order %d: %w", orderID, err)
+		}
+	}
+	if policy.Notify != nil {
+		err = policy.Notify(d)
+		if err != nil {
+			return d, fmt.Errorf("error notifying backorder of order %d: %w", orderID, err)
+		}
+	}
+	return d, nil
+}
+
+// Decide allocates the stock available to sell, by variant ID, to the unshipped order lines in order and returns
+// the decision. Variants missing from available are treated as out of stock, digital lines are skipped
+func (p BackorderPolicy) Decide(products []OrderProduct, available map[int64]int) *BackorderDecision {
+	d := &BackorderDecision{Action: BackorderShip, Oversold: []OversoldLine{}, Items: []ShipmentItem{}}
+	left := map[int64]int{}
+	for id, n := range available {
+		left[id] = n
+	}
+	for _, op := range products {
+		qty := op.QuantityToShip()
+		if qty <= 0 || op.Type == "digital" {
+			continue
+		}
+		covered := qty
+		if left[op.VariantID] < covered {
+			covered = left[op.VariantID]
+		}
+		if covered < 0 {
+			covered = 0
+		}
+		left[op.VariantID] -= covered
+		if covered > 0 {
+			d.Items = append(d.Items, ShipmentItem{OrderProductId: op.ID, Quantity: int64(covered)})
+		}
+		if covered < qty {
+			d.Oversold = append(d.Oversold, OversoldLine{
+				OrderProductID: op.ID,
+				VariantID:      op.VariantID,
+				Sku:            op.Sku,
+				Ordered:        qty,
+				Available:      covered,
+				Short:          qty - covered,
+			})
+		}
+	}
+	if len(d.Oversold) > 0 {
+		d.Action = p.Action
+		if d.Action == BackorderShip {
+			d.Action = BackorderNotify
+		}
+	}
+	return d
+}
+
+// notes describes the oversold lines for the staff notes of a held order
+func (d *BackorderDecision) notes() string {
+	lines := make([]string, len(d.Oversold))
+	for i, l := range d.Oversold {
+		lines[i] = l.Sku + " short " + strconv.Itoa(l.Short)
+	}
+	return "Backordered: " + strings.Join(lines, ", ")
+}
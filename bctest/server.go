@@ -0,0 +1,524 @@
+// Package bctest runs an in-memory fake of the BigCommerce orders, shipments and inventory endpoints,
+// so fulfillment code can be tested end to end without a store:
+//
+//	srv := bctest.NewServer()
+//	defer srv.Close()
+//	srv.AddOrder(bigcommerce.Order{ID: 100, StatusID: bigcommerce.OrderStatusAwaitingFulfillment},
+//		[]bigcommerce.OrderProduct{{ID: 1, VariantID: 77, Sku: "MUG", Quantity: 2, OrderAddressID: 5}})
+//	client := srv.Client()
+//	// run the code under test with client, then check srv.Shipments(100)
+//
+// Lists paginate with page and limit like BigCommerce, and Throttle makes the next requests fail with 429
+package bctest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ewarehousing-solutions/bigcommerce-api-go"
+)
+
+// StoreHash is the store hash of the clients returned by Server.Client
+const StoreHash = "bctest"
+
+// Server is a fake BigCommerce API
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	orders      map[int64]*bigcommerce.Order
+	products    map[int64][]bigcommerce.OrderProduct
+	addresses   map[int64][]bigcommerce.OrderShippingAddress
+	shipments   map[int64][]bigcommerce.Shipment
+	inventory   map[int64][]bigcommerce.Inventory
+	adjustments []bigcommerce.Adjustment
+	requests    []string
+	throttle    int
+	nextID      int64
+}
+
+// NewServer starts a fake BigCommerce API, close it with Close
+func NewServer() *Server {
+	s := &Server{
+		orders:    map[int64]*bigcommerce.Order{},
+		products:  map[int64][]bigcommerce.OrderProduct{},
+		addresses: map[int64][]bigcommerce.OrderShippingAddress{},
+		shipments: map[int64][]bigcommerce.Shipment{},
+		inventory: map[int64][]bigcommerce.Inventory{},
+		nextID:    1000,
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Client returns a client for the fake store, without retry delays, opts are applied after the defaults
+func (s *Server) Client(opts ...bigcommerce.Option) *bigcommerce.Client {
+	defaults := []bigcommerce.Option{
+		bigcommerce.WithHTTPClient(s.Server.Client()),
+		bigcommerce.WithBaseURL(s.URL),
+		bigcommerce.WithRetryPolicy(&bigcommerce.RetryPolicy{MaxAttempts: 4, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}),
+	}
+	return bigcommerce.NewClient(StoreHash, "bctest-token", append(defaults, opts...)...)
+}
+
+// AddOrder adds an order with its products, products get the order ID and the order a shipping address
+// for every order address ID of the products
+func (s *Server) AddOrder(order bigcommerce.Order, products []bigcommerce.OrderProduct) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	o := order
+	s.orders[o.ID] = &o
+	ps := make([]bigcommerce.OrderProduct, len(products))
+	seen := map[int64]bool{}
+	s.addresses[o.ID] = nil
+	for i, p := range products {
+		p.OrderID = o.ID
+		ps[i] = p
+		if p.OrderAddressID != 0 && !seen[p.OrderAddressID] {
+			seen[p.OrderAddressID] = true
+			s.addresses[o.ID] = append(s.addresses[o.ID], bigcommerce.OrderShippingAddress{ID: p.OrderAddressID, OrderID: o.ID})
+		}
+	}
+	s.products[o.ID] = ps
+}
+
+// SetInventory sets the stock of a variant at a location, replacing an item with the same variant ID
+func (s *Server) SetInventory(locationID int64, item bigcommerce.Inventory) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	items := s.inventory[locationID]
+	for i := range items {
+		if items[i].Identity.VariantID == item.Identity.VariantID {
+			items[i] = item
+			return
+		}
+	}
+	s.inventory[locationID] = append(items, item)
+}
+
+// Order returns an order as the fake store has it now
+func (s *Server) Order(orderID int64) (bigcommerce.Order, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	o, ok := s.orders[orderID]
+	if !ok {
+		return bigcommerce.Order{}, false
+	}
+	return *o, true
+}
+
+// OrderProducts returns the products of an order, with the quantities shipped so far
+func (s *Server) OrderProducts(orderID int64) []bigcommerce.OrderProduct {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]bigcommerce.OrderProduct(nil), s.products[orderID]...)
+}
+
+// Shipments returns the shipments of an order
+func (s *Server) Shipments(orderID int64) []bigcommerce.Shipment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]bigcommerce.Shipment(nil), s.shipments[orderID]...)
+}
+
+// Inventory returns the inventory of a location
+func (s *Server) Inventory(locationID int64) []bigcommerce.Inventory {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]bigcommerce.Inventory(nil), s.inventory[locationID]...)
+}
+
+// Adjustments returns the inventory adjustments received
+func (s *Server) Adjustments() []bigcommerce.Adjustment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]bigcommerce.Adjustment(nil), s.adjustments...)
+}
+
+// Requests returns the requests received, as "METHOD /path?query" without the store prefix
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// Throttle answers the next n requests with 429 Too Many Requests, as BigCommerce does when the quota is used up
+func (s *Server) Throttle(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.throttle = n
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	path := strings.TrimPrefix(r.URL.Path, "/stores/"+StoreHash)
+	req := r.Method + " " + path
+	if r.URL.RawQuery != "" {
+		req += "?" + r.URL.RawQuery
+	}
+	s.requests = append(s.requests, req)
+
+	w.Header().Set("X-Rate-Limit-Requests-Quota", "150")
+	w.Header().Set("X-Rate-Limit-Time-Window-Ms", "30000")
+	if s.throttle > 0 {
+		s.throttle--
+		w.Header().Set("X-Rate-Limit-Requests-Left", "0")
+		w.Header().Set("X-Rate-Limit-Time-Reset-Ms", "1")
+		writeJSON(w, http.StatusTooManyRequests, map[string]interface{}{"status": 429, "title": "Too many requests"})
+		return
+	}
+	w.Header().Set("X-Rate-Limit-Requests-Left", "149")
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	switch {
+	case len(segments) >= 2 && segments[0] == "v2" && segments[1] == "orders":
+		s.serveOrders(w, r, segments[2:])
+	case len(segments) == 5 && segments[0] == "v3" && segments[1] == "inventory" && segments[2] == "locations" && segments[4] == "items":
+		s.serveInventory(w, r, segments[3])
+	case len(segments) == 4 && segments[0] == "v3" && segments[1] == "inventory" && segments[2] == "adjustments":
+		s.serveAdjustment(w, r, segments[3])
+	default:
+		notFoundV3(w)
+	}
+}
+
+func (s *Server) serveOrders(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) == 0 {
+		if r.Method != http.MethodGet {
+			notAllowed(w)
+			return
+		}
+		ids := make([]int64, 0, len(s.orders))
+		for id := range s.orders {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		orders := make([]bigcommerce.Order, len(ids))
+		for i, id := range ids {
+			orders[i] = *s.orders[id]
+		}
+		writeV2Page(w, r, orders)
+		return
+	}
+	orderID, err := strconv.ParseInt(segments[0], 10, 64)
+	order, ok := s.orders[orderID]
+	if err != nil || !ok {
+		notFoundV2(w)
+		return
+	}
+	switch {
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, order)
+	case len(segments) == 1 && r.Method == http.MethodPut:
+		var update bigcommerce.UpdateOrder
+		if !readJSON(w, r, &update) {
+			return
+		}
+		if update.StatusID != 0 {
+			order.StatusID = update.StatusID
+		}
+		if update.StaffNotes != "" {
+			order.StaffNotes = update.StaffNotes
+		}
+		if update.CustomerMessage != "" {
+			order.CustomerMessage = update.CustomerMessage
+		}
+		writeJSON(w, http.StatusOK, order)
+	case len(segments) == 2 && segments[1] == "products" && r.Method == http.MethodGet:
+		writeV2Page(w, r, s.products[orderID])
+	case len(segments) == 2 && segments[1] == "shipping_addresses" && r.Method == http.MethodGet:
+		writeV2Page(w, r, s.addresses[orderID])
+	case len(segments) == 2 && segments[1] == "coupons" && r.Method == http.MethodGet:
+		writeV2Page(w, r, []bigcommerce.OrderCoupon{})
+	case len(segments) >= 2 && segments[1] == "shipments":
+		s.serveShipments(w, r, order, segments[2:])
+	default:
+		notFoundV2(w)
+	}
+}
+
+func (s *Server) serveShipments(w http.ResponseWriter, r *http.Request, order *bigcommerce.Order, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			writeV2Page(w, r, s.shipments[order.ID])
+		case http.MethodPost:
+			var shipment bigcommerce.Shipment
+			if !readJSON(w, r, &shipment) {
+				return
+			}
+			if msg := s.checkShipment(order.ID, shipment, nil); msg != "" {
+				writeJSON(w, http.StatusBadRequest, []map[string]interface{}{{"status": 400, "message": msg}})
+				return
+			}
+			s.nextID++
+			shipment.ID = s.nextID
+			shipment.OrderId = order.ID
+			shipment.CustomerId = order.CustomerID
+			shipment.DateCreated = time.Now().UTC().Format(time.RFC1123Z)
+			s.shipments[order.ID] = append(s.shipments[order.ID], shipment)
+			s.updateShipped(order)
+			writeJSON(w, http.StatusCreated, shipment)
+		case http.MethodDelete:
+			s.shipments[order.ID] = nil
+			s.updateShipped(order)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			notAllowed(w)
+		}
+		return
+	}
+	shipmentID, _ := strconv.ParseInt(segments[0], 10, 64)
+	shipments := s.shipments[order.ID]
+	i := 0
+	for i < len(shipments) && shipments[i].ID != shipmentID {
+		i++
+	}
+	if len(segments) != 1 || i == len(shipments) {
+		notFoundV2(w)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, shipments[i])
+	case http.MethodPut:
+		var update bigcommerce.Shipment
+		if !readJSON(w, r, &update) {
+			return
+		}
+		if update.Items == nil {
+			update.Items = shipments[i].Items
+		}
+		if msg := s.checkShipment(order.ID, update, &shipments[i]); msg != "" {
+			writeJSON(w, http.StatusBadRequest, []map[string]interface{}{{"status": 400, "message": msg}})
+			return
+		}
+		update.ID, update.OrderId, update.CustomerId, update.DateCreated = shipments[i].ID, order.ID, order.CustomerID, shipments[i].DateCreated
+		if update.OrderAddressId == 0 {
+			update.OrderAddressId = shipments[i].OrderAddressId
+		}
+		shipments[i] = update
+		s.updateShipped(order)
+		writeJSON(w, http.StatusOK, update)
+	case http.MethodDelete:
+		s.shipments[order.ID] = append(shipments[:i], shipments[i+1:]...)
+		s.updateShipped(order)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notAllowed(w)
+	}
+}
+
+// checkShipment validates the items of a shipment like BigCommerce does, replaced is the shipment being updated
+func (s *Server) checkShipment(orderID int64, shipment bigcommerce.Shipment, replaced *bigcommerce.Shipment) string {
+	if len(shipment.Items) == 0 {
+		return "The field 'items' is invalid."
+	}
+	shipped := map[int64]int64{}
+	for _, sh := range s.shipments[orderID] {
+		if replaced != nil && sh.ID == replaced.ID {
+			continue
+		}
+		for _, item := range sh.Items {
+			shipped[item.OrderProductId] += item.Quantity
+		}
+	}
+	for _, item := range shipment.Items {
+		shipped[item.OrderProductId] += item.Quantity
+	}
+	for _, item := range shipment.Items {
+		var product *bigcommerce.OrderProduct
+		for i, p := range s.products[orderID] {
+			if p.ID == item.OrderProductId {
+				product = &s.products[orderID][i]
+			}
+		}
+		if product == nil {
+			return "Order product " + strconv.FormatInt(item.OrderProductId, 10) + " does not exist."
+		}
+		if item.Quantity <= 0 || shipped[item.OrderProductId] > int64(product.Quantity) {
+			return "The quantity for order product " + strconv.FormatInt(item.OrderProductId, 10) + " is invalid."
+		}
+	}
+	return ""
+}
+
+// updateShipped recomputes quantity_shipped of the order products and the order status from the shipments
+func (s *Server) updateShipped(order *bigcommerce.Order) {
+	shipped := map[int64]int{}
+	for _, sh := range s.shipments[order.ID] {
+		for _, item := range sh.Items {
+			shipped[item.OrderProductId] += int(item.Quantity)
+		}
+	}
+	total, done := 0, 0
+	for i := range s.products[order.ID] {
+		p := &s.products[order.ID][i]
+		p.QuantityShipped = shipped[p.ID]
+		total += p.Quantity
+		done += p.QuantityShipped
+	}
+	order.ItemsShipped = done
+	switch {
+	case done == 0:
+		if order.StatusID == bigcommerce.OrderStatusShipped || order.StatusID == bigcommerce.OrderStatusPartiallyShipped {
+			order.StatusID = bigcommerce.OrderStatusAwaitingFulfillment
+		}
+	case done < total:
+		order.StatusID = bigcommerce.OrderStatusPartiallyShipped
+	default:
+		order.StatusID = bigcommerce.OrderStatusShipped
+	}
+}
+
+func (s *Server) serveInventory(w http.ResponseWriter, r *http.Request, location string) {
+	if r.Method != http.MethodGet {
+		notAllowed(w)
+		return
+	}
+	locationID, _ := strconv.ParseInt(location, 10, 64)
+	q := r.URL.Query()
+	variantIDs := inFilter(q.Get("variant_id:in"))
+	skus := inFilter(q.Get("sku:in"))
+	items := []bigcommerce.Inventory{}
+	for _, item := range s.inventory[locationID] {
+		if len(variantIDs) > 0 && !variantIDs[strconv.FormatInt(item.Identity.VariantID, 10)] {
+			continue
+		}
+		if len(skus) > 0 && !skus[item.Identity.Sku] {
+			continue
+		}
+		items = append(items, item)
+	}
+	page, limit := pageArgs(r, 50)
+	start, end := pageBounds(len(items), page, limit)
+	pagination := bigcommerce.Pagination{
+		Total:       len(items),
+		Count:       end - start,
+		PerPage:     limit,
+		CurrentPage: page,
+		TotalPages:  (len(items) + limit - 1) / limit,
+	}
+	if page < pagination.TotalPages {
+		pagination.Links.Next = "?page=" + strconv.Itoa(page+1) + "&limit=" + strconv.Itoa(limit)
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": items[start:end],
+		"meta": map[string]interface{}{"pagination": pagination},
+	})
+}
+
+func (s *Server) serveAdjustment(w http.ResponseWriter, r *http.Request, mode string) {
+	if (mode == "relative" && r.Method != http.MethodPost) || (mode == "absolute" && r.Method != http.MethodPut) {
+		notAllowed(w)
+		return
+	}
+	if mode != "relative" && mode != "absolute" {
+		notFoundV3(w)
+		return
+	}
+	var adjustment bigcommerce.Adjustment
+	if !readJSON(w, r, &adjustment) {
+		return
+	}
+	for _, a := range adjustment.Items {
+		items := s.inventory[a.LocationId]
+		i := 0
+		for i < len(items) && !((a.VariantId != 0 && items[i].Identity.VariantID == a.VariantId) || (a.Sku != "" && items[i].Identity.Sku == a.Sku)) {
+			i++
+		}
+		if i == len(items) {
+			items = append(items, bigcommerce.Inventory{Identity: bigcommerce.Identity{VariantID: a.VariantId, Sku: a.Sku, ProductID: a.ProductId}})
+			s.inventory[a.LocationId] = items
+		}
+		if mode == "relative" {
+			items[i].AvailableToSell += a.Quantity
+			items[i].TotalInventoryOnhand += a.Quantity
+		} else {
+			items[i].AvailableToSell = a.Quantity
+			items[i].TotalInventoryOnhand = a.Quantity
+		}
+	}
+	s.adjustments = append(s.adjustments, adjustment)
+	writeJSON(w, http.StatusOK, map[string]interface{}{"transaction_id": strconv.Itoa(len(s.adjustments))})
+}
+
+// writeV2Page writes a page of a v2 list, 204 No Content past the last page like BigCommerce
+func writeV2Page[T any](w http.ResponseWriter, r *http.Request, items []T) {
+	page, limit := pageArgs(r, 50)
+	start, end := pageBounds(len(items), page, limit)
+	if start == end {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	writeJSON(w, http.StatusOK, items[start:end])
+}
+
+func pageArgs(r *http.Request, defaultLimit int) (int, int) {
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit < 1 {
+		limit = defaultLimit
+	}
+	return page, limit
+}
+
+func pageBounds(n, page, limit int) (int, int) {
+	start := (page - 1) * limit
+	if start > n {
+		start = n
+	}
+	end := start + limit
+	if end > n {
+		end = n
+	}
+	return start, end
+}
+
+func inFilter(v string) map[string]bool {
+	if v == "" {
+		return nil
+	}
+	set := map[string]bool{}
+	for _, s := range strings.Split(v, ",") {
+		set[s] = true
+	}
+	return set
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{"status": 400, "title": "Input is invalid: " + err.Error()})
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func notFoundV2(w http.ResponseWriter) {
+	writeJSON(w, http.StatusNotFound, []map[string]interface{}{{"status": 404, "message": "The requested resource was not found."}})
+}
+
+func notFoundV3(w http.ResponseWriter) {
+	writeJSON(w, http.StatusNotFound, map[string]interface{}{"status": 404, "title": "The requested resource was not found."})
+}
+
+func notAllowed(w http.ResponseWriter) {
+	writeJSON(w, http.StatusMethodNotAllowed, map[string]interface{}{"status": 405, "title": "Method not allowed"})
+}
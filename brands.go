@@ -1,10 +1,7 @@
 package bigcommerce
 
 import (
-	"encoding/json"
 	"fmt"
-	"net/http"
-	"strconv"
 )
 
 // Brand is BigCommerce brand object
@@ -58,33 +55,9 @@ func (bc *Client) GetAllBrands(args map[string]string) ([]Brand, error) {
 // args is a map of arguments to pass to the API
 // page: the page number to download
 func (bc *Client) GetBrands(args map[string]string, page int) ([]Brand, bool, error) {
-	fpart := ""
-	for k, v := range args {
-		fpart += "&" + k + "=" + v
-	}
-	url := "/v3/catalog/brands?page=" + strconv.Itoa(page) + fpart
-
-	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
-	if err != nil {
-		return nil, false, err
-	}
-
-	defer res.Body.Close()
-	body, err := processBody(res)
-	if err != nil {
-		return nil, false, err
-	}
-
-	var pp struct {
-		Data []Brand `json:"data"`
-		Meta struct {
-			Pagination Pagination `json:"pagination"`
-		} `json:"meta"`
-	}
-	err = json.Unmarshal(body, &pp)
+	p, err := ListPage[Brand](bc, newURL("/v3/catalog/brands").Int("page", int64(page)).Args(args).String())
 	if err != nil {
 		return nil, false, err
 	}
-	return pp.Data, pp.Meta.Pagination.CurrentPage < pp.Meta.Pagination.TotalPages, nil
+	return p.Data, p.More(), nil
 }
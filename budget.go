@@ -0,0 +1,144 @@
+package bigcommerce
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// QuotaWindow is the window BigCommerce plan rate limits are counted in
+const QuotaWindow = 30 * time.Second
+
+// Requests per QuotaWindow for BigCommerce plans, Enterprise limits are set per store
+const (
+	PlanStandardQuota = 150
+	PlanPlusQuota     = 150
+	PlanProQuota      = 450
+)
+
+// TokenBucket rations API requests, tokens refill evenly over the window up to capacity.
+// Set it as Client.Budget to have every request take a token, or Reserve tokens up front in batch jobs
+type TokenBucket struct {
+	mu       sync.Mutex
+	capacity float64
+	rate     float64 // tokens per second
+	tokens   float64
+	last     time.Time
+	parent   *TokenBucket
+}
+
+// NewTokenBucket returns a full bucket allowing requests per window
+func NewTokenBucket(requests int, window time.Duration) *TokenBucket {
+	return &TokenBucket{
+		capacity: float64(requests),
+		rate:     float64(requests) / window.Seconds(),
+		tokens:   float64(requests),
+		last:     time.Now(),
+	}
+}
+
+// NewPlanBudget returns a bucket for a plan quota per QuotaWindow, keeping headroom (0-1) of it
+// free for the merchant's other apps, e.g. NewPlanBudget(PlanProQuota, 0.2) uses at most 360 requests per 30s
+func NewPlanBudget(quota int, headroom float64) *TokenBucket {
+	requests := int(float64(quota) * (1 - headroom))
+	if requests < 1 {
+		requests = 1
+	}
+	return NewTokenBucket(requests, QuotaWindow)
+}
+
+// Split returns a child bucket allowing requests per window that also takes its tokens from b,
+// so batch jobs sharing a client each get a ration of the client's budget
+func (b *TokenBucket) Split(requests int, window time.Duration) *TokenBucket {
+	child := NewTokenBucket(requests, window)
+	child.parent = b
+	return child
+}
+
+// Reserve takes n tokens and returns how long to wait before using them, 0 if they are available now.
+// Tokens are taken even when the caller has to wait, later reservations queue up behind it
+func (b *TokenBucket) Reserve(n int) time.Duration {
+	b.mu.Lock()
+	b.refill()
+	b.tokens -= float64(n)
+	var delay time.Duration
+	if b.tokens < 0 {
+		delay = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	b.mu.Unlock()
+	if b.parent != nil {
+		if pd := b.parent.Reserve(n); pd > delay {
+			delay = pd
+		}
+	}
+	return delay
+}
+
+// TryReserve takes n tokens only if they are available now (in b and its parents)
+func (b *TokenBucket) TryReserve(n int) bool {
+	if b.Available() < float64(n) {
+		return false
+	}
+	if b.parent != nil && !b.parent.TryReserve(n) {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill()
+	b.tokens -= float64(n)
+	return true
+}
+
+// Wait reserves n tokens and sleeps until they can be used
+func (b *TokenBucket) Wait(n int) {
+	if d := b.Reserve(n); d > 0 {
+		time.Sleep(d)
+	}
+}
+
+// WaitContext is Wait returning early with the context error when ctx is done, the tokens are given back then
+func (b *TokenBucket) WaitContext(ctx context.Context, n int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	d := b.Reserve(n)
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		b.release(n)
+		return ctx.Err()
+	}
+}
+
+// release gives back n reserved tokens
+func (b *TokenBucket) release(n int) {
+	b.mu.Lock()
+	b.tokens += float64(n)
+	b.mu.Unlock()
+	if b.parent != nil {
+		b.parent.release(n)
+	}
+}
+
+// Available returns the tokens available now, negative when reservations are queued
+func (b *TokenBucket) Available() float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill()
+	return b.tokens
+}
+
+func (b *TokenBucket) refill() {
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+	b.last = now
+}
@@ -0,0 +1,107 @@
+package bigcommerce
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// DefaultBulkConcurrency is how many fetches BulkFetch runs at once unless set
+const DefaultBulkConcurrency = 8
+
+// bulkRateLimitRetries is how often BulkFetch tries an item again that was throttled after all retries
+const bulkRateLimitRetries = 3
+
+// BulkOptions tunes BulkFetch
+type BulkOptions struct {
+	// Concurrency is how many fetches run at once, DefaultBulkConcurrency when 0
+	Concurrency int
+	// ThrottleBelow pauses starting fetches until the rate limit window resets once BigCommerce reports this many
+	// requests left or fewer, Concurrency when 0, -1 to not wait
+	ThrottleBelow int
+}
+
+// BulkResult holds what BulkFetch fetched by ID and the errors of the IDs that failed
+type BulkResult[K comparable, T any] struct {
+	Results map[K]T
+	Errors  map[K]error
+}
+
+// Err returns the errors of the failed IDs joined, nil when all were fetched
+func (r *BulkResult[K, T]) Err() error {
+	if len(r.Errors) == 0 {
+		return nil
+	}
+	errs := make([]error, 0, len(r.Errors))
+	for _, err := range r.Errors {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// BulkFetch calls fetch for every ID with at most opts.Concurrency calls at once, e.g. to get the shipments
+// of 500 orders:
+//
+//	res := bigcommerce.BulkFetch(bc, orderIDs, bigcommerce.BulkOptions{Concurrency: 10}, (*bigcommerce.Client).GetAllOrderShipments)
+//	for orderID, err := range res.Errors { ... }
+//
+// It waits for the rate limit window to reset when few requests are left, and fetches answered with 429 after
+// all retries are tried again once the window reset. A failed ID doesn't stop the others, its error is in
+// Errors. IDs not started when the context of bc is done fail with its error
+func BulkFetch[K comparable, T any](bc *Client, ids []K, opts BulkOptions, fetch func(bc *Client, id K) (T, error)) *BulkResult[K, T] {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBulkConcurrency
+	}
+	throttle := opts.ThrottleBelow
+	if throttle == 0 {
+		throttle = concurrency
+	}
+	res := &BulkResult[K, T]{Results: make(map[K]T, len(ids)), Errors: map[K]error{}}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	ctx := bc.requestContext()
+	for _, id := range ids {
+		id := id
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			var v T
+			err := ctx.Err()
+			for attempt := 0; err == nil; attempt++ {
+				if throttle > 0 {
+					err = sleepContext(ctx, bc.rateLimits().delay(throttle))
+					if err != nil {
+						break
+					}
+				}
+				v, err = fetch(bc, id)
+				if !errors.Is(err, ErrTooManyRequests) || attempt >= bulkRateLimitRetries {
+					break
+				}
+				wait := time.Until(bc.RateLimitStatus().ResetAt)
+				if wait <= 0 {
+					wait = time.Second
+				}
+				if serr := sleepContext(ctx, wait); serr != nil {
+					break
+				}
+				err = nil
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				res.Errors[id] = err
+				return
+			}
+			res.Results[id] = v
+		}()
+	}
+	wg.Wait()
+	return res
+}
@@ -0,0 +1,61 @@
+package bigcommerce
+
+import (
+	"net/http"
+	"time"
+)
+
+// CallPolicy overrides the retries and timeout of the requests it is set for, see Client.Policies
+type CallPolicy struct {
+	// Retry replaces the client's Retry policy, &RetryPolicy{MaxAttempts: 1} disables retries.
+	// nil keeps the client's
+	Retry *RetryPolicy
+	// Timeout bounds each request, retries included, 0 keeps the client's
+	Timeout time.Duration
+}
+
+// WithCallPolicy sets the policy of the requests matching key, see Client.Policies:
+//
+//	bigcommerce.WithCallPolicy("POST /payments", bigcommerce.CallPolicy{Retry: &bigcommerce.RetryPolicy{MaxAttempts: 1}})
+//	bigcommerce.WithCallPolicy("GET", bigcommerce.CallPolicy{Retry: &bigcommerce.RetryPolicy{MaxAttempts: 8, BaseDelay: time.Second}})
+func WithCallPolicy(key string, p CallPolicy) Option {
+	return func(bc *Client) {
+		if bc.Policies == nil {
+			bc.Policies = map[string]CallPolicy{}
+		}
+		bc.Policies[key] = p
+	}
+}
+
+// WithRetry retries the requests of a call by p instead of the client's policy, see Client.With.
+// &RetryPolicy{MaxAttempts: 1} disables retries
+func WithRetry(p *RetryPolicy) RequestOption {
+	return func(o *requestOptions) {
+		o.retry = p
+	}
+}
+
+// callPolicy returns the policy of req: the options of With, else the policy for its method and resource,
+// else the policy for its method, else the client's
+func (bc *Client) callPolicy(req *http.Request) CallPolicy {
+	p := CallPolicy{Retry: bc.Retry}
+	if len(bc.Policies) > 0 {
+		mp, ok := bc.Policies[req.Method+" "+resourceName(req.URL.Path)]
+		if !ok {
+			mp = bc.Policies[req.Method]
+		}
+		if mp.Retry != nil {
+			p.Retry = mp.Retry
+		}
+		p.Timeout = mp.Timeout
+	}
+	if bc.call != nil {
+		if bc.call.retry != nil {
+			p.Retry = bc.call.retry
+		}
+		if bc.call.timeout > 0 {
+			p.Timeout = bc.call.timeout
+		}
+	}
+	return p
+}
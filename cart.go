@@ -2,7 +2,6 @@ package bigcommerce
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
@@ -72,14 +71,29 @@ type CartURLs struct {
 }
 
 // CreateCart creates a new cart in BigCommerce and returns it
+// items may carry a ListPrice to override the catalog price (requires the Carts scope)
 func (bc *Client) CreateCart(items []LineItem) (*Cart, error) {
-	var body []byte
-	body, _ = json.Marshal(map[string]interface{}{
+	return bc.CreateCartWithCustomItems(items, nil)
+}
+
+// CreateCartWithCustomItems creates a new cart with catalog line items and custom items
+// custom items are not in the catalog and must have Sku, Name and Quantity, a zero ListPrice makes them free
+func (bc *Client) CreateCartWithCustomItems(items []LineItem, customItems []LineItem) (*Cart, error) {
+	err := validateCustomItems(customItems)
+	if err != nil {
+		return nil, err
+	}
+	payload := map[string]interface{}{
 		"channel_id": bc.ChannelID,
 		"line_items": items,
-	})
+	}
+	if len(customItems) > 0 {
+		payload["custom_items"] = newCustomItems(customItems)
+	}
+	var body []byte
+	body, _ = bc.marshal(payload)
 	req := bc.getAPIRequest(http.MethodPost, "/v3/carts?include=redirect_urls", bytes.NewReader(body))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +106,7 @@ func (bc *Client) CreateCart(items []LineItem) (*Cart, error) {
 		Meta struct {
 		} `json:"meta,omitempty"`
 	}
-	err = json.Unmarshal(b, &cartResponse)
+	err = bc.unmarshal(b, &cartResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +116,7 @@ func (bc *Client) CreateCart(items []LineItem) (*Cart, error) {
 // GetCart gets a cart by ID from BigCommerce and returns it
 func (bc *Client) GetCart(cartID string) (*Cart, error) {
 	req := bc.getAPIRequest(http.MethodGet, "/v3/carts/"+cartID+"?include=redirect_urls", nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +129,7 @@ func (bc *Client) GetCart(cartID string) (*Cart, error) {
 		Meta struct {
 		} `json:"meta,omitempty"`
 	}
-	err = json.Unmarshal(b, &cartResponse)
+	err = bc.unmarshal(b, &cartResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -125,11 +139,11 @@ func (bc *Client) GetCart(cartID string) (*Cart, error) {
 // CartAddItem adds line items to a cart
 func (bc *Client) CartAddItems(cartID string, items []LineItem) (*Cart, error) {
 	var body []byte
-	body, _ = json.Marshal(map[string]interface{}{
+	body, _ = bc.marshal(map[string]interface{}{
 		"line_items": items,
 	})
 	req := bc.getAPIRequest(http.MethodPost, "/v3/carts/"+cartID+"/items?include=redirect_urls", bytes.NewReader(body))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -142,24 +156,84 @@ func (bc *Client) CartAddItems(cartID string, items []LineItem) (*Cart, error) {
 		Meta struct {
 		} `json:"meta,omitempty"`
 	}
-	err = json.Unmarshal(b, &cartResponse)
+	err = bc.unmarshal(b, &cartResponse)
 	if err != nil {
 		return nil, err
 	}
 	return &cartResponse.Data, nil
 }
 
+// CartAddCustomItems adds custom (non-catalog) items to a cart
+// custom items must have Sku, Name and Quantity, a zero ListPrice makes them free
+func (bc *Client) CartAddCustomItems(cartID string, customItems []LineItem) (*Cart, error) {
+	err := validateCustomItems(customItems)
+	if err != nil {
+		return nil, err
+	}
+	var body []byte
+	body, _ = bc.marshal(map[string]interface{}{
+		"custom_items": newCustomItems(customItems),
+	})
+	req := bc.getAPIRequest(http.MethodPost, "/v3/carts/"+cartID+"/items?include=redirect_urls", bytes.NewReader(body))
+	res, err := bc.do(req)
+	if err != nil {
+		return nil, err
+	}
+	b, err := processBody(res)
+	if err != nil {
+		return nil, fmt.Errorf("%s", string(b))
+	}
+	var cartResponse struct {
+		Data Cart `json:"data,omitempty"`
+	}
+	err = bc.unmarshal(b, &cartResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &cartResponse.Data, nil
+}
+
+// customItem is a custom item as it is sent, list_price is always included so free items are priced 0
+// instead of rejected
+type customItem struct {
+	Sku       string  `json:"sku"`
+	Name      string  `json:"name"`
+	Quantity  float64 `json:"quantity"`
+	ListPrice float64 `json:"list_price"`
+	ImageURL  string  `json:"image_url,omitempty"`
+}
+
+func newCustomItems(items []LineItem) []customItem {
+	ret := make([]customItem, len(items))
+	for i, item := range items {
+		ret[i] = customItem{Sku: item.Sku, Name: item.Name, Quantity: item.Quantity, ListPrice: item.ListPrice, ImageURL: item.ImageURL}
+	}
+	return ret
+}
+
+func validateCustomItems(customItems []LineItem) error {
+	for i, item := range customItems {
+		if item.Sku == "" || item.Name == "" || item.Quantity <= 0 {
+			return fmt.Errorf("custom item %d: sku, name and quantity are required", i)
+		}
+		if item.ListPrice < 0 {
+			return fmt.Errorf("custom item %s: negative list price", item.Sku)
+		}
+	}
+	return nil
+}
+
 // EditItem edits a line item in a cart, returns the updated cart
 // Arguments:
 // 		cartID: the cart ID
 // 		item: the line item to edit. Must have an ID, quantity, and product ID
 func (bc *Client) CartEditItem(cartID string, item LineItem) (*Cart, error) {
 	var body []byte
-	body, _ = json.Marshal(map[string]interface{}{
+	body, _ = bc.marshal(map[string]interface{}{
 		"line_item": item,
 	})
 	req := bc.getAPIRequest(http.MethodPut, "/v3/carts/"+cartID+"/items/"+item.ID+"?include=redirect_urls", bytes.NewReader(body))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -177,7 +251,7 @@ func (bc *Client) CartEditItem(cartID string, item LineItem) (*Cart, error) {
 // returns nil for empty cart
 func (bc *Client) CartDeleteItem(cartID string, item LineItem) (*Cart, error) {
 	req := bc.getAPIRequest(http.MethodDelete, "/v3/carts/"+cartID+"/items/"+item.ID+"?include=redirect_urls", nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -198,7 +272,7 @@ func (bc *Client) CartDeleteItem(cartID string, item LineItem) (*Cart, error) {
 func (bc *Client) CartUpdateCustomerID(cartID, customerID string) (*Cart, error) {
 	req := bc.getAPIRequest(http.MethodPut, "/v3/carts/"+cartID+"?include=redirect_urls",
 		bytes.NewReader([]byte(fmt.Sprintf(`{"customer_id": %s}`, customerID))))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -209,7 +283,7 @@ func (bc *Client) CartUpdateCustomerID(cartID, customerID string) (*Cart, error)
 	var cartResponse struct {
 		Data Cart `json:"data,omitempty"`
 	}
-	err = json.Unmarshal(b, &cartResponse)
+	err = bc.unmarshal(b, &cartResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -219,12 +293,13 @@ func (bc *Client) CartUpdateCustomerID(cartID, customerID string) (*Cart, error)
 // DeleteCart deletes a cart by ID from BigCommerce
 func (bc *Client) DeleteCart(cartID string) error {
 	req := bc.getAPIRequest(http.MethodDelete, "/v3/carts/"+cartID, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return err
 	}
-	if res.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("unexpected status code: %d", res.StatusCode)
+	_, err = processBody(res)
+	if err != nil && err != ErrNoContent {
+		return err
 	}
 	return nil
 }
@@ -0,0 +1,53 @@
+package bigcommerce
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCartCustomItemsSendListPrice(t *testing.T) {
+	var sent []map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var payload struct {
+			CustomItems []map[string]interface{} `json:"custom_items"`
+		}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Error(err)
+		}
+		sent = payload.CustomItems
+		w.Write([]byte(`{"data": {"id": "cart"}}`))
+	}))
+	defer srv.Close()
+	bc := newTestClient(srv)
+
+	items := []LineItem{
+		{Sku: "GIFT", Name: "Free gift", Quantity: 1},
+		{Sku: "WRAP", Name: "Gift wrap", Quantity: 2, ListPrice: 2.5},
+	}
+	if _, err := bc.CreateCartWithCustomItems(nil, items); err != nil {
+		t.Fatal(err)
+	}
+	if len(sent) != 2 {
+		t.Fatalf("got %d custom items, want 2", len(sent))
+	}
+	for i, want := range []float64{0, 2.5} {
+		price, ok := sent[i]["list_price"]
+		if !ok || price != want {
+			t.Errorf("custom item %d: got list_price %v (sent: %v), want %g", i, price, ok, want)
+		}
+	}
+
+	if _, err := bc.CartAddCustomItems("cart", items[:1]); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := sent[0]["list_price"]; !ok {
+		t.Error("CartAddCustomItems sent a free custom item without list_price")
+	}
+	if err := validateCustomItems([]LineItem{{Sku: "X", Name: "X", Quantity: 1, ListPrice: -1}}); err == nil {
+		t.Error("got no error for a negative list price")
+	}
+}
@@ -0,0 +1,263 @@
+package bigcommerce
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrItemDoesNotFit is returned by CartonPacker.Pack for items larger or heavier than every box
+var ErrItemDoesNotFit = errors.New("item fits in none of the boxes")
+
+// Box is a carton size for CartonPacker, dimensions and weight are in the store's units
+type Box struct {
+	Name   string  `json:"name"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+	Depth  float64 `json:"depth"`
+	// MaxWeight is the most the box may weigh, 0 for no limit
+	MaxWeight float64 `json:"max_weight"`
+}
+
+// Volume returns the inner volume of the box
+func (b Box) Volume() float64 {
+	return b.Width * b.Height * b.Depth
+}
+
+// fits returns true if an item of the dimensions fits in the box, in any orientation
+func (b Box) fits(width, height, depth float64) bool {
+	box := []float64{b.Width, b.Height, b.Depth}
+	item := []float64{width, height, depth}
+	sort.Float64s(box)
+	sort.Float64s(item)
+	for i := range box {
+		if item[i] > box[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// PackItem is an order product to pack, dimensions and weight are per unit
+type PackItem struct {
+	OrderProductID int64   `json:"order_product_id"`
+	OrderAddressID int64   `json:"order_address_id"`
+	ProductID      int64   `json:"product_id"`
+	Sku            string  `json:"sku"`
+	Quantity       int     `json:"quantity"`
+	Width          float64 `json:"width"`
+	Height         float64 `json:"height"`
+	Depth          float64 `json:"depth"`
+	Weight         float64 `json:"weight"`
+}
+
+func (i PackItem) volume() float64 {
+	return i.Width * i.Height * i.Depth
+}
+
+// Carton is a box CartonPacker filled, ready to be shipped with CreateOrderShipment
+type Carton struct {
+	Box            Box            `json:"box"`
+	OrderAddressID int64          `json:"order_address_id"`
+	Items          []ShipmentItem `json:"items"`
+	// Weight and Volume are the totals of the items in the carton, Weight without the box itself
+	Weight float64 `json:"weight"`
+	Volume float64 `json:"volume"`
+
+	packed []PackItem
+}
+
+// Shipment returns a shipment of the carton's items, set the tracking number before creating it
+func (c *Carton) Shipment() Shipment {
+	return Shipment{
+		OrderAddressId: c.OrderAddressID,
+		Items:          append([]ShipmentItem{}, c.Items...),
+	}
+}
+
+func (c *Carton) add(item PackItem) {
+	c.Weight += item.Weight
+	c.Volume += item.volume()
+	c.packed = append(c.packed, item)
+	for i := range c.Items {
+		if c.Items[i].OrderProductId == item.OrderProductID {
+			c.Items[i].Quantity++
+			return
+		}
+	}
+	c.Items = append(c.Items, ShipmentItem{OrderProductId: item.OrderProductID, ProductId: item.ProductID, Quantity: 1})
+}
+
+// CartonPacker proposes how to split an order into shipments by packing its items into boxes,
+// first fit decreasing: the largest items go first, each into the first carton it fits
+type CartonPacker struct {
+	Boxes []Box
+	// Fill is the part of a box's volume that can be filled, 1 when 0, e.g. 0.8 to leave room for padding
+	Fill float64
+}
+
+// NewCartonPacker returns a packer filling the boxes completely
+func NewCartonPacker(boxes ...Box) *CartonPacker {
+	return &CartonPacker{Boxes: boxes, Fill: 1}
+}
+
+func (p *CartonPacker) capacity(b Box) float64 {
+	if p.Fill <= 0 || p.Fill > 1 {
+		return b.Volume()
+	}
+	return b.Volume() * p.Fill
+}
+
+// canHold returns true if box b can take item on top of the items already in c
+func (p *CartonPacker) canHold(b Box, c *Carton, item PackItem) bool {
+	if !b.fits(item.Width, item.Height, item.Depth) {
+		return false
+	}
+	if b.MaxWeight > 0 && c.Weight+item.Weight > b.MaxWeight {
+		return false
+	}
+	return c.Volume+item.volume() <= p.capacity(b)
+}
+
+// Pack packs the items into cartons, items for different addresses never share a carton. Each carton gets
+// the smallest box its items fit in. Volume is a rough measure, check cartons of oddly shaped items by hand
+func (p *CartonPacker) Pack(items []PackItem) ([]Carton, error) {
+	if len(p.Boxes) == 0 {
+		return nil, errors.New("no boxes to pack into")
+	}
+	boxes := append([]Box{}, p.Boxes...)
+	sort.SliceStable(boxes, func(i, j int) bool {
+		return boxes[i].Volume() > boxes[j].Volume()
+	})
+	units := []PackItem{}
+	for _, item := range items {
+		for n := 0; n < item.Quantity; n++ {
+			units = append(units, item)
+		}
+	}
+	sort.SliceStable(units, func(i, j int) bool {
+		if units[i].volume() != units[j].volume() {
+			return units[i].volume() > units[j].volume()
+		}
+		return units[i].Weight > units[j].Weight
+	})
+
+	cartons := []*Carton{}
+	for _, unit := range units {
+		var carton *Carton
+		for _, c := range cartons {
+			if c.OrderAddressID == unit.OrderAddressID && p.canHold(c.Box, c, unit) {
+				carton = c
+				break
+			}
+		}
+		if carton == nil {
+			// open the largest box the item fits, it is made smaller once everything is packed
+			for _, b := range boxes {
+				if p.canHold(b, &Carton{}, unit) {
+					carton = &Carton{Box: b, OrderAddressID: unit.OrderAddressID, Items: []ShipmentItem{}}
+					cartons = append(cartons, carton)
+					break
+				}
+			}
+		}
+		if carton == nil {
+			return nil, fmt.Errorf("%w: %s %gx%gx%g weighing %g", ErrItemDoesNotFit, unit.Sku, unit.Width, unit.Height, unit.Depth, unit.Weight)
+		}
+		carton.add(unit)
+	}
+
+	ret := make([]Carton, 0, len(cartons))
+	for _, c := range cartons {
+		for i := len(boxes) - 1; i >= 0; i-- {
+			if p.holdsAll(boxes[i], c) {
+				c.Box = boxes[i]
+				break
+			}
+		}
+		ret = append(ret, *c)
+	}
+	return ret, nil
+}
+
+// holdsAll returns true if box b can take all items of c
+func (p *CartonPacker) holdsAll(b Box, c *Carton) bool {
+	if b.MaxWeight > 0 && c.Weight > b.MaxWeight {
+		return false
+	}
+	if c.Volume > p.capacity(b) {
+		return false
+	}
+	for _, item := range c.packed {
+		if !b.fits(item.Width, item.Height, item.Depth) {
+			return false
+		}
+	}
+	return true
+}
+
+// PackOrder packs the physical order products not shipped yet into cartons, with the dimensions of their
+// variants or products, see CartonPacker.Pack:
+//
+//	cartons, err := bc.PackOrder(orderID, bigcommerce.NewCartonPacker(small, large))
+//	for _, c := range cartons {
+//		shipment := c.Shipment()
+//		shipment.TrackingNumber = label(c)
+//		_, err = bc.CreateOrderShipment(orderID, shipment)
+//	}
+func (bc *Client) PackOrder(orderID int64, packer *CartonPacker) ([]Carton, error) {
+	orderProducts, err := bc.GetOrderProducts(orderID)
+	if err != nil {
+		return nil, err
+	}
+	productIDs := []int64{}
+	seen := map[int64]bool{}
+	for _, op := range orderProducts {
+		if op.Type != "digital" && !seen[op.ProductID] {
+			seen[op.ProductID] = true
+			productIDs = append(productIDs, op.ProductID)
+		}
+	}
+	products := map[int64]Product{}
+	for start := 0; start < len(productIDs); start += 50 {
+		end := start + 50
+		if end > len(productIDs) {
+			end = len(productIDs)
+		}
+		ps, err := ListPages[Product](bc, "/v3/catalog/products", map[string]string{
+			"id:in":   joinIDs(productIDs[start:end]),
+			"include": "variants",
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range ps {
+			products[p.ID] = p
+		}
+	}
+
+	items := []PackItem{}
+	for _, op := range orderProducts {
+		qty := op.QuantityToShip()
+		if qty <= 0 || op.Type == "digital" {
+			continue
+		}
+		item := PackItem{
+			OrderProductID: op.ID,
+			OrderAddressID: op.OrderAddressID,
+			ProductID:      op.ProductID,
+			Sku:            op.Sku,
+			Quantity:       qty,
+			Weight:         parseAmount(op.Weight),
+		}
+		p := products[op.ProductID]
+		item.Width, item.Height, item.Depth = p.Width, p.Height, p.Depth
+		for _, v := range p.Variants {
+			if v.ID == op.VariantID && v.Width > 0 && v.Height > 0 && v.Depth > 0 {
+				item.Width, item.Height, item.Depth = v.Width, v.Height, v.Depth
+			}
+		}
+		items = append(items, item)
+	}
+	return packer.Pack(items)
+}
@@ -1,11 +1,8 @@
 package bigcommerce
 
 import (
-	"encoding/json"
 	"fmt"
-	"net/http"
 	"sort"
-	"strconv"
 )
 
 // Category is a BC category object
@@ -69,35 +66,11 @@ func (bc *Client) GetAllCategories(args map[string]string) ([]Category, error) {
 // args is a map of arguments to pass to the API
 // page: the page number to download
 func (bc *Client) GetCategories(args map[string]string, page int) ([]Category, bool, error) {
-	fpart := ""
-	for k, v := range args {
-		fpart += "&" + k + "=" + v
-	}
-	url := "/v3/catalog/categories?page=" + strconv.Itoa(page) + fpart
-
-	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
-	if err != nil {
-		return nil, false, err
-	}
-
-	defer res.Body.Close()
-	body, err := processBody(res)
-	if err != nil {
-		return nil, false, err
-	}
-
-	var pp struct {
-		Data []Category `json:"data"`
-		Meta struct {
-			Pagination Pagination `json:"pagination"`
-		} `json:"meta"`
-	}
-	err = json.Unmarshal(body, &pp)
+	p, err := ListPage[Category](bc, newURL("/v3/catalog/categories").Int("page", int64(page)).Args(args).String())
 	if err != nil {
 		return nil, false, err
 	}
-	return pp.Data, pp.Meta.Pagination.CurrentPage < pp.Meta.Pagination.TotalPages, nil
+	return p.Data, p.More(), nil
 }
 
 func (bc *Client) getFullCategoryName(cats map[int64]Category, i int64) string {
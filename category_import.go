@@ -0,0 +1,72 @@
+package bigcommerce
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// CategoryNode is a category to create with ImportCategoryTree, with its subcategories
+type CategoryNode struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Visible     bool   `json:"is_visible"`
+	PageTitle   string `json:"page_title,omitempty"`
+	ImageURL    string `json:"image_url,omitempty"`
+	// SortOrder is the position among its siblings, 0 keeps the order of the input
+	SortOrder int             `json:"sort_order"`
+	Children  []*CategoryNode `json:"-"`
+}
+
+// ImportCategoryTree creates a tree of categories under parentID (0 for top level categories) level by level,
+// so every parent exists before its children, keeping the order of siblings in the input.
+// It returns the ID created for each node; when creating one fails the categories created so far are
+// returned with the error
+func (bc *Client) ImportCategoryTree(tree []*CategoryNode, parentID int64) (map[*CategoryNode]int64, error) {
+	type pending struct {
+		node     *CategoryNode
+		parentID int64
+		position int
+	}
+	defer bc.ResetCategoryCache()
+	ids := map[*CategoryNode]int64{}
+	level := []pending{}
+	for i, n := range tree {
+		level = append(level, pending{n, parentID, i})
+	}
+	for len(level) > 0 {
+		next := []pending{}
+		for _, p := range level {
+			id, err := bc.createCategoryNode(p.node, p.parentID, p.position)
+			if err != nil {
+				return ids, err
+			}
+			ids[p.node] = id
+			for i, c := range p.node.Children {
+				next = append(next, pending{c, id, i})
+			}
+		}
+		level = next
+	}
+	return ids, nil
+}
+
+func (bc *Client) createCategoryNode(n *CategoryNode, parentID int64, position int) (int64, error) {
+	payload := struct {
+		*CategoryNode
+		ParentID int64 `json:"parent_id"`
+	}{n, parentID}
+	if n.SortOrder == 0 {
+		// space the positions so categories can be put in between later
+		c := *n
+		c.SortOrder = (position + 1) * 10
+		payload.CategoryNode = &c
+	}
+	var ret struct {
+		Data Category `json:"data"`
+	}
+	err := bc.sendJSON(http.MethodPost, "/v3/catalog/categories", payload, &ret)
+	if err != nil {
+		return 0, fmt.Errorf("error creating category %q: %w", n.Name, err)
+	}
+	return ret.Data.ID, nil
+}
@@ -0,0 +1,92 @@
+package bigcommerce
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CategoryPathSeparator separates category names in paths passed to ResolveCategoryByPath
+const CategoryPathSeparator = "/"
+
+// CategoryCrumb is one step of a category breadcrumb
+type CategoryCrumb struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetCategoryPath returns the breadcrumb of a category, root first and the category itself last
+func (bc *Client) GetCategoryPath(categoryID int64) ([]CategoryCrumb, error) {
+	cats, err := bc.categoryTree()
+	if err != nil {
+		return nil, err
+	}
+	path := []CategoryCrumb{}
+	for id := categoryID; id != 0; {
+		c, ok := cats[id]
+		if !ok {
+			return nil, ErrNotFound
+		}
+		path = append([]CategoryCrumb{{ID: c.ID, Name: c.Name}}, path...)
+		if len(path) > len(cats) {
+			return nil, fmt.Errorf("category %d: parent loop", categoryID)
+		}
+		id = c.ParentID
+	}
+	return path, nil
+}
+
+// ResolveCategoryByPath returns the category at a path of names like "Men/Shoes/Boots",
+// names are matched case insensitive, ErrNotFound if a step doesn't exist
+func (bc *Client) ResolveCategoryByPath(path string) (*Category, error) {
+	cats, err := bc.categoryTree()
+	if err != nil {
+		return nil, err
+	}
+	var parentID int64
+	var found *Category
+	for _, name := range strings.Split(strings.Trim(path, CategoryPathSeparator), CategoryPathSeparator) {
+		name = strings.TrimSpace(name)
+		found = nil
+		for _, c := range cats {
+			if c.ParentID == parentID && strings.EqualFold(strings.TrimSpace(c.Name), name) {
+				if found == nil || c.ID < found.ID {
+					c := c
+					found = &c
+				}
+			}
+		}
+		if found == nil {
+			return nil, ErrNotFound
+		}
+		parentID = found.ID
+	}
+	return found, nil
+}
+
+// ResetCategoryCache drops the cached category tree, the next path lookup fetches it again
+func (bc *Client) ResetCategoryCache() {
+	s := bc.sharedState()
+	s.categoriesMu.Lock()
+	defer s.categoriesMu.Unlock()
+	s.categories = nil
+}
+
+// categoryTree returns all categories by ID, cached after the first call
+func (bc *Client) categoryTree() (map[int64]Category, error) {
+	s := bc.sharedState()
+	s.categoriesMu.Lock()
+	defer s.categoriesMu.Unlock()
+	if s.categories != nil {
+		return s.categories, nil
+	}
+	cs, err := bc.GetAllCategories(map[string]string{"limit": "250"})
+	if err != nil {
+		return nil, err
+	}
+	cats := map[int64]Category{}
+	for _, c := range cs {
+		cats[c.ID] = c
+	}
+	s.categories = cats
+	return cats, nil
+}
@@ -0,0 +1,54 @@
+package bigcommerce
+
+import (
+	"sort"
+	"time"
+)
+
+// ListProductsModifiedSince returns the products modified at or after t, oldest change first (ties by ID),
+// for incremental syncs: store the DateModified of the last product processed and pass it to the next run.
+// The bound is inclusive, so the last product of a run is returned again by the next one
+// args is a key-value map of additional arguments to pass to the API, e.g. include or include_fields
+func (bc *Client) ListProductsModifiedSince(t time.Time, args map[string]string) ([]Product, error) {
+	query := map[string]string{}
+	for k, v := range args {
+		query[k] = v
+	}
+	query["date_modified:min"] = t.UTC().Format(time.RFC3339)
+	query["sort"] = "date_modified"
+	query["direction"] = "asc"
+	if query["limit"] == "" {
+		query["limit"] = "250"
+	}
+	products := []Product{}
+	err := bc.scanProducts(query, func(p *Product) {
+		products = append(products, *p)
+	})
+	if err != nil {
+		return products, err
+	}
+	// products modified while we were paging can move between pages, sort what we got
+	sort.SliceStable(products, func(i, j int) bool {
+		if !products[i].DateModified.Equal(products[j].DateModified) {
+			return products[i].DateModified.Before(products[j].DateModified)
+		}
+		return products[i].ID < products[j].ID
+	})
+	return products, nil
+}
+
+// ListVariantsModifiedSince returns the variants of the products modified at or after t, in product order
+// as ListProductsModifiedSince. Variants have no modification date of their own, changing a variant
+// updates the date_modified of its product, so all variants of a changed product are returned.
+// Like the products they are in bc.TargetUnits if set
+func (bc *Client) ListVariantsModifiedSince(t time.Time) ([]Variant, error) {
+	products, err := bc.ListProductsModifiedSince(t, map[string]string{"include": "variants"})
+	if err != nil {
+		return nil, err
+	}
+	variants := []Variant{}
+	for _, p := range products {
+		variants = append(variants, p.Variants...)
+	}
+	return variants, nil
+}
@@ -1,9 +1,8 @@
 package bigcommerce
 
 import (
-	"encoding/json"
-	"errors"
-	"io/ioutil"
+	"bytes"
+	"fmt"
 	"log"
 	"net/http"
 	"strconv"
@@ -21,7 +20,7 @@ type Channel struct {
 	IsEnabled        bool      `json:"is_enabled"`
 	DateModified     time.Time `json:"date_modified"`
 	Name             string    `json:"name"`
-	ID               int       `json:"id"`
+	ID               int64     `json:"id"`
 	Status           string    `json:"status"`
 }
 
@@ -49,36 +48,103 @@ func (bc *Client) GetAllChannels() ([]Channel, error) {
 }
 
 func (bc *Client) GetChannels(page int) ([]Channel, bool, error) {
-	url := "/v3/channels?page=" + strconv.Itoa(page)
-
-	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	p, err := ListPage[Channel](bc, newURL("/v3/channels").Int("page", int64(page)).String())
 	if err != nil {
 		return nil, false, err
 	}
+	return p.Data, p.More(), nil
+}
+
+// Channel statuses accepted by UpdateChannelStatus
+const (
+	ChannelStatusActive      = "active"
+	ChannelStatusPrelaunch   = "prelaunch"
+	ChannelStatusMaintenance = "maintenance"
+	ChannelStatusInactive    = "inactive"
+)
+
+// StorefrontStatusSettings are the storefront status messages of a channel
+type StorefrontStatusSettings struct {
+	DownForMaintenanceMessage string `json:"down_for_maintenance_message,omitempty"`
+	PrelaunchMessage          string `json:"prelaunch_message,omitempty"`
+	PrelaunchPassword         string `json:"prelaunch_password,omitempty"`
+}
+
+// UpdateChannelStatus sets the status of a channel, e.g. ChannelStatusMaintenance
+func (bc *Client) UpdateChannelStatus(channelID int64, status string) (*Channel, error) {
+	reqJSON, _ := bc.marshal(map[string]string{"status": status})
+	req := bc.getAPIRequest(http.MethodPut, "/v3/channels/"+strconv.FormatInt(channelID, 10), bytes.NewReader(reqJSON))
+	res, err := bc.do(req)
+	if err != nil {
+		return nil, err
+	}
 	defer res.Body.Close()
-	if res.StatusCode == http.StatusNoContent {
-		return nil, false, ErrNoContent
+	body, err := processBody(res)
+	if err != nil {
+		return nil, fmt.Errorf("error updating channel %d status: %w %s", channelID, err, string(body))
+	}
+	var channelResponse struct {
+		Data Channel `json:"data"`
+	}
+	err = bc.unmarshal(body, &channelResponse)
+	if err != nil {
+		return nil, err
 	}
+	return &channelResponse.Data, nil
+}
 
-	body, err := ioutil.ReadAll(res.Body)
+// GetStorefrontStatusSettings returns the storefront status messages of a channel
+func (bc *Client) GetStorefrontStatusSettings(channelID int64) (*StorefrontStatusSettings, error) {
+	req := bc.getAPIRequest(http.MethodGet, "/v3/settings/storefront/status?channel_id="+strconv.FormatInt(channelID, 10), nil)
+	res, err := bc.do(req)
 	if err != nil {
-		return nil, false, err
+		return nil, err
+	}
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil {
+		return nil, err
 	}
-	var pp struct {
-		Status int       `json:"status"`
-		Title  string    `json:"title"`
-		Data   []Channel `json:"data"`
-		Meta   struct {
-			Pagination Pagination `json:"pagination"`
-		} `json:"meta"`
+	var settingsResponse struct {
+		Data StorefrontStatusSettings `json:"data"`
 	}
-	err = json.Unmarshal(body, &pp)
+	err = bc.unmarshal(body, &settingsResponse)
 	if err != nil {
-		return nil, false, err
+		return nil, err
+	}
+	return &settingsResponse.Data, nil
+}
+
+// UpdateStorefrontStatusSettings updates the storefront status messages of a channel
+func (bc *Client) UpdateStorefrontStatusSettings(channelID int64, settings StorefrontStatusSettings) error {
+	reqJSON, _ := bc.marshal(settings)
+	req := bc.getAPIRequest(http.MethodPut, "/v3/settings/storefront/status?channel_id="+strconv.FormatInt(channelID, 10), bytes.NewReader(reqJSON))
+	res, err := bc.do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil {
+		return fmt.Errorf("error updating storefront status settings: %w %s", err, string(body))
 	}
-	if pp.Status != 0 {
-		return nil, false, errors.New(pp.Title)
+	return nil
+}
+
+// SetChannelMaintenance takes a channel's storefront down for maintenance, showing message when not empty
+func (bc *Client) SetChannelMaintenance(channelID int64, message string) error {
+	if message != "" {
+		err := bc.UpdateStorefrontStatusSettings(channelID, StorefrontStatusSettings{DownForMaintenanceMessage: message})
+		if err != nil {
+			return err
+		}
 	}
-	return pp.Data, pp.Meta.Pagination.CurrentPage < pp.Meta.Pagination.TotalPages, nil
+	_, err := bc.UpdateChannelStatus(channelID, ChannelStatusMaintenance)
+	return err
+}
+
+// SetChannelActive brings a channel's storefront back up
+func (bc *Client) SetChannelActive(channelID int64) error {
+	_, err := bc.UpdateChannelStatus(channelID, ChannelStatusActive)
+	return err
 }
@@ -0,0 +1,348 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Checkout is a BigCommerce checkout object, its ID is the cart ID
+type Checkout struct {
+	ID                      string                    `json:"id"`
+	Cart                    Cart                      `json:"cart"`
+	BillingAddress          CheckoutAddress           `json:"billing_address"`
+	Consignments            []Consignment             `json:"consignments"`
+	Coupons                 []CartCoupon              `json:"coupons"`
+	GiftCertificates        []CheckoutGiftCertificate `json:"gift_certificates"`
+	OrderID                 int64                     `json:"order_id"`
+	ShippingCostTotalIncTax float64                   `json:"shipping_cost_total_inc_tax"`
+	ShippingCostTotalExTax  float64                   `json:"shipping_cost_total_ex_tax"`
+	HandlingCostTotalIncTax float64                   `json:"handling_cost_total_inc_tax"`
+	HandlingCostTotalExTax  float64                   `json:"handling_cost_total_ex_tax"`
+	TaxTotal                float64                   `json:"tax_total"`
+	SubtotalIncTax          float64                   `json:"subtotal_inc_tax"`
+	SubtotalExTax           float64                   `json:"subtotal_ex_tax"`
+	GrandTotal              float64                   `json:"grand_total"`
+	CustomerMessage         string                    `json:"customer_message"`
+	CreatedTime             string                    `json:"created_time"`
+	UpdatedTime             string                    `json:"updated_time"`
+}
+
+// CheckoutGiftCertificate is a gift certificate applied to a checkout
+type CheckoutGiftCertificate struct {
+	Code         string  `json:"code"`
+	Balance      float64 `json:"balance"`
+	Remaining    float64 `json:"remaining"`
+	Used         float64 `json:"used"`
+	PurchaseDate string  `json:"purchase_date"`
+}
+
+// CheckoutAddress is a billing or shipping address on a checkout
+type CheckoutAddress struct {
+	ID                  string `json:"id,omitempty"`
+	FirstName           string `json:"first_name"`
+	LastName            string `json:"last_name"`
+	Email               string `json:"email,omitempty"`
+	Company             string `json:"company,omitempty"`
+	Address1            string `json:"address1"`
+	Address2            string `json:"address2,omitempty"`
+	City                string `json:"city"`
+	StateOrProvince     string `json:"state_or_province,omitempty"`
+	StateOrProvinceCode string `json:"state_or_province_code,omitempty"`
+	CountryCode         string `json:"country_code"`
+	PostalCode          string `json:"postal_code,omitempty"`
+	Phone               string `json:"phone,omitempty"`
+}
+
+// Consignment is a group of cart line items shipped to one address
+type Consignment struct {
+	ID                       string           `json:"id"`
+	ShippingAddress          CheckoutAddress  `json:"shipping_address"`
+	LineItemIDs              []string         `json:"line_item_ids"`
+	AvailableShippingOptions []ShippingOption `json:"available_shipping_options"`
+	SelectedShippingOption   *ShippingOption  `json:"selected_shipping_option"`
+	ShippingCostIncTax       float64          `json:"shipping_cost_inc_tax"`
+	ShippingCostExTax        float64          `json:"shipping_cost_ex_tax"`
+	HandlingCostIncTax       float64          `json:"handling_cost_inc_tax"`
+	HandlingCostExTax        float64          `json:"handling_cost_ex_tax"`
+}
+
+// ShippingOption is a shipping quote available for a consignment
+type ShippingOption struct {
+	ID                    string  `json:"id"`
+	Type                  string  `json:"type"`
+	Description           string  `json:"description"`
+	ImageURL              string  `json:"image_url"`
+	Cost                  float64 `json:"cost"`
+	TransitTime           string  `json:"transit_time"`
+	AdditionalDescription string  `json:"additional_description"`
+}
+
+// ConsignmentRequest assigns cart line items to a shipping address
+// ShippingOptionID is optional for CompleteCheckout, the first available option is selected when empty
+type ConsignmentRequest struct {
+	Address          CheckoutAddress       `json:"address"`
+	LineItems        []ConsignmentLineItem `json:"line_items"`
+	ShippingOptionID string                `json:"-"`
+}
+
+// ConsignmentLineItem is a cart line item reference in a ConsignmentRequest
+type ConsignmentLineItem struct {
+	ItemID   string `json:"item_id"`
+	Quantity int    `json:"quantity"`
+}
+
+// CheckoutError tells which step of CompleteCheckout failed
+// OrderID is set when the order was already created before the failing step
+type CheckoutError struct {
+	Step    string
+	OrderID int64
+	Err     error
+}
+
+const (
+	CheckoutStepBillingAddress = "billing_address"
+	CheckoutStepConsignments   = "consignments"
+	CheckoutStepShippingOption = "shipping_option"
+	CheckoutStepOrder          = "order"
+	CheckoutStepPayment        = "payment"
+)
+
+func (e *CheckoutError) Error() string {
+	if e.OrderID != 0 {
+		return fmt.Sprintf("checkout %s step failed (order %d): %v", e.Step, e.OrderID, e.Err)
+	}
+	return fmt.Sprintf("checkout %s step failed: %v", e.Step, e.Err)
+}
+
+func (e *CheckoutError) Unwrap() error {
+	return e.Err
+}
+
+// Validate checks the address has the fields BigCommerce requires for a billing address
+func (a CheckoutAddress) Validate() error {
+	missing := []string{}
+	for _, f := range [][2]string{
+		{"first_name", a.FirstName},
+		{"last_name", a.LastName},
+		{"email", a.Email},
+		{"address1", a.Address1},
+		{"city", a.City},
+		{"country_code", a.CountryCode},
+	} {
+		if strings.TrimSpace(f[1]) == "" {
+			missing = append(missing, f[0])
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("address is missing %s", strings.Join(missing, ", "))
+	}
+	if len(a.CountryCode) != 2 {
+		return fmt.Errorf("country_code must be an ISO 3166-1 alpha-2 code, got %s", a.CountryCode)
+	}
+	if !strings.Contains(a.Email, "@") {
+		return fmt.Errorf("invalid email %s", a.Email)
+	}
+	return nil
+}
+
+// GetCheckout returns the checkout for a cart
+func (bc *Client) GetCheckout(checkoutID string) (*Checkout, error) {
+	return bc.checkoutRequest(http.MethodGet, "/v3/checkouts/"+checkoutID+"?include=consignments.available_shipping_options", nil)
+}
+
+// SetCheckoutBillingAddress validates and adds (or replaces) the billing address of a checkout
+func (bc *Client) SetCheckoutBillingAddress(checkoutID string, address CheckoutAddress) (*Checkout, error) {
+	err := address.Validate()
+	if err != nil {
+		return nil, err
+	}
+	if address.ID != "" {
+		return bc.checkoutRequest(http.MethodPut, "/v3/checkouts/"+checkoutID+"/billing-address/"+address.ID, address)
+	}
+	return bc.checkoutRequest(http.MethodPost, "/v3/checkouts/"+checkoutID+"/billing-address", address)
+}
+
+// AddCheckoutConsignments adds consignments to a checkout, the returned checkout includes available shipping options
+func (bc *Client) AddCheckoutConsignments(checkoutID string, consignments []ConsignmentRequest) (*Checkout, error) {
+	return bc.checkoutRequest(http.MethodPost, "/v3/checkouts/"+checkoutID+"/consignments?include=consignments.available_shipping_options", consignments)
+}
+
+// CreateCheckoutOrder creates an order from a checkout and returns the order ID
+func (bc *Client) CreateCheckoutOrder(checkoutID string) (int64, error) {
+	req := bc.getAPIRequest(http.MethodPost, "/v3/checkouts/"+checkoutID+"/orders", nil)
+	res, err := bc.do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil {
+		return 0, fmt.Errorf("%w %s", err, string(body))
+	}
+	var orderResponse struct {
+		Data struct {
+			ID int64 `json:"id"`
+		} `json:"data"`
+	}
+	err = bc.unmarshal(body, &orderResponse)
+	if err != nil {
+		return 0, err
+	}
+	bc.recordConversion(checkoutID, orderResponse.Data.ID)
+	return orderResponse.Data.ID, nil
+}
+
+// CompleteCheckout runs the whole server-side checkout for a cart:
+// billing address, consignments with shipping option selection, order creation and (optional) payment.
+// Returns the created order ID, errors are *CheckoutError telling which step failed
+func (bc *Client) CompleteCheckout(cartID string, billing CheckoutAddress, consignments []ConsignmentRequest, payment *PaymentRequest) (int64, error) {
+	_, err := bc.SetCheckoutBillingAddress(cartID, billing)
+	if err != nil {
+		return 0, &CheckoutError{Step: CheckoutStepBillingAddress, Err: err}
+	}
+
+	for _, cr := range consignments {
+		if len(cr.LineItems) == 0 {
+			return 0, &CheckoutError{Step: CheckoutStepConsignments, Err: errors.New("consignment without line items")}
+		}
+		checkout, err := bc.AddCheckoutConsignments(cartID, []ConsignmentRequest{cr})
+		if err != nil {
+			return 0, &CheckoutError{Step: CheckoutStepConsignments, Err: err}
+		}
+		consignment := findConsignment(checkout.Consignments, cr.LineItems[0].ItemID)
+		if consignment == nil {
+			return 0, &CheckoutError{Step: CheckoutStepConsignments, Err: fmt.Errorf("no consignment created for line item %s", cr.LineItems[0].ItemID)}
+		}
+		optionID := cr.ShippingOptionID
+		if optionID == "" {
+			if len(consignment.AvailableShippingOptions) == 0 {
+				return 0, &CheckoutError{Step: CheckoutStepShippingOption, Err: fmt.Errorf("no shipping options for consignment %s", consignment.ID)}
+			}
+			optionID = consignment.AvailableShippingOptions[0].ID
+		}
+		_, err = bc.UpdateConsignmentShippingOption(cartID, consignment.ID, optionID)
+		if err != nil {
+			return 0, &CheckoutError{Step: CheckoutStepShippingOption, Err: err}
+		}
+	}
+
+	orderID, err := bc.CreateCheckoutOrder(cartID)
+	if err != nil {
+		return 0, &CheckoutError{Step: CheckoutStepOrder, Err: err}
+	}
+	if payment == nil {
+		return orderID, nil
+	}
+
+	_, err = bc.PayOrder(orderID, *payment)
+	if err != nil {
+		return orderID, &CheckoutError{Step: CheckoutStepPayment, OrderID: orderID, Err: err}
+	}
+	return orderID, nil
+}
+
+// GetConsignmentShippingOptions returns the shipping quotes available for a consignment of a checkout,
+// ErrNotFound if the checkout has no such consignment
+func (bc *Client) GetConsignmentShippingOptions(checkoutID, consignmentID string) ([]ShippingOption, error) {
+	checkout, err := bc.GetCheckout(checkoutID)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range checkout.Consignments {
+		if c.ID == consignmentID {
+			return c.AvailableShippingOptions, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// UpdateConsignmentShippingOption selects a shipping option for a consignment, shippingOptionID must be one
+// of the consignment's available shipping options. The returned checkout includes the updated shipping costs
+func (bc *Client) UpdateConsignmentShippingOption(checkoutID, consignmentID, shippingOptionID string) (*Checkout, error) {
+	return bc.checkoutRequest(http.MethodPut, "/v3/checkouts/"+checkoutID+"/consignments/"+consignmentID+"?include=consignments.available_shipping_options", map[string]string{
+		"shipping_option_id": shippingOptionID,
+	})
+}
+
+// ApplyCouponToCheckout applies a coupon code to a checkout, the returned checkout includes the discount.
+// BigCommerce answers 400 for unknown or expired codes and codes the cart doesn't qualify for
+func (bc *Client) ApplyCouponToCheckout(checkoutID, couponCode string) (*Checkout, error) {
+	return bc.checkoutRequest(http.MethodPost, "/v3/checkouts/"+checkoutID+"/coupons", map[string]string{
+		"coupon_code": couponCode,
+	})
+}
+
+// RemoveCoupon removes a coupon code applied to a checkout
+func (bc *Client) RemoveCoupon(checkoutID, couponCode string) (*Checkout, error) {
+	return bc.checkoutRequest(http.MethodDelete, "/v3/checkouts/"+checkoutID+"/coupons/"+url.PathEscape(couponCode), nil)
+}
+
+// ApplyGiftCertificateToCheckout applies a gift certificate to a checkout, its remaining balance
+// is deducted from the grand total. BigCommerce answers 400 for unknown or used up codes
+func (bc *Client) ApplyGiftCertificateToCheckout(checkoutID, giftCertificateCode string) (*Checkout, error) {
+	return bc.checkoutRequest(http.MethodPost, "/v3/checkouts/"+checkoutID+"/gift-certificates", map[string]string{
+		"giftCertificateCode": giftCertificateCode,
+	})
+}
+
+// RemoveGiftCertificateFromCheckout removes a gift certificate applied to a checkout
+func (bc *Client) RemoveGiftCertificateFromCheckout(checkoutID, giftCertificateCode string) (*Checkout, error) {
+	return bc.checkoutRequest(http.MethodDelete, "/v3/checkouts/"+checkoutID+"/gift-certificates/"+url.PathEscape(giftCertificateCode), nil)
+}
+
+// CheapestShippingOption returns the shipping option with the lowest cost, nil if there are none
+func CheapestShippingOption(options []ShippingOption) *ShippingOption {
+	var cheapest *ShippingOption
+	for i := range options {
+		if cheapest == nil || options[i].Cost < cheapest.Cost {
+			cheapest = &options[i]
+		}
+	}
+	return cheapest
+}
+
+// checkoutRequest sends a checkout API request and returns the checkout from the response
+func (bc *Client) checkoutRequest(method, url string, payload interface{}) (*Checkout, error) {
+	var reqBody io.Reader
+	if payload != nil {
+		reqJSON, err := bc.marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewReader(reqJSON)
+	}
+	req := bc.getAPIRequest(method, url, reqBody)
+	res, err := bc.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil {
+		return nil, fmt.Errorf("%w %s", err, string(body))
+	}
+	var checkoutResponse struct {
+		Data Checkout `json:"data"`
+	}
+	err = bc.unmarshal(body, &checkoutResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &checkoutResponse.Data, nil
+}
+
+func findConsignment(consignments []Consignment, lineItemID string) *Consignment {
+	for i := range consignments {
+		for _, id := range consignments[i].LineItemIDs {
+			if id == lineItemID {
+				return &consignments[i]
+			}
+		}
+	}
+	return nil
+}
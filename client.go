@@ -1,13 +1,20 @@
 package bigcommerce
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
+	"syscall"
 	"time"
 )
 
@@ -16,11 +23,78 @@ type Client struct {
 	XAuthToken string `json:"x-auth-token"`
 	MaxRetries int
 	HTTPClient HTTPClient
-	ChannelID  int
+	ChannelID  int64
+	// TargetUnits, when set, converts product weights and dimensions from the store's units
+	TargetUnits *UnitSystem
+	// AdjustmentSink, when set, records every inventory adjustment made through the client
+	AdjustmentSink AdjustmentSink
+	// StoreCreditSink, when set, records every store credit change made through the client
+	StoreCreditSink StoreCreditSink
+	// ConversionSink, when set, records the cart of every order created from a checkout through the client
+	ConversionSink ConversionSink
+	// IdempotencyStore, when set, remembers the shipments CreateOrderShipmentIdempotent created by key
+	IdempotencyStore IdempotencyStore
+	// RefreshToken, when set, is called when the API answers 401 to get a new X-Auth-Token,
+	// the failed request is then replayed once with the new token. The new token replaces XAuthToken
+	// for the client and all clients derived from it with WithContext, With and the like
+	RefreshToken func(storeHash, oldToken string) (string, error)
+	// Codec encodes and decodes JSON bodies, encoding/json when nil
+	Codec Codec
+	// Budget, when set, makes every request wait for a token
+	Budget *TokenBucket
+	// Retry, when set, retries requests answered with 429 or 5xx, NewClient sets DefaultRetryPolicy()
+	Retry *RetryPolicy
+	// Policies override Retry and the timeout per endpoint, keyed by method and resource like
+	// "POST /v2/orders/{id}/shipments", or by method only like "GET", see CallPolicy
+	Policies map[string]CallPolicy
+	// BaseURL is the API host requests are sent to, DefaultBaseURL when empty
+	BaseURL string
+	// APIHost, when set, is sent as Host header instead of the host of BaseURL, for proxies routing by host
+	APIHost string
+	// PaymentsBaseURL is the host payments are processed at, DefaultPaymentsBaseURL when empty
+	PaymentsBaseURL string
+	// UserAgent is the User-Agent header of requests, DefaultUserAgent when empty
+	UserAgent string
+	// Slog, when set, logs method, URL, status, latency and rate limit state of every request at debug level,
+	// with the X-Auth-Token header redacted
+	Slog *slog.Logger
+	// Tracer, when set, starts a span for every API call, see Tracer
+	Tracer Tracer
+	// Metrics, when set, receives the API usage of the client, see Metrics
+	Metrics Metrics
+	// Middleware wraps every request sent, see Middleware
+	Middleware []Middleware
+	// Logger, when set, logs retries and errors instead of the standard logger
+	Logger *log.Logger
+	// DegradeOnScopeErrors, when set, returns an *InsufficientScopeError matching ErrInsufficientScope for writes answered
+	// with 403 and fails further writes to the same resource without sending them, so pipelines mixing reads
+	// and writes can carry on with a read-only token
+	DegradeOnScopeErrors bool
+	// DryRun, when set, logs the method, path and body size of writes instead of sending them and returns ErrDryRun for them
+	DryRun bool
+	// Cache, when set, makes GET requests conditional on the ETag and Last-Modified of the responses
+	// stored in it, a 304 answer returns the stored response, see ResponseCache
+	Cache ResponseCache
+	// ThrottleBelow, when set, makes requests wait for the rate limit window to reset
+	// once BigCommerce reports this many requests left or fewer, see RateLimitStatus
+	ThrottleBelow int
+
+	shared     *sharedState
+	sharedMu   sync.Mutex
+	rawPayload *recorder[json.RawMessage]
+	responses  *recorder[Response]
+	ctx        context.Context
+	call       *requestOptions
+	rateLimit  *rateLimitTracker
+	rateMu     sync.Mutex
+	scopes     *scopeTracker
+	scopesMu   sync.Mutex
 }
 
 var ErrNoContent = errors.New("no content 204 from BigCommerce API")
 var ErrNoMainThumbnail = errors.New("no main thumbnail")
+
+// ErrNotFound is returned for 404 responses, as an *APIError matching it with errors.Is, and when a lookup finds nothing
 var ErrNotFound = errors.New("404 not found")
 
 // AuthContexter interface for GetAuthContext
@@ -28,8 +102,9 @@ type AuthContexter interface {
 	GetAuthContext(clientID, clientSecret string, q url.Values) (*AuthContext, error)
 }
 
-func NewClient(storeHash, xAuthToken string) *Client {
-	return &Client{
+// NewClient returns a client for a store, options change the defaults
+func NewClient(storeHash, xAuthToken string, opts ...Option) *Client {
+	bc := &Client{
 		StoreHash:  storeHash,
 		XAuthToken: xAuthToken,
 		MaxRetries: 1,
@@ -37,43 +112,409 @@ func NewClient(storeHash, xAuthToken string) *Client {
 			Timeout: time.Second * 10,
 		},
 		ChannelID: 1,
+		Retry:     DefaultRetryPolicy(),
+	}
+	for _, opt := range opts {
+		opt(bc)
+	}
+	return bc
+}
+
+// clone returns a copy of the client sharing its settings and caches, used for per call options.
+// New Client fields must be added here
+func (bc *Client) clone() *Client {
+	return &Client{
+		StoreHash:            bc.StoreHash,
+		XAuthToken:           bc.authToken(),
+		MaxRetries:           bc.MaxRetries,
+		HTTPClient:           bc.HTTPClient,
+		ChannelID:            bc.ChannelID,
+		TargetUnits:          bc.TargetUnits,
+		AdjustmentSink:       bc.AdjustmentSink,
+		StoreCreditSink:      bc.StoreCreditSink,
+		ConversionSink:       bc.ConversionSink,
+		IdempotencyStore:     bc.IdempotencyStore,
+		RefreshToken:         bc.RefreshToken,
+		Codec:                bc.Codec,
+		Budget:               bc.Budget,
+		Retry:                bc.Retry,
+		ThrottleBelow:        bc.ThrottleBelow,
+		Cache:                bc.Cache,
+		DegradeOnScopeErrors: bc.DegradeOnScopeErrors,
+		DryRun:               bc.DryRun,
+		Policies:             bc.Policies,
+		BaseURL:              bc.BaseURL,
+		APIHost:              bc.APIHost,
+		PaymentsBaseURL:      bc.PaymentsBaseURL,
+		UserAgent:            bc.UserAgent,
+		Logger:               bc.Logger,
+		Middleware:           bc.Middleware,
+		Slog:                 bc.Slog,
+		Tracer:               bc.Tracer,
+		Metrics:              bc.Metrics,
+		shared:               bc.sharedState(),
+		rawPayload:           bc.rawPayload,
+		responses:            bc.responses,
+		ctx:                  bc.ctx,
+		call:                 bc.call,
+		rateLimit:            bc.rateLimits(),
+		scopes:               bc.scopeTracker(),
+	}
+}
+
+// WithContext returns a client whose requests are bound to ctx, so callers can set deadlines and cancel calls:
+//
+//	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+//	defer cancel()
+//	shipments, err := bc.WithContext(ctx).GetOrderShipments(100, nil)
+//
+// the returned client shares the settings and caches of bc, waiting for Budget tokens is cancelled with ctx too
+func (bc *Client) WithContext(ctx context.Context) *Client {
+	c := bc.clone()
+	c.ctx = ctx
+	return c
+}
+
+// requestContext returns the context requests are made with, context.Background() when none was set
+func (bc *Client) requestContext() context.Context {
+	if bc.ctx == nil {
+		return context.Background()
 	}
+	return bc.ctx
 }
 
 func (bc *Client) getAPIRequest(method, url string, body io.Reader) *http.Request {
 	if !strings.HasPrefix(url, "/") {
 		url = "/" + url
 	}
-	fullURL := "https://api.bigcommerce.com/stores/" + bc.StoreHash + url
+	fullURL := bc.baseURL() + "/stores/" + bc.StoreHash + url
 
-	req, _ := http.NewRequest(method, fullURL, body)
+	req, _ := http.NewRequestWithContext(bc.requestContext(), method, fullURL, body)
 
-	req.Header.Add("X-Auth-Token", bc.XAuthToken)
+	req.Header.Add("X-Auth-Token", bc.authToken())
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add("User-Agent", "BigCommerce-Go-SDK")
+	req.Header.Add("User-Agent", bc.userAgent())
 	req.Header.Add("Cache-Control", "no-cache")
-	req.Header.Add("Host", "api.bigcommerce.com")
+	if bc.APIHost != "" {
+		req.Host = bc.APIHost
+	}
+	bc.applyRequestOptions(req)
 	req.Header.Add("Accept-Encoding", "none")
 	req.Header.Add("Connection", "keep-alive")
 	return req
 }
 
+// do sends an API request, all endpoints send their requests through it:
+// idempotent requests are retried up to MaxRetries times when the connection was reset before a response came back,
+// requests answered with 429 or 5xx are retried after a backoff as set by Retry, Policies or With,
+// and when the token was rejected and RefreshToken is set it refreshes the token and replays the request once.
+// Request bodies are recreated with GetBody for every attempt and discarded responses are drained
+func (bc *Client) do(req *http.Request) (res *http.Response, err error) {
+	if err := bc.checkWrite(req); err != nil {
+		return nil, err
+	}
+	policy := bc.callPolicy(req)
+	req, cancel := withTimeout(req, policy.Timeout)
+	req, endSpan := bc.traceRequest(req)
+	cached := bc.conditionalRequest(req)
+	start := time.Now()
+	attempts := 0
+	defer func() {
+		if err == nil && res != nil {
+			res, err = bc.cachedResponse(req, cached, res)
+		}
+		if err == nil && res != nil {
+			bc.recordResponse(req, res, start)
+			res, err = bc.scopeError(req, res)
+		}
+		if err != nil || res == nil {
+			cancel()
+		} else {
+			res.Body = cancelOnClose{res.Body, cancel}
+		}
+		retries := attempts - 1
+		if retries < 0 {
+			retries = 0
+		}
+		endSpan(res, retries, err)
+	}()
+	if bc.Budget != nil {
+		err := bc.Budget.WaitContext(req.Context(), 1)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if bc.ThrottleBelow > 0 {
+		err := sleepContext(req.Context(), bc.rateLimits().delay(bc.ThrottleBelow))
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err = bc.send(req, policy.Retry, &attempts)
+	if err != nil || res.StatusCode != http.StatusUnauthorized || bc.RefreshToken == nil {
+		return res, err
+	}
+	usedToken := req.Header.Get("X-Auth-Token")
+	if usedToken == "" {
+		return res, err // not authenticated with X-Auth-Token, e.g. payments
+	}
+	if req.Body != nil && req.GetBody == nil {
+		return res, err // body can't be replayed
+	}
+	token, rerr := bc.refreshToken(usedToken)
+	if rerr != nil {
+		bc.logf("error refreshing X-Auth-Token: %v", rerr)
+		return res, err
+	}
+	drainBody(res)
+
+	retry, err := replayRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	retry.Header.Set("X-Auth-Token", token)
+	return bc.send(retry, policy.Retry, &attempts)
+}
+
+// send sends a request, retrying idempotent requests on connection resets
+// and, with a retry policy, throttled and failed requests after a backoff, attempts counts the requests sent
+func (bc *Client) send(req *http.Request, retryPolicy *RetryPolicy, attempts *int) (*http.Response, error) {
+	*attempts++
+	res, err := bc.roundTrip(req)
+	resets, retries := 0, 0
+	for {
+		var delay time.Duration
+		switch {
+		case err != nil:
+			if resets >= bc.MaxRetries || !isIdempotent(req.Method) || !isConnectionReset(err) {
+				return res, err
+			}
+			resets++
+			bc.logf("%s %s: %v, retrying", req.Method, req.URL, err)
+		case retryPolicy != nil && retries+1 < retryPolicy.MaxAttempts && retryPolicy.retryable(req.Method, res.StatusCode):
+			var ok bool
+			delay, ok = retryPolicy.delay(retries, res)
+			if !ok {
+				return res, err
+			}
+			retries++
+			bc.logf("%s %s: %s, retrying in %s", req.Method, req.URL, res.Status, delay)
+		default:
+			return res, err
+		}
+		retry, rerr := replayRequest(req)
+		if rerr != nil {
+			return res, err
+		}
+		bc.observeRetry(req)
+		if res != nil {
+			drainBody(res)
+		}
+		serr := sleepContext(req.Context(), delay)
+		if serr != nil {
+			return nil, serr
+		}
+		*attempts++
+		res, err = bc.roundTrip(retry)
+	}
+}
+
+// roundTrip sends a request once through the middleware, recording the rate limit headers of the response
+func (bc *Client) roundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	res, err := bc.transport().RoundTrip(req)
+	if err == nil {
+		bc.rateLimits().record(res)
+	}
+	latency := time.Since(start)
+	bc.logRequest(req, res, err, latency)
+	bc.observeRequest(req, res, latency)
+	return res, err
+}
+
+// replayRequest returns a copy of req with a fresh body
+func replayRequest(req *http.Request) (*http.Request, error) {
+	retry := req.Clone(req.Context())
+	if req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return nil, errors.New("request body can't be replayed")
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		retry.Body = body
+	}
+	return retry, nil
+}
+
+// drainBody reads what is left of a response body and closes it, so the connection can be reused
+func drainBody(res *http.Response) {
+	io.Copy(ioutil.Discard, io.LimitReader(res.Body, 1<<16))
+	res.Body.Close()
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isConnectionReset returns true for errors where the server dropped the connection without answering,
+// typically a keep-alive connection closed by BigCommerce while we were reusing it
+func isConnectionReset(err error) bool {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "connection reset") || strings.Contains(msg, "server closed idle connection")
+}
+
+// sharedState is the state a client shares with its clones: the refreshed token and the caches
+type sharedState struct {
+	tokenMu sync.Mutex
+	// token is the token RefreshToken returned last, empty until a refresh
+	token string
+
+	unitsMu            sync.Mutex
+	storeUnits         *UnitSystem
+	attributesMu       sync.Mutex
+	customerAttributes map[string]CustomerAttribute
+	categoriesMu       sync.Mutex
+	categories         map[int64]Category
+}
+
+// sharedState returns the client's shared state, creating it on first use
+func (bc *Client) sharedState() *sharedState {
+	bc.sharedMu.Lock()
+	defer bc.sharedMu.Unlock()
+	if bc.shared == nil {
+		bc.shared = &sharedState{}
+	}
+	return bc.shared
+}
+
+// refreshToken returns a new token, unless another request, of this client or a clone, already refreshed
+// the token we used
+func (bc *Client) refreshToken(usedToken string) (string, error) {
+	s := bc.sharedState()
+	s.tokenMu.Lock()
+	defer s.tokenMu.Unlock()
+	current := s.token
+	if current == "" {
+		current = bc.XAuthToken
+	}
+	if current != usedToken {
+		return current, nil
+	}
+	token, err := bc.RefreshToken(bc.StoreHash, usedToken)
+	if err != nil {
+		return "", err
+	}
+	s.token = token
+	bc.XAuthToken = token
+	return token, nil
+}
+
+// authToken returns the token requests are sent with, the refreshed one once RefreshToken was called
+func (bc *Client) authToken() string {
+	s := bc.sharedState()
+	s.tokenMu.Lock()
+	defer s.tokenMu.Unlock()
+	if s.token != "" {
+		return s.token
+	}
+	return bc.XAuthToken
+}
+
 func processBody(res *http.Response) ([]byte, error) {
 	if res.StatusCode == http.StatusNoContent {
+		drainBody(res)
 		return nil, ErrNoContent
 	}
-	if res.StatusCode == http.StatusNotFound {
-		return nil, ErrNotFound
-	}
 	body, err := ioutil.ReadAll(res.Body)
 	if err != nil {
 		return nil, err
 	}
 	res.Body.Close()
+	if nonJSON := newNonJSONResponseError(res, body); nonJSON != nil {
+		log.Printf("%s %s %s", res.Request.Method, res.Request.URL, nonJSON)
+		return body, nonJSON
+	}
+	if res.StatusCode == http.StatusNotFound {
+		return body, newAPIError(res, body)
+	}
 	if res.StatusCode > 299 {
 		log.Printf("%s %s %s", res.Request.Method, res.Request.URL, string(body))
-		return body, errors.New(res.Status)
+		return body, newAPIError(res, body)
 	}
 	return body, nil
 }
+
+// getJSON gets url and decodes the response into v
+func (bc *Client) getJSON(url string, v interface{}) error {
+	req := bc.getAPIRequest(http.MethodGet, url, nil)
+	res, err := bc.do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil {
+		return err
+	}
+	return bc.unmarshal(body, v)
+}
+
+// putJSON sends payload to url with PUT, the response is discarded
+func (bc *Client) putJSON(url string, payload interface{}) error {
+	reqJSON, err := bc.marshal(payload)
+	if err != nil {
+		return err
+	}
+	req := bc.getAPIRequest(http.MethodPut, url, bytes.NewReader(reqJSON))
+	res, err := bc.do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil && err != ErrNoContent {
+		return fmt.Errorf("error updating %s: %w %s", url, err, string(body))
+	}
+	return nil
+}
+
+// sendJSON sends payload (when not nil) and decodes the response into result (when not nil)
+func (bc *Client) sendJSON(method, url string, payload, result interface{}) error {
+	var req *http.Request
+	if payload != nil {
+		reqJSON, err := bc.marshal(payload)
+		if err != nil {
+			return err
+		}
+		req = bc.getAPIRequest(method, url, bytes.NewReader(reqJSON))
+	} else {
+		req = bc.getAPIRequest(method, url, nil)
+	}
+	res, err := bc.do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil {
+		if err == ErrNoContent {
+			return nil
+		}
+		return fmt.Errorf("%s %s: %w %s", method, url, err, string(body))
+	}
+	if result == nil {
+		return nil
+	}
+	return bc.unmarshal(body, result)
+}
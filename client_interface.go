@@ -0,0 +1,384 @@
+// Code generated by clientgen; DO NOT EDIT.
+
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// ClientInterface has every exported method of Client, so services can be tested with the stub in the mocks
+// package, or any other implementation, instead of a *Client. Depend on a per resource interface like
+// OrderClient where a few methods will do
+type ClientInterface interface {
+	// abandoned_cart_emails.go
+	GetAbandonedCartEmailSettings(channelID int64) (*AbandonedCartEmailSettings, error)
+	UpdateAbandonedCartEmailSettings(channelID int64, settings AbandonedCartEmailSettings) (*AbandonedCartEmailSettings, error)
+	GetAbandonedCartEmails() ([]AbandonedCartEmail, error)
+	GetAbandonedCartEmail(emailID int64) (*AbandonedCartEmail, error)
+	CreateAbandonedCartEmail(email AbandonedCartEmail) (*AbandonedCartEmail, error)
+	UpdateAbandonedCartEmail(email AbandonedCartEmail) (*AbandonedCartEmail, error)
+	DeleteAbandonedCartEmail(emailID int64) error
+
+	// address.go
+	GetAddresses(customerID int64) ([]Address, error)
+	GetAddressPage(customerID int64, page int) ([]Address, bool, error)
+	CreateAddress(customerID int64, address *Address) (*Address, error)
+	UpdateAddress(customerID int64, address *Address) (*Address, error)
+	DeleteAddress(customerID, addressID int64) error
+
+	// address_backfill.go
+	GetCountries() ([]Country, error)
+	GetCountryStates(countryID int64) ([]CountryState, error)
+	BackfillAddressCountries(opts BackfillOptions) ([]AddressFix, error)
+
+	// adjustments.go
+	AdjustInventoryRelative(adjustment *Adjustment) error
+	AdjustInventoryAbsolute(adjustment *Adjustment) error
+
+	// anonymize.go
+	AnonymizeOrder(orderID int64) error
+
+	// backorders.go
+	CheckBackorders(orderID, locationID int64, policy BackorderPolicy) (*BackorderDecision, error)
+	ApplyBackorderPolicy(orderID, locationID int64, policy BackorderPolicy) (*BackorderDecision, error)
+
+	// brands.go
+	GetAllBrands(args map[string]string) ([]Brand, error)
+	GetBrands(args map[string]string, page int) ([]Brand, bool, error)
+
+	// cart.go
+	CreateCart(items []LineItem) (*Cart, error)
+	CreateCartWithCustomItems(items []LineItem, customItems []LineItem) (*Cart, error)
+	GetCart(cartID string) (*Cart, error)
+	CartAddItems(cartID string, items []LineItem) (*Cart, error)
+	CartAddCustomItems(cartID string, customItems []LineItem) (*Cart, error)
+	CartEditItem(cartID string, item LineItem) (*Cart, error)
+	CartDeleteItem(cartID string, item LineItem) (*Cart, error)
+	CartUpdateCustomerID(cartID, customerID string) (*Cart, error)
+	DeleteCart(cartID string) error
+
+	// carton_packer.go
+	PackOrder(orderID int64, packer *CartonPacker) ([]Carton, error)
+
+	// categories.go
+	GetAllCategories(args map[string]string) ([]Category, error)
+	GetCategories(args map[string]string, page int) ([]Category, bool, error)
+
+	// category_import.go
+	ImportCategoryTree(tree []*CategoryNode, parentID int64) (map[*CategoryNode]int64, error)
+
+	// category_paths.go
+	GetCategoryPath(categoryID int64) ([]CategoryCrumb, error)
+	ResolveCategoryByPath(path string) (*Category, error)
+	ResetCategoryCache()
+
+	// changes.go
+	ListProductsModifiedSince(t time.Time, args map[string]string) ([]Product, error)
+	ListVariantsModifiedSince(t time.Time) ([]Variant, error)
+
+	// channels.go
+	GetAllChannels() ([]Channel, error)
+	GetChannels(page int) ([]Channel, bool, error)
+	UpdateChannelStatus(channelID int64, status string) (*Channel, error)
+	GetStorefrontStatusSettings(channelID int64) (*StorefrontStatusSettings, error)
+	UpdateStorefrontStatusSettings(channelID int64, settings StorefrontStatusSettings) error
+	SetChannelMaintenance(channelID int64, message string) error
+	SetChannelActive(channelID int64) error
+
+	// checkout.go
+	GetCheckout(checkoutID string) (*Checkout, error)
+	SetCheckoutBillingAddress(checkoutID string, address CheckoutAddress) (*Checkout, error)
+	AddCheckoutConsignments(checkoutID string, consignments []ConsignmentRequest) (*Checkout, error)
+	CreateCheckoutOrder(checkoutID string) (int64, error)
+	CompleteCheckout(cartID string, billing CheckoutAddress, consignments []ConsignmentRequest, payment *PaymentRequest) (int64, error)
+	GetConsignmentShippingOptions(checkoutID, consignmentID string) ([]ShippingOption, error)
+	UpdateConsignmentShippingOption(checkoutID, consignmentID, shippingOptionID string) (*Checkout, error)
+	ApplyCouponToCheckout(checkoutID, couponCode string) (*Checkout, error)
+	RemoveCoupon(checkoutID, couponCode string) (*Checkout, error)
+	ApplyGiftCertificateToCheckout(checkoutID, giftCertificateCode string) (*Checkout, error)
+	RemoveGiftCertificateFromCheckout(checkoutID, giftCertificateCode string) (*Checkout, error)
+
+	// client.go
+	WithContext(ctx context.Context) *Client
+
+	// codec.go
+	WithRawPayload(raw *[]json.RawMessage) *Client
+
+	// conflicts.go
+	UpdateProductIfUnmodifiedSince(productID int64, since time.Time, updates map[string]interface{}) error
+	UpdateOrderIfUnmodifiedSince(orderID int64, since time.Time, order *UpdateOrder) error
+	SaveAccountIfUnmodifiedSince(since time.Time, payload *SaveAccountPayload) (*Customer, error)
+
+	// conversions.go
+	RecordCartConvertedWebhook(body []byte) (*CartConversion, error)
+
+	// coupons.go
+	CreateCoupon(coupon Coupon) (*Coupon, error)
+	GetCoupon(couponID int64) (*Coupon, error)
+	UpdateCoupon(couponID int64, coupon Coupon) (*Coupon, error)
+	DeleteCoupon(couponID int64) error
+	GetAllCoupons(args map[string]string) ([]Coupon, error)
+	GetCoupons(args map[string]string, page int) ([]Coupon, bool, error)
+
+	// currencies.go
+	GetCurrencies() ([]Currency, error)
+
+	// customer_attributes.go
+	GetCustomerAttributes() ([]CustomerAttribute, error)
+	GetCustomerAttributeByName(name string) (*CustomerAttribute, error)
+	GetCustomerAttributeValues(customerID int64) ([]CustomerAttributeValue, error)
+	UpsertCustomerAttributeValues(values []CustomerAttributeValue) ([]CustomerAttributeValue, error)
+	CustomerAttributes(customerID int64) *CustomerAttributes
+
+	// customer_channels.go
+	GetAllCustomers(args map[string]string) ([]Customer, error)
+	GetChannelCustomers(filter CustomerChannelFilter, args map[string]string) ([]Customer, error)
+
+	// customer_groups.go
+	GetCustomerGroups() ([]CustomerGroup, error)
+
+	// customers.go
+	ValidateCredentials(email, password string) (int64, error)
+	CreateAccount(payload *CreateAccountPayload) (*Customer, error)
+	SaveAccount(payload *SaveAccountPayload) (*Customer, error)
+	CustomerSetFormFields(customerID int64, formFields []FormField) error
+	CustomerGetFormFields(customerID int64) ([]FormField, error)
+	GetCustomerByID(customerID int64) (*Customer, error)
+	GetCustomerByEmail(email string) (*Customer, error)
+
+	// customs.go
+	GetCustomsDeclaration(orderID int64, shipment *Shipment, opts CustomsOptions) (*CustomsDeclaration, error)
+
+	// duplicates.go
+	FindDuplicateSKUs() ([]DuplicateGroup, error)
+	FindDuplicateNames() ([]DuplicateGroup, error)
+
+	// feeds.go
+	GetFeedItems(opts FeedOptions) ([]FeedItem, error)
+
+	// fixtures.go
+	RecordFixtures(resources []string, dir string) error
+
+	// fulfillment.go
+	ComputeFulfillmentStatus(orderID int64) (*Fulfillment, error)
+
+	// fulfillment_confirmations.go
+	ShipmentsFromConfirmation(c FulfillmentConfirmation) ([]Shipment, error)
+	CreateShipmentsFromConfirmation(c FulfillmentConfirmation) ([]Shipment, error)
+	CreateShipmentsFromMessage(adapter FulfillmentAdapter, message []byte) ([]Shipment, error)
+
+	// graphql_admin.go
+	AdminGraphQL(query string, variables map[string]interface{}, result interface{}) error
+	GetProductTranslation(productID, channelID int64, locale string) (*ProductTranslation, error)
+	UpsertProductTranslation(productID, channelID int64, locale string, translation ProductTranslation) error
+	GetCategoryTranslation(categoryID, channelID int64, locale string) (*CategoryTranslation, error)
+	UpsertCategoryTranslation(categoryID, channelID int64, locale string, translation CategoryTranslation) error
+
+	// idempotency.go
+	CreateOrderShipmentIdempotent(orderID int64, shipment Shipment, key string) (*Shipment, error)
+
+	// image_upload.go
+	CreateProductImageFile(productID int64, image Image, fileName string, file io.Reader) (*Image, error)
+	CreateProductImageFromSource(productID int64, image Image, src ImageSource) (*Image, error)
+	SetVariantImageFromSource(productID, variantID int64, src ImageSource) (string, error)
+
+	// images.go
+	GetMainThumbnailURL(productID int64) (string, error)
+	SetVariantImage(productID, variantID int64, imageURL string) (string, error)
+	SetVariantImageFile(productID, variantID int64, fileName string, file io.Reader) (string, error)
+
+	// inventory.go
+	GetInventoryForLocation(ID int64, filters map[string]string) (*InventoryResource, error)
+
+	// iterators.go
+	OrderShipmentsIterator(orderID int64, filters map[string]string) *ShipmentsIterator
+	InventoryForLocationIterator(locationID int64, filters map[string]string) *InventoryIterator
+	ProductsIterator(args map[string]string) *ProductsIterator
+
+	// kits.go
+	GetKitComponents(productID int64) ([]KitComponent, error)
+	SetKitComponents(productID int64, components []KitComponent) error
+	ExplodeOrder(orderID int64) ([]ComponentPick, error)
+	AdjustKitInventory(orderID, locationID int64, shipment *Shipment) error
+
+	// list_options.go
+	GetOrderShipmentsWithOptions(orderID int64, opts ShipmentListOptions) ([]Shipment, error)
+	GetInventoryForLocationWithOptions(locationID int64, opts InventoryListOptions) (*InventoryResource, error)
+
+	// locations.go
+	GetLocations(filters map[string]string) ([]Location, error)
+	CreateLocations(location *[]Location) error
+	UpdateLocation(location *Location) error
+
+	// metafields.go
+	GetOrderMetafields(orderID int64, namespace string) ([]Metafield, error)
+	CreateOrderMetafield(orderID int64, metafield Metafield) (*Metafield, error)
+	UpdateOrderMetafield(orderID int64, metafield Metafield) (*Metafield, error)
+	DeleteOrderMetafield(orderID, metafieldID int64) error
+	GetProductMetafieldsInNamespace(productID int64, namespace string) ([]Metafield, error)
+	CreateProductMetafield(productID int64, metafield Metafield) (*Metafield, error)
+	UpdateProductMetafield(productID int64, metafield Metafield) (*Metafield, error)
+	DeleteProductMetafield(productID, metafieldID int64) error
+
+	// opengraph.go
+	FillProductsOpenGraph(products []Product) (int, error)
+
+	// order_tags.go
+	OrderTags(orderID int64) *OrderTags
+
+	// order_timeline.go
+	GetOrderRefunds(orderID int64) ([]OrderRefund, error)
+	GetOrderTransactions(orderID int64) ([]OrderTransaction, error)
+	GetOrderTimeline(orderID int64) ([]TimelineEvent, error)
+
+	// orders.go
+	GetOrders(filters map[string]string) ([]Order, error)
+	GetOrder(orderID int64) (*Order, error)
+	UpdateOrder(orderId int64, order *UpdateOrder) error
+	GetOrderProducts(orderID int64) ([]OrderProduct, error)
+	GetOrderProductsPage(orderID int64, page int) ([]OrderProduct, bool, error)
+	GetOrderShippingAddresses(orderID int64) ([]OrderShippingAddress, error)
+	GetOrderCoupons(orderID int64) ([]OrderCoupon, error)
+	GetOrderMessages(orderID int64) ([]OrderMessage, error)
+	GetOrderMessagesPage(orderID int64, page int) ([]OrderMessage, bool, error)
+	GetOrderShippingMargin(order *Order) (float64, error)
+
+	// pagebuilder.go
+	CreateWidgetTemplate(pt *PageBuilderTemplate) (*PageBuilderTemplate, error)
+	GetWidgetTemplates() ([]PageBuilderTemplate, error)
+	DeleteWidgetTemplate(uuid string) error
+
+	// payment_reconciliation.go
+	ReconcileOrderPayments(orderID int64) (*PaymentReconciliation, error)
+
+	// payments.go
+	PayOrder(orderID int64, payment PaymentRequest) (*PaymentResult, error)
+	CreatePaymentAccessToken(orderID int64) (string, error)
+	ProcessPayment(accessToken string, payment PaymentRequest) (*PaymentResult, error)
+	GetCustomerStoredInstruments(customerID int64) ([]StoredInstrument, error)
+	GetOrderStoredInstruments(orderID int64) ([]StoredInstrument, error)
+	PayOrderWithStoredInstrument(orderID int64, paymentMethodID, token string) (*PaymentResult, error)
+	CaptureOrderPayment(orderID int64) error
+
+	// pickup.go
+	GetPickupMethods() ([]PickupMethod, error)
+	CreatePickupMethods(methods []PickupMethod) ([]PickupMethod, error)
+	UpdatePickupMethods(methods []PickupMethod) ([]PickupMethod, error)
+	DeletePickupMethods(ids []int64) error
+	GetPickupOptions(request PickupOptionsRequest) ([]PickupOption, error)
+	GetOrderPickups(orderIDs ...int64) ([]Pickup, error)
+	CreateOrderPickups(pickups []Pickup) ([]Pickup, error)
+	DeleteOrderPickups(ids []int64) error
+
+	// posts.go
+	GetAllPosts() ([]Post, error)
+	GetPosts(page int) ([]Post, bool, error)
+
+	// pricelists.go
+	GetPriceListRecords(priceListID int64, args map[string]string) ([]PriceListRecord, error)
+	UpsertPriceListRecords(priceListID int64, records []PriceListRecord) error
+	RecalculatePriceList(priceListID int64, rule PricingRule, opts RecalculateOptions) ([]PriceChange, error)
+
+	// product_approval.go
+	ProductWorkflow() *ProductWorkflow
+
+	// products.go
+	GetAllProducts(args map[string]string) ([]Product, error)
+	GetProducts(args map[string]string, page int) ([]Product, bool, error)
+	GetProductByID(productID int64) (*Product, error)
+	GetProductMetafields(productID int64) (map[string]Metafield, error)
+	SetProductsSortOrder(sortOrders map[int64]int) error
+	SetProductsFeatured(productIDs []int64, featured bool) error
+
+	// promotions.go
+	CreatePromotion(promotion Promotion) (*Promotion, error)
+	CreateSegmentPromotion(segmentName string, promotion Promotion) (*Promotion, error)
+
+	// ratelimit.go
+	RateLimitStatus() RateLimitStatus
+
+	// raw.go
+	Raw(method, path string, body []byte) ([]byte, error)
+	SendJSON(method, path string, payload, result interface{}) error
+	Patch(path string, payload, result interface{}) error
+
+	// reports.go
+	GetSalesReport(opts SalesReportOptions) (*SalesReport, error)
+
+	// request_options.go
+	With(opts ...RequestOption) *Client
+
+	// response.go
+	WithResponses(responses *[]Response) *Client
+
+	// schedule.go
+	ProductSchedule(changes ...ScheduledChange) *ProductSchedule
+
+	// scripts.go
+	CreateScript(s *Script) (*Script, error)
+	GetScriptByID(uuid string) (*Script, error)
+	GetScripts() ([]Script, error)
+
+	// seeder.go
+	Seeder(opts SeedOptions) *Seeder
+	CleanupSeeded(r SeededResources) (SeededResources, error)
+
+	// segments.go
+	GetSegments() ([]Segment, error)
+	GetSegmentByName(name string) (*Segment, error)
+	CreateSegment(segment Segment) (*Segment, error)
+	EnsureSegment(name, description string) (*Segment, error)
+
+	// shipment_locations.go
+	CreateOrderShipmentFromLocation(orderID, locationID int64, shipment Shipment, opts ShipmentLocationOptions) (*Shipment, error)
+	GetShipmentLocation(orderID, shipmentID int64) (int64, error)
+	GetOrderShipmentLocations(orderID int64) (map[int64]int64, error)
+
+	// shipments.go
+	GetOrderShipments(orderId int64, filters map[string]string) ([]Shipment, error)
+	GetAllOrderShipments(orderId int64) ([]Shipment, error)
+	CreateOrderShipment(orderId int64, shipment Shipment) (*Shipment, error)
+	DeleteOrderShipments(orderId int64) (bool, error)
+	DeleteOrderShipment(orderId int64, shipmentId int64) (bool, error)
+	GetOrderShipment(orderId int64, shipmentId int64) (*Shipment, error)
+	UpdateOrderShipment(orderId int64, shipment Shipment) (*Shipment, error)
+	CreateOrderShipmentAndCapture(orderId int64, shipment Shipment, onCaptureFailure CaptureFailurePolicy) (*Shipment, error)
+	ResendShipmentNotification(orderID int64) error
+
+	// store.go
+	GetStoreInfo() (StoreInfo, error)
+
+	// store_credit.go
+	GetCustomerStoreCredit(customerID int64) (float64, error)
+	SetCustomerStoreCredit(customerID int64, amount float64, reason, reference string) error
+	AddCustomerStoreCredit(customerID int64, amount float64, reason, reference string) (float64, error)
+
+	// storefront_tokens.go
+	CreateStorefrontToken(channelID int64, expiresAt time.Time, allowedOrigins ...string) (*StorefrontToken, error)
+	CreateCustomerImpersonationToken(channelID int64, expiresAt time.Time) (*StorefrontToken, error)
+	RevokeStorefrontToken(token string) error
+	StorefrontTokenProvider(channelID int64, ttl time.Duration, allowedOrigins ...string) *StorefrontTokenProvider
+
+	// theme.go
+	GetActiveThemeConfig() (*ThemeConfig, error)
+	GetThemes() ([]Theme, error)
+	GetThemeConfig(uuid string) (*ThemeConfig, error)
+
+	// units.go
+	GetStoreUnits() (UnitSystem, error)
+
+	// variants.go
+	CreateVariantCombinations(productID int64, combinations []VariantCombination) error
+	CreateProductVariants(productID int64, baseSku string, options []VariantOption, skuPattern string) ([]VariantCombination, error)
+	GetProductVariants(productID int64) ([]Variant, error)
+	GetVariant(productID, variantID int64) (*Variant, error)
+
+	// webhook.go
+	GetWebhooks() ([]Webhook, error)
+	CreateWebhook(scope, destination string, headers map[string]string) (int64, error)
+}
+
+var _ ClientInterface = (*Client)(nil)
@@ -0,0 +1,151 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRefreshTokenInCloneSeenByParent(t *testing.T) {
+	var refreshes, unauthorized int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Auth-Token") != "new" {
+			atomic.AddInt32(&unauthorized, 1)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"id": "store", "weight_units": "kg", "dimension_units": "cm"}`)
+	}))
+	defer srv.Close()
+	bc := newTestClient(srv)
+	bc.RefreshToken = func(storeHash, oldToken string) (string, error) {
+		atomic.AddInt32(&refreshes, 1)
+		return "new", nil
+	}
+
+	_, err := bc.WithContext(context.Background()).GetStoreInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []*Client{bc, bc.WithContext(context.Background())} {
+		_, err = c.GetStoreInfo()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if refreshes != 1 || unauthorized != 1 {
+		t.Errorf("got %d refreshes and %d 401 answers, want 1 of each", refreshes, unauthorized)
+	}
+	if bc.authToken() != "new" {
+		t.Errorf("parent sends token %q, want the refreshed one", bc.authToken())
+	}
+}
+
+func TestClonesShareCaches(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		fmt.Fprint(w, `{"id": "store", "weight_units": "LBS", "dimension_units": "Inches"}`)
+	}))
+	defer srv.Close()
+	bc := newTestClient(srv)
+
+	units, err := bc.WithContext(context.Background()).GetStoreUnits()
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := bc.GetStoreUnits()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests != 1 || again != units {
+		t.Errorf("got %d requests and units %v then %v, want the units of a clone cached for the parent", requests, units, again)
+	}
+}
+
+func TestConnectionResetRetries(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		resets     int32
+		maxRetries int
+		wantErr    bool
+		wantSent   int32
+	}{
+		{"GET retried", http.MethodGet, 1, 1, false, 2},
+		{"GET retried up to MaxRetries", http.MethodGet, 2, 2, false, 3},
+		{"GET beyond MaxRetries", http.MethodGet, 2, 1, true, 2},
+		{"PUT retried", http.MethodPut, 1, 1, false, 2},
+		{"POST not retried", http.MethodPost, 1, 1, true, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, requests := failingServer(t, tt.resets, 0, nil)
+			defer srv.Close()
+			srv.Config.SetKeepAlivesEnabled(false)
+			bc := newTestClient(srv, WithMaxRetries(tt.maxRetries))
+
+			var body []byte
+			if tt.method != http.MethodGet {
+				body = []byte(`{}`)
+			}
+			_, err := bc.Raw(tt.method, "/v3/catalog/products", body)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if got := atomic.LoadInt32(requests); got != tt.wantSent {
+				t.Errorf("got %d requests, want %d", got, tt.wantSent)
+			}
+		})
+	}
+}
+
+func TestRefreshTokenOn401(t *testing.T) {
+	tests := []struct {
+		name      string
+		refresh   func(storeHash, oldToken string) (string, error)
+		wantErr   bool
+		wantSent  int32
+		wantToken string
+	}{
+		{"refreshed", func(string, string) (string, error) { return "new", nil }, false, 2, "new"},
+		{"refresh fails", func(string, string) (string, error) { return "", errors.New("revoked") }, true, 1, "token"},
+		{"refreshed token rejected", func(string, string) (string, error) { return "other", nil }, true, 2, "other"},
+		{"no RefreshToken", nil, true, 1, "token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&requests, 1)
+				if r.Header.Get("X-Auth-Token") != "new" {
+					w.WriteHeader(http.StatusUnauthorized)
+					fmt.Fprint(w, `{"status": 401, "title": "Unauthorized"}`)
+					return
+				}
+				fmt.Fprint(w, `{}`)
+			}))
+			defer srv.Close()
+			bc := newTestClient(srv)
+			bc.RefreshToken = tt.refresh
+
+			_, err := bc.Raw(http.MethodPut, "/v3/catalog/products", []byte(`[{"id": 1}]`))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, ErrUnauthorized) {
+				t.Errorf("got error %v, want ErrUnauthorized", err)
+			}
+			if requests != tt.wantSent {
+				t.Errorf("got %d requests, want %d", requests, tt.wantSent)
+			}
+			if bc.authToken() != tt.wantToken {
+				t.Errorf("client sends token %q, want %q", bc.authToken(), tt.wantToken)
+			}
+		})
+	}
+}
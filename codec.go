@@ -0,0 +1,71 @@
+package bigcommerce
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// Codec encodes request bodies and decodes API responses, set Client.Codec to use a faster
+// JSON library (e.g. jsoniter or segmentio/encoding) for stores with very large catalogs.
+// Implementations must be compatible with encoding/json struct tags.
+type Codec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// StdCodec is the default Codec, backed by encoding/json
+type StdCodec struct{}
+
+// Marshal calls json.Marshal
+func (StdCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Unmarshal calls json.Unmarshal
+func (StdCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func (bc *Client) codec() Codec {
+	if bc.Codec == nil {
+		return StdCodec{}
+	}
+	return bc.Codec
+}
+
+func (bc *Client) marshal(v interface{}) ([]byte, error) {
+	return bc.codec().Marshal(v)
+}
+
+func (bc *Client) unmarshal(data []byte, v interface{}) error {
+	if bc.rawPayload != nil {
+		bc.rawPayload.add(append(json.RawMessage(nil), data...))
+	}
+	bc.recordPagination(data)
+	return bc.codec().Unmarshal(data, v)
+}
+
+// WithRawPayload returns a client for one call that also appends the untouched JSON of every response
+// it decodes to raw, for storing original payloads or reading fields the structs don't model yet:
+//
+//	var raw []json.RawMessage
+//	order, err := bc.WithRawPayload(&raw).GetOrder(100)
+//
+// calls that make several requests, like GetOrder, append one payload per request
+func (bc *Client) WithRawPayload(raw *[]json.RawMessage) *Client {
+	c := bc.clone()
+	c.rawPayload = &recorder[json.RawMessage]{data: raw}
+	return c
+}
+
+// recorder appends to a slice of the caller, the clones of a client share it so they append under one lock
+type recorder[T any] struct {
+	mu   sync.Mutex
+	data *[]T
+}
+
+func (r *recorder[T]) add(v T) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	*r.data = append(*r.data, v)
+}
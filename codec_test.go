@@ -0,0 +1,170 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// productPageFixture returns a full page of 250 products with variants, images and custom fields,
+// as ListPage decodes it
+func productPageFixture(tb testing.TB) []byte {
+	tb.Helper()
+	products := make([]Product, 250)
+	for i := range products {
+		p := &products[i]
+		p.ID = int64(i + 1)
+		p.Name = fmt.Sprintf("Product %d", i+1)
+		p.Type = "physical"
+		p.Sku = fmt.Sprintf("SKU-%d", i+1)
+		p.Description = strings.Repeat("<p>A long product description with markup.</p>", 20)
+		p.Price = 19.95
+		p.Weight = 1.5
+		p.Width, p.Height, p.Depth = 10, 20, 30
+		for v := 0; v < 12; v++ {
+			p.Variants = append(p.Variants, Variant{
+				ID:        int64(i*100 + v),
+				ProductID: p.ID,
+				Sku:       fmt.Sprintf("SKU-%d-%d", i+1, v),
+				Price:     19.95,
+				Weight:    1.5,
+			})
+		}
+		for n := 0; n < 5; n++ {
+			p.Images = append(p.Images, Image{
+				ID:          int64(i*10 + n),
+				ProductID:   p.ID,
+				URLZoom:     fmt.Sprintf("https://cdn11.bigcommerce.com/s-store/images/stencil/1280x1280/products/%d/%d/image.jpg", p.ID, n),
+				URLStandard: fmt.Sprintf("https://cdn11.bigcommerce.com/s-store/images/stencil/500x659/products/%d/%d/image.jpg", p.ID, n),
+			})
+		}
+	}
+	page := map[string]interface{}{
+		"data": products,
+		"meta": map[string]interface{}{
+			"pagination": Pagination{Total: 10000, Count: 250, PerPage: 250, CurrentPage: 1, TotalPages: 40},
+		},
+	}
+	data, err := json.Marshal(page)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return data
+}
+
+func TestCodecDecodesProductPage(t *testing.T) {
+	data := productPageFixture(t)
+	var page struct {
+		Data []Product `json:"data"`
+	}
+	err := NewClient("store", "token").unmarshal(data, &page)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Data) != 250 || len(page.Data[249].Variants) != 12 {
+		t.Errorf("got %d products, want 250 with 12 variants each", len(page.Data))
+	}
+}
+
+// BenchmarkDecodeProductPage compares decoding a page of 250 products with encoding/json directly,
+// as before Codec, with decoding through the client's Codec, and with responses recorded too
+func BenchmarkDecodeProductPage(b *testing.B) {
+	data := productPageFixture(b)
+	var responses []Response
+	benchmarks := []struct {
+		name   string
+		decode func(data []byte, v interface{}) error
+	}{
+		{"json.Unmarshal", json.Unmarshal},
+		{"StdCodec", NewClient("store", "token").unmarshal},
+		{"StdCodecWithResponses", NewClient("store", "token").WithResponses(&responses).unmarshal},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				var page struct {
+					Data []Product `json:"data"`
+				}
+				if err := bm.decode(data, &page); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkEncodeProduct compares encoding a product with variants with encoding/json directly and through the Codec
+func BenchmarkEncodeProduct(b *testing.B) {
+	var page struct {
+		Data []Product `json:"data"`
+	}
+	if err := json.Unmarshal(productPageFixture(b), &page); err != nil {
+		b.Fatal(err)
+	}
+	product := page.Data[0]
+	benchmarks := []struct {
+		name   string
+		encode func(v interface{}) ([]byte, error)
+	}{
+		{"json.Marshal", json.Marshal},
+		{"StdCodec", NewClient("store", "token").marshal},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := bm.encode(product); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestRawPayloadSharedByClones(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/stores/store/graphql" {
+			fmt.Fprint(w, `{"data": {"store": {"name": "Store"}}}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": "store"}`)
+	}))
+	defer srv.Close()
+	var raw []json.RawMessage
+	bc := newTestClient(srv).WithRawPayload(&raw)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := bc.WithContext(context.Background()).GetStoreInfo(); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if len(raw) != 10 {
+		t.Errorf("got %d payloads, want 10", len(raw))
+	}
+
+	raw = nil
+	var result struct {
+		Store struct {
+			Name string `json:"name"`
+		} `json:"store"`
+	}
+	if err := bc.AdminGraphQL("query { store { name } }", nil, &result); err != nil {
+		t.Fatal(err)
+	}
+	if len(raw) != 1 || result.Store.Name != "Store" {
+		t.Errorf("got %d payloads and store %q, want the response recorded once", len(raw), result.Store.Name)
+	}
+}
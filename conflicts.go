@@ -0,0 +1,124 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ErrConflict is returned (wrapped in a ConflictError) when a resource changed since it was read
+var ErrConflict = errors.New("resource was modified")
+
+// ConflictError is returned by the IfUnmodifiedSince updates when date_modified is newer than expected
+type ConflictError struct {
+	Resource     string // "product", "order" or "customer"
+	ID           int64
+	Since        time.Time
+	DateModified time.Time
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s %d was modified at %s, after %s", e.Resource, e.ID,
+		e.DateModified.Format(time.RFC3339), e.Since.Format(time.RFC3339))
+}
+
+// Unwrap makes errors.Is(err, ErrConflict) work
+func (e *ConflictError) Unwrap() error {
+	return ErrConflict
+}
+
+// UpdateProductIfUnmodifiedSince re-fetches the product and applies the partial update only if
+// its date_modified is not after since, e.g. the DateModified of the copy the update was based on.
+// BigCommerce has no conditional writes, so a change between the check and the write can still be lost
+// updates: product fields to set, e.g. {"price": 9.95}
+func (bc *Client) UpdateProductIfUnmodifiedSince(productID int64, since time.Time, updates map[string]interface{}) error {
+	url := newURL("/v3/catalog/products").ID(productID).Param("include_fields", "date_modified").String()
+	var productResponse struct {
+		Data struct {
+			DateModified time.Time `json:"date_modified"`
+		} `json:"data"`
+	}
+	err := bc.getJSON(url, &productResponse)
+	if err != nil {
+		return err
+	}
+	err = checkUnmodified("product", productID, since, productResponse.Data.DateModified)
+	if err != nil {
+		return err
+	}
+	reqJSON, err := bc.marshal(updates)
+	if err != nil {
+		return err
+	}
+	req := bc.getAPIRequest(http.MethodPut, newURL("/v3/catalog/products").ID(productID).String(), bytes.NewReader(reqJSON))
+	res, err := bc.do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil {
+		return fmt.Errorf("error updating product %d: %w %s", productID, err, string(body))
+	}
+	return nil
+}
+
+// UpdateOrderIfUnmodifiedSince runs UpdateOrder only if the order's date_modified is not after since
+func (bc *Client) UpdateOrderIfUnmodifiedSince(orderID int64, since time.Time, order *UpdateOrder) error {
+	var o struct {
+		DateModified string `json:"date_modified"`
+	}
+	err := bc.getJSON(newURL("/v2/orders").ID(orderID).String(), &o)
+	if err != nil {
+		return err
+	}
+	modified, err := parseDateModified(o.DateModified)
+	if err != nil {
+		return err
+	}
+	err = checkUnmodified("order", orderID, since, modified)
+	if err != nil {
+		return err
+	}
+	return bc.UpdateOrder(orderID, order)
+}
+
+// SaveAccountIfUnmodifiedSince runs SaveAccount only if the customer's date_modified is not after since
+func (bc *Client) SaveAccountIfUnmodifiedSince(since time.Time, payload *SaveAccountPayload) (*Customer, error) {
+	customer, err := bc.GetCustomerByID(payload.ID)
+	if err != nil {
+		return nil, err
+	}
+	modified, err := parseDateModified(customer.DateModified)
+	if err != nil {
+		return nil, err
+	}
+	err = checkUnmodified("customer", payload.ID, since, modified)
+	if err != nil {
+		return nil, err
+	}
+	return bc.SaveAccount(payload)
+}
+
+func checkUnmodified(resource string, id int64, since, modified time.Time) error {
+	if modified.After(since) {
+		return &ConflictError{
+			Resource:     resource,
+			ID:           id,
+			Since:        since,
+			DateModified: modified,
+		}
+	}
+	return nil
+}
+
+// parseDateModified parses v3 (RFC3339) and v2 (RFC1123Z) dates
+func parseDateModified(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, s)
+	if err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC1123Z, s)
+}
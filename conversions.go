@@ -0,0 +1,137 @@
+package bigcommerce
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// Cart conversion sources
+const (
+	ConversionSourceCheckout = "checkout"
+	ConversionSourceWebhook  = "webhook"
+)
+
+// WebhookScopeCartConverted is the webhook scope BigCommerce sends when a cart became an order
+const WebhookScopeCartConverted = "store/cart/converted"
+
+// CartConversion links a cart to the order created from it, for attributing conversions
+type CartConversion struct {
+	Time    time.Time `json:"time"`
+	CartID  string    `json:"cart_id"`
+	OrderID int64     `json:"order_id"`
+	Source  string    `json:"source"`
+}
+
+// ConversionSink stores cart conversions, set Client.ConversionSink to have CreateCheckoutOrder (and
+// CompleteCheckout) record them. Orders placed on the storefront are recorded with RecordCartConvertedWebhook
+type ConversionSink interface {
+	RecordConversion(conversion CartConversion) error
+}
+
+// RecordCartConvertedWebhook records the conversion of a store/cart/converted webhook body in the client's
+// ConversionSink, it returns the conversion, nil for other scopes
+func (bc *Client) RecordCartConvertedWebhook(body []byte) (*CartConversion, error) {
+	var payload struct {
+		Scope     string `json:"scope"`
+		CreatedAt int64  `json:"created_at"`
+		Data      struct {
+			ID      string `json:"id"`
+			CartID  string `json:"cartId"`
+			OrderID int64  `json:"orderId"`
+		} `json:"data"`
+	}
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		return nil, err
+	}
+	if payload.Scope != WebhookScopeCartConverted {
+		return nil, nil
+	}
+	conversion := CartConversion{
+		Time:    time.Unix(payload.CreatedAt, 0),
+		CartID:  firstNonEmpty(payload.Data.ID, payload.Data.CartID),
+		OrderID: payload.Data.OrderID,
+		Source:  ConversionSourceWebhook,
+	}
+	if bc.ConversionSink != nil {
+		err = bc.ConversionSink.RecordConversion(conversion)
+	}
+	return &conversion, err
+}
+
+func (bc *Client) recordConversion(cartID string, orderID int64) {
+	if bc.ConversionSink == nil {
+		return
+	}
+	err := bc.ConversionSink.RecordConversion(CartConversion{
+		Time:    time.Now(),
+		CartID:  cartID,
+		OrderID: orderID,
+		Source:  ConversionSourceCheckout,
+	})
+	if err != nil {
+		bc.logf("error recording conversion of cart %s: %v", cartID, err)
+	}
+}
+
+// MemoryConversionSink keeps cart conversions in memory, a conversion seen twice (checkout and webhook) is kept once
+type MemoryConversionSink struct {
+	mu      sync.Mutex
+	byCart  map[string]CartConversion
+	byOrder map[int64]string
+}
+
+// RecordConversion adds a conversion to the sink
+func (s *MemoryConversionSink) RecordConversion(conversion CartConversion) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.byCart == nil {
+		s.byCart = map[string]CartConversion{}
+		s.byOrder = map[int64]string{}
+	}
+	if _, ok := s.byCart[conversion.CartID]; ok {
+		return nil
+	}
+	s.byCart[conversion.CartID] = conversion
+	s.byOrder[conversion.OrderID] = conversion.CartID
+	return nil
+}
+
+// OrderForCart returns the conversion of a cart
+func (s *MemoryConversionSink) OrderForCart(cartID string) (CartConversion, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.byCart[cartID]
+	return c, ok
+}
+
+// CartForOrder returns the conversion an order was created by
+func (s *MemoryConversionSink) CartForOrder(orderID int64) (CartConversion, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cartID, ok := s.byOrder[orderID]
+	if !ok {
+		return CartConversion{}, false
+	}
+	return s.byCart[cartID], true
+}
+
+// JSONLinesConversionSink writes each conversion as a JSON line, e.g. to a file an analytics job picks up
+type JSONLinesConversionSink struct {
+	mu sync.Mutex
+	W  io.Writer
+}
+
+// RecordConversion writes the conversion as one JSON line
+func (s *JSONLinesConversionSink) RecordConversion(conversion CartConversion) error {
+	b, err := json.Marshal(conversion)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.W.Write(append(b, '\n'))
+	return err
+}
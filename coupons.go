@@ -2,7 +2,6 @@ package bigcommerce
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -32,9 +31,9 @@ type Coupon struct {
 
 func (bc *Client) CreateCoupon(coupon Coupon) (*Coupon, error) {
 	var body []byte
-	body, _ = json.Marshal(coupon)
+	body, _ = bc.marshal(coupon)
 	req := bc.getAPIRequest(http.MethodPost, "/v3/coupons", bytes.NewReader(body))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -47,7 +46,7 @@ func (bc *Client) CreateCoupon(coupon Coupon) (*Coupon, error) {
 		Meta struct {
 		} `json:"meta,omitempty"`
 	}
-	err = json.Unmarshal(b, &couponResponse)
+	err = bc.unmarshal(b, &couponResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +55,7 @@ func (bc *Client) CreateCoupon(coupon Coupon) (*Coupon, error) {
 
 func (bc *Client) GetCoupon(couponID int64) (*Coupon, error) {
 	req := bc.getAPIRequest(http.MethodGet, "/v3/coupons/"+strconv.FormatInt(couponID, 10), nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +68,7 @@ func (bc *Client) GetCoupon(couponID int64) (*Coupon, error) {
 		Meta struct {
 		} `json:"meta,omitempty"`
 	}
-	err = json.Unmarshal(b, &couponResponse)
+	err = bc.unmarshal(b, &couponResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -78,9 +77,9 @@ func (bc *Client) GetCoupon(couponID int64) (*Coupon, error) {
 
 func (bc *Client) UpdateCoupon(couponID int64, coupon Coupon) (*Coupon, error) {
 	var body []byte
-	body, _ = json.Marshal(coupon)
+	body, _ = bc.marshal(coupon)
 	req := bc.getAPIRequest(http.MethodPut, "/v3/coupons/"+strconv.FormatInt(couponID, 10), bytes.NewReader(body))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -93,7 +92,7 @@ func (bc *Client) UpdateCoupon(couponID int64, coupon Coupon) (*Coupon, error) {
 		Meta struct {
 		} `json:"meta,omitempty"`
 	}
-	err = json.Unmarshal(b, &couponResponse)
+	err = bc.unmarshal(b, &couponResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +101,7 @@ func (bc *Client) UpdateCoupon(couponID int64, coupon Coupon) (*Coupon, error) {
 
 func (bc *Client) DeleteCoupon(couponID int64) error {
 	req := bc.getAPIRequest(http.MethodDelete, "/v3/coupons/"+strconv.FormatInt(couponID, 10), nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return err
 	}
@@ -115,7 +114,7 @@ func (bc *Client) DeleteCoupon(couponID int64) error {
 		Meta struct {
 		} `json:"meta,omitempty"`
 	}
-	err = json.Unmarshal(b, &couponResponse)
+	err = bc.unmarshal(b, &couponResponse)
 	if err != nil {
 		return err
 	}
@@ -145,29 +144,9 @@ func (bc *Client) GetAllCoupons(args map[string]string) ([]Coupon, error) {
 }
 
 func (bc *Client) GetCoupons(args map[string]string, page int) ([]Coupon, bool, error) {
-	fpart := ""
-	for k, v := range args {
-		fpart += "&" + k + "=" + v
-	}
-	url := "/v3/coupons?page=" + strconv.Itoa(page) + fpart
-	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
-	if err != nil {
-		return nil, false, err
-	}
-	b, err := processBody(res)
-	if err != nil {
-		return nil, false, err
-	}
-	var couponResponse struct {
-		Data []Coupon `json:"data,omitempty"`
-		Meta struct {
-			Pagination Pagination `json:"pagination,omitempty"`
-		} `json:"meta,omitempty"`
-	}
-	err = json.Unmarshal(b, &couponResponse)
+	p, err := ListPage[Coupon](bc, newURL("/v3/coupons").Int("page", int64(page)).Args(args).String())
 	if err != nil {
 		return nil, false, err
 	}
-	return couponResponse.Data, couponResponse.Meta.Pagination.CurrentPage < couponResponse.Meta.Pagination.TotalPages, nil
+	return p.Data, p.More(), nil
 }
@@ -1,14 +1,13 @@
 package bigcommerce
 
 import (
-	"encoding/json"
 	"log"
 	"net/http"
 )
 
 // Currency is entry for BC currency API
 type Currency struct {
-	ID                     int      `json:"id"`
+	ID                     int64    `json:"id"`
 	IsDefault              bool     `json:"is_default"`
 	LastUpdated            string   `json:"last_updated"`
 	CountryIso2            string   `json:"country_iso2"`
@@ -32,7 +31,7 @@ func (bc *Client) GetCurrencies() ([]Currency, error) {
 	url := "/v2/currencies"
 
 	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +43,7 @@ func (bc *Client) GetCurrencies() ([]Currency, error) {
 	}
 
 	var cs []Currency
-	err = json.Unmarshal(body, &cs)
+	err = bc.unmarshal(body, &cs)
 	if err != nil {
 		log.Println(err)
 		return nil, err
@@ -0,0 +1,232 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Customer attribute types
+const (
+	CustomerAttributeString = "string"
+	CustomerAttributeNumber = "number"
+	CustomerAttributeDate   = "date"
+)
+
+// CustomerAttribute is a customer attribute definition
+type CustomerAttribute struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	DateCreated  string `json:"date_created,omitempty"`
+	DateModified string `json:"date_modified,omitempty"`
+}
+
+// CustomerAttributeValue is the value of an attribute for a customer, values are always strings
+type CustomerAttributeValue struct {
+	ID           int64  `json:"id,omitempty"`
+	AttributeID  int64  `json:"attribute_id"`
+	CustomerID   int64  `json:"customer_id"`
+	Value        string `json:"value"`
+	DateCreated  string `json:"date_created,omitempty"`
+	DateModified string `json:"date_modified,omitempty"`
+}
+
+// GetCustomerAttributes returns all customer attribute definitions
+func (bc *Client) GetCustomerAttributes() ([]CustomerAttribute, error) {
+	return ListPages[CustomerAttribute](bc, "/v3/customers/attributes", nil)
+}
+
+// GetCustomerAttributeByName returns the attribute definition with name,
+// definitions are cached after the first call, use GetCustomerAttributes for fresh ones
+func (bc *Client) GetCustomerAttributeByName(name string) (*CustomerAttribute, error) {
+	s := bc.sharedState()
+	s.attributesMu.Lock()
+	defer s.attributesMu.Unlock()
+	if s.customerAttributes == nil {
+		attributes, err := bc.GetCustomerAttributes()
+		if err != nil {
+			return nil, err
+		}
+		s.customerAttributes = map[string]CustomerAttribute{}
+		for _, a := range attributes {
+			s.customerAttributes[a.Name] = a
+		}
+	}
+	a, ok := s.customerAttributes[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown customer attribute %s", name)
+	}
+	return &a, nil
+}
+
+// GetCustomerAttributeValues returns the attribute values of a customer
+func (bc *Client) GetCustomerAttributeValues(customerID int64) ([]CustomerAttributeValue, error) {
+	return ListPages[CustomerAttributeValue](bc, "/v3/customers/attribute-values", map[string]string{"customer_id:in": strconv.FormatInt(customerID, 10)})
+}
+
+// UpsertCustomerAttributeValues creates or updates attribute values, returning the saved values
+func (bc *Client) UpsertCustomerAttributeValues(values []CustomerAttributeValue) ([]CustomerAttributeValue, error) {
+	reqJSON, err := bc.marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	req := bc.getAPIRequest(http.MethodPut, "/v3/customers/attribute-values", bytes.NewReader(reqJSON))
+	res, err := bc.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil {
+		return nil, fmt.Errorf("error saving customer attribute values: %w %s", err, string(body))
+	}
+	var valuesResponse struct {
+		Data []CustomerAttributeValue `json:"data"`
+	}
+	err = bc.unmarshal(body, &valuesResponse)
+	if err != nil {
+		return nil, err
+	}
+	return valuesResponse.Data, nil
+}
+
+// CustomerAttributes reads and writes a customer's attribute values by attribute name,
+// values are loaded on first use and kept in sync with Set calls
+type CustomerAttributes struct {
+	client     *Client
+	CustomerID int64
+	values     map[int64]CustomerAttributeValue
+}
+
+// CustomerAttributes returns the attribute accessor for a customer
+func (bc *Client) CustomerAttributes(customerID int64) *CustomerAttributes {
+	return &CustomerAttributes{client: bc, CustomerID: customerID}
+}
+
+// Reload drops loaded values, the next Get fetches them again
+func (c *CustomerAttributes) Reload() {
+	c.values = nil
+}
+
+// Get returns the raw value of the attribute, ErrNotFound if the customer has no value for it
+func (c *CustomerAttributes) Get(name string) (string, error) {
+	a, err := c.client.GetCustomerAttributeByName(name)
+	if err != nil {
+		return "", err
+	}
+	if c.values == nil {
+		values, err := c.client.GetCustomerAttributeValues(c.CustomerID)
+		if err != nil {
+			return "", err
+		}
+		c.values = map[int64]CustomerAttributeValue{}
+		for _, v := range values {
+			c.values[v.AttributeID] = v
+		}
+	}
+	v, ok := c.values[a.ID]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return v.Value, nil
+}
+
+// GetInt returns the value of a number attribute as int64
+func (c *CustomerAttributes) GetInt(name string) (int64, error) {
+	s, err := c.Get(name)
+	if err != nil {
+		return 0, err
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("customer attribute %s: %v", name, err)
+	}
+	return int64(f), nil
+}
+
+// GetFloat returns the value of a number attribute
+func (c *CustomerAttributes) GetFloat(name string) (float64, error) {
+	s, err := c.Get(name)
+	if err != nil {
+		return 0, err
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("customer attribute %s: %v", name, err)
+	}
+	return f, nil
+}
+
+// GetBool returns the value of an attribute holding "true"/"false" or "1"/"0"
+func (c *CustomerAttributes) GetBool(name string) (bool, error) {
+	s, err := c.Get(name)
+	if err != nil {
+		return false, err
+	}
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, fmt.Errorf("customer attribute %s: %v", name, err)
+	}
+	return b, nil
+}
+
+// GetDate returns the value of a date attribute, both RFC3339 and 2006-01-02 values are accepted
+func (c *CustomerAttributes) GetDate(name string) (time.Time, error) {
+	s, err := c.Get(name)
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		t, err = time.Parse("2006-01-02", s)
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("customer attribute %s: %v", name, err)
+	}
+	return t, nil
+}
+
+// Set saves the raw value of the attribute
+func (c *CustomerAttributes) Set(name, value string) error {
+	a, err := c.client.GetCustomerAttributeByName(name)
+	if err != nil {
+		return err
+	}
+	saved, err := c.client.UpsertCustomerAttributeValues([]CustomerAttributeValue{{
+		AttributeID: a.ID,
+		CustomerID:  c.CustomerID,
+		Value:       value,
+	}})
+	if err != nil {
+		return err
+	}
+	if c.values != nil {
+		for _, v := range saved {
+			c.values[v.AttributeID] = v
+		}
+	}
+	return nil
+}
+
+// SetInt saves the value of a number attribute
+func (c *CustomerAttributes) SetInt(name string, value int64) error {
+	return c.Set(name, strconv.FormatInt(value, 10))
+}
+
+// SetFloat saves the value of a number attribute
+func (c *CustomerAttributes) SetFloat(name string, value float64) error {
+	return c.Set(name, strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// SetBool saves a bool as "true" or "false"
+func (c *CustomerAttributes) SetBool(name string, value bool) error {
+	return c.Set(name, strconv.FormatBool(value))
+}
+
+// SetDate saves the value of a date attribute as RFC3339
+func (c *CustomerAttributes) SetDate(name string, value time.Time) error {
+	return c.Set(name, value.UTC().Format(time.RFC3339))
+}
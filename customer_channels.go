@@ -0,0 +1,60 @@
+package bigcommerce
+
+// InChannel returns true if the customer can sign in to the channel, customers without channel IDs can sign in to all
+func (c *Customer) InChannel(channelID int64) bool {
+	if len(c.ChannelIDs) == 0 {
+		return true
+	}
+	for _, id := range c.ChannelIDs {
+		if id == channelID {
+			return true
+		}
+	}
+	return false
+}
+
+// CustomerChannelFilter selects the customers of a storefront for GetChannelCustomers
+type CustomerChannelFilter struct {
+	// OriginChannelID keeps the customers created in this channel, 0 for any
+	OriginChannelID int64
+	// ChannelID keeps the customers that can sign in to this channel, 0 for any
+	ChannelID int64
+	// Exclusive leaves out customers that can sign in to all channels when ChannelID is set
+	Exclusive bool
+}
+
+// Match returns true if the customer is selected by the filter
+func (f CustomerChannelFilter) Match(c *Customer) bool {
+	if f.OriginChannelID != 0 && c.OriginChannelID != f.OriginChannelID {
+		return false
+	}
+	if f.ChannelID != 0 {
+		if f.Exclusive && len(c.ChannelIDs) == 0 {
+			return false
+		}
+		return c.InChannel(f.ChannelID)
+	}
+	return true
+}
+
+// GetAllCustomers returns all customers, args is a key-value map of additional arguments to pass to the API
+func (bc *Client) GetAllCustomers(args map[string]string) ([]Customer, error) {
+	return ListPages[Customer](bc, "/v3/customers", args)
+}
+
+// GetChannelCustomers returns the customers of a storefront on a multi-storefront store, e.g. for an export
+// per storefront. BigCommerce can't filter customers by channel, so all customers are read and filtered here,
+// args is a key-value map of additional arguments to pass to the API, e.g. date_modified:min
+func (bc *Client) GetChannelCustomers(filter CustomerChannelFilter, args map[string]string) ([]Customer, error) {
+	customers, err := bc.GetAllCustomers(args)
+	if err != nil {
+		return nil, err
+	}
+	ret := []Customer{}
+	for i := range customers {
+		if filter.Match(&customers[i]) {
+			ret = append(ret, customers[i])
+		}
+	}
+	return ret, nil
+}
@@ -1,7 +1,6 @@
 package bigcommerce
 
 import (
-	"encoding/json"
 	"net/http"
 )
 
@@ -28,7 +27,7 @@ type DiscountRule struct {
 
 func (bc *Client) GetCustomerGroups() ([]CustomerGroup, error) {
 	req := bc.getAPIRequest(http.MethodGet, "/v2/customer_groups", nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -38,6 +37,6 @@ func (bc *Client) GetCustomerGroups() ([]CustomerGroup, error) {
 		return nil, err
 	}
 	var ret []CustomerGroup
-	err = json.Unmarshal(body, &ret)
+	err = bc.unmarshal(body, &ret)
 	return ret, err
 }
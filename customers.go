@@ -2,12 +2,10 @@ package bigcommerce
 
 import (
 	"bytes"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"net/http"
-	"strings"
 )
 
 // Customer is a struct for the BigCommerce Customer API
@@ -29,6 +27,13 @@ type Customer struct {
 	ResetPassword    bool        `json:"reset_pass_on_login"`
 	AcceptsMarketing bool        `json:"accepts_marketing"`
 	Addresses        []Address   `json:"addresses"`
+	// OriginChannelID is the channel the customer was created in
+	OriginChannelID int64 `json:"origin_channel_id"`
+	// ChannelIDs are the channels the customer can sign in to, empty for all channels
+	ChannelIDs []int64 `json:"channel_ids"`
+
+	// StoreCreditAmounts is only returned by v3 with include=storecredit
+	StoreCreditAmounts []StoreCredit `json:"store_credit_amounts,omitempty"`
 }
 
 type SaveAccountPayload struct {
@@ -50,8 +55,8 @@ type SaveAccountPayload struct {
 	StoreCreditAmounts                      []struct {
 		Amount float64 `json:"amount,omitempty"`
 	} `json:"store_credit_amounts,omitempty"`
-	OriginChannelID int   `json:"origin_channel_id,omitempty"`
-	ChannelIDs      []int `json:"channel_ids,omitempty"`
+	OriginChannelID int64   `json:"origin_channel_id,omitempty"`
+	ChannelIDs      []int64 `json:"channel_ids,omitempty"`
 	FormFields      []struct {
 		Name  string `json:"name,omitempty"`
 		Value string `json:"value,omitempty"`
@@ -71,8 +76,8 @@ type CreateAccountPayload struct {
 	Authentication                          Authentication `json:"authentication,omitempty"`
 	AcceptsProductReviewAbandonedCartEmails bool           `json:"accepts_product_review_abandoned_cart_emails,omitempty"`
 	StoreCreditAmounts                      []StoreCredit  `json:"store_credit_amounts,omitempty"`
-	OriginChannelID                         int            `json:"origin_channel_id,omitempty"`
-	ChannelIDs                              []int          `json:"channel_ids,omitempty"`
+	OriginChannelID                         int64          `json:"origin_channel_id,omitempty"`
+	ChannelIDs                              []int64        `json:"channel_ids,omitempty"`
 }
 
 // StoreCredit is for CreateAccountPayload's store_credit_ammounts field
@@ -98,15 +103,15 @@ func (bc *Client) ValidateCredentials(email, password string) (int64, error) {
 	var credReq struct {
 		Email     string `json:"email"`
 		Password  string `json:"password"`
-		ChannelID int    `json:"channel_id"`
+		ChannelID int64  `json:"channel_id"`
 	}
 	credReq.Email = email
 	credReq.Password = password
 	credReq.ChannelID = bc.ChannelID
 	var b []byte
-	b, _ = json.Marshal(credReq)
+	b, _ = bc.marshal(credReq)
 	req := bc.getAPIRequest(http.MethodPost, "/v3/customers/validate-credentials", bytes.NewBuffer(b))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return 0, err
 	}
@@ -119,7 +124,7 @@ func (bc *Client) ValidateCredentials(email, password string) (int64, error) {
 		IsValid    bool  `json:"is_valid"`
 		CustomerID int64 `json:"customer_id"`
 	}
-	err = json.Unmarshal(body, &credResptype)
+	err = bc.unmarshal(body, &credResptype)
 	if err != nil {
 		return 0, err
 	}
@@ -135,41 +140,25 @@ func (bc *Client) CreateAccount(payload *CreateAccountPayload) (*Customer, error
 		payload.OriginChannelID = bc.ChannelID
 	}
 	if payload.ChannelIDs == nil {
-		payload.ChannelIDs = []int{bc.ChannelID}
+		payload.ChannelIDs = []int64{bc.ChannelID}
 	}
 	var b []byte
-	b, _ = json.Marshal([]CreateAccountPayload{*payload})
+	b, _ = bc.marshal([]CreateAccountPayload{*payload})
 	req := bc.getAPIRequest(http.MethodPost, "/v3/customers", bytes.NewBuffer(b))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 	body, err := processBody(res)
 	if err != nil {
-		if res.StatusCode == http.StatusUnprocessableEntity {
-			var errResp ErrorResult
-			err = json.Unmarshal(body, &errResp)
-			if err != nil {
-				log.Printf("Error: %s\nResult: %s", err, string(body))
-				return nil, err
-			}
-			if len(errResp.Errors) > 0 {
-				errors := []string{}
-				for _, e := range errResp.Errors {
-					errors = append(errors, e)
-				}
-				return nil, fmt.Errorf("%s", strings.Join(errors, ", "))
-			}
-			return nil, errors.New("unknown error")
-		}
 		log.Printf("Error: %s\nResult: %s", err, string(body))
 		return nil, err
 	}
 	var ret struct {
 		Customers []Customer `json:"data"`
 	}
-	err = json.Unmarshal(body, &ret)
+	err = bc.unmarshal(body, &ret)
 	if err != nil {
 		return nil, err
 	}
@@ -182,41 +171,25 @@ func (bc *Client) SaveAccount(payload *SaveAccountPayload) (*Customer, error) {
 		payload.OriginChannelID = bc.ChannelID
 	}
 	if payload.ChannelIDs == nil {
-		payload.ChannelIDs = []int{bc.ChannelID}
+		payload.ChannelIDs = []int64{bc.ChannelID}
 	}
 	var b []byte
-	b, _ = json.Marshal([]SaveAccountPayload{*payload})
+	b, _ = bc.marshal([]SaveAccountPayload{*payload})
 	req := bc.getAPIRequest(http.MethodPut, "/v3/customers", bytes.NewBuffer(b))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 	body, err := processBody(res)
 	if err != nil {
-		if res.StatusCode == http.StatusUnprocessableEntity {
-			var errResp ErrorResult
-			err = json.Unmarshal(body, &errResp)
-			if err != nil {
-				log.Printf("Error: %s\nResult: %s", err, string(body))
-				return nil, err
-			}
-			if len(errResp.Errors) > 0 {
-				errors := []string{}
-				for _, e := range errResp.Errors {
-					errors = append(errors, e)
-				}
-				return nil, fmt.Errorf("%s", strings.Join(errors, ", "))
-			}
-			return nil, errors.New("unknown error")
-		}
 		log.Printf("Error: %s\nResult: %s", err, string(body))
 		return nil, err
 	}
 	var ret struct {
 		Customers []Customer `json:"data"`
 	}
-	err = json.Unmarshal(body, &ret)
+	err = bc.unmarshal(body, &ret)
 	if err != nil {
 		return nil, err
 	}
@@ -232,32 +205,16 @@ func (bc *Client) CustomerSetFormFields(customerID int64, formFields []FormField
 		formFields[i].CustomerID = customerID
 	}
 	var b []byte
-	b, _ = json.Marshal(formFields)
+	b, _ = bc.marshal(formFields)
 	log.Printf("Fields: %s", string(b))
 	req := bc.getAPIRequest(http.MethodPut, "/v3/customers/form-field-values", bytes.NewBuffer(b))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
-	body, err := processBody(res)
+	_, err = processBody(res)
 	if err != nil {
-		if res.StatusCode == http.StatusUnprocessableEntity {
-			var errResp ErrorResult
-			err = json.Unmarshal(body, &errResp)
-			if err != nil {
-				log.Printf("Error: %s\nResult: %s", err, string(body))
-				return err
-			}
-			if len(errResp.Errors) > 0 {
-				errors := []string{}
-				for _, e := range errResp.Errors {
-					errors = append(errors, e)
-				}
-				return fmt.Errorf("%s", strings.Join(errors, ", "))
-			}
-			return errors.New("unknown error")
-		}
 		return err
 	}
 	return nil
@@ -265,7 +222,7 @@ func (bc *Client) CustomerSetFormFields(customerID int64, formFields []FormField
 
 func (bc *Client) CustomerGetFormFields(customerID int64) ([]FormField, error) {
 	req := bc.getAPIRequest(http.MethodGet, fmt.Sprintf("/v3/customers/form-field-values?customer_id=%d", customerID), nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -277,7 +234,7 @@ func (bc *Client) CustomerGetFormFields(customerID int64) ([]FormField, error) {
 	var ret struct {
 		Data []FormField `json:"data"`
 	}
-	err = json.Unmarshal(body, &ret)
+	err = bc.unmarshal(body, &ret)
 	if err != nil {
 		return nil, err
 	}
@@ -287,7 +244,7 @@ func (bc *Client) CustomerGetFormFields(customerID int64) ([]FormField, error) {
 
 func (bc *Client) GetCustomerByID(customerID int64) (*Customer, error) {
 	req := bc.getAPIRequest(http.MethodGet, fmt.Sprintf("/v3/customers?id:in=%d", customerID), nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -299,7 +256,7 @@ func (bc *Client) GetCustomerByID(customerID int64) (*Customer, error) {
 	var ret struct {
 		Data []Customer `json:"data"`
 	}
-	err = json.Unmarshal(body, &ret)
+	err = bc.unmarshal(body, &ret)
 	if err != nil {
 		return nil, err
 	}
@@ -311,7 +268,7 @@ func (bc *Client) GetCustomerByID(customerID int64) (*Customer, error) {
 
 func (bc *Client) GetCustomerByEmail(email string) (*Customer, error) {
 	req := bc.getAPIRequest(http.MethodGet, fmt.Sprintf("/v3/customers?email:in=%s", email), nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -323,7 +280,7 @@ func (bc *Client) GetCustomerByEmail(email string) (*Customer, error) {
 	var ret struct {
 		Data []Customer `json:"data"`
 	}
-	err = json.Unmarshal(body, &ret)
+	err = bc.unmarshal(body, &ret)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,177 @@
+package bigcommerce
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// CustomsOptions configures where GetCustomsDeclaration finds customs data on products
+type CustomsOptions struct {
+	// HSCodeField is the product custom field or metafield key holding the HS code, "hs_code" when empty
+	HSCodeField string
+	// OriginField is the product custom field or metafield key holding the ISO2 country of origin, "country_of_origin" when empty
+	OriginField string
+	// DefaultOrigin is used for products without a country of origin
+	DefaultOrigin string
+	// UseMetafields also looks in product metafields when a custom field is missing, this costs one extra request per product
+	UseMetafields bool
+}
+
+// CustomsLine is one declared line of a customs declaration
+type CustomsLine struct {
+	OrderProductID  int64   `json:"order_product_id"`
+	ProductID       int64   `json:"product_id"`
+	Sku             string  `json:"sku"`
+	Description     string  `json:"description"`
+	Quantity        int     `json:"quantity"`
+	UnitValue       float64 `json:"unit_value"`
+	TotalValue      float64 `json:"total_value"`
+	UnitWeight      float64 `json:"unit_weight"`
+	TotalWeight     float64 `json:"total_weight"`
+	HSCode          string  `json:"hs_code"`
+	CountryOfOrigin string  `json:"country_of_origin"`
+}
+
+// CustomsDeclaration is the data carrier customs APIs (DHL, FedEx, ...) need for an international shipment
+type CustomsDeclaration struct {
+	OrderID            int64         `json:"order_id"`
+	ShipmentID         int64         `json:"shipment_id,omitempty"`
+	Currency           string        `json:"currency"`
+	WeightUnit         string        `json:"weight_unit"`
+	DestinationCountry string        `json:"destination_country"`
+	Lines              []CustomsLine `json:"lines"`
+	TotalValue         float64       `json:"total_value"`
+	TotalWeight        float64       `json:"total_weight"`
+}
+
+// Missing returns the SKUs of lines without HS code or country of origin
+func (d *CustomsDeclaration) Missing() []string {
+	missing := []string{}
+	for _, l := range d.Lines {
+		if l.HSCode == "" || l.CountryOfOrigin == "" {
+			missing = append(missing, l.Sku)
+		}
+	}
+	return missing
+}
+
+// GetCustomsDeclaration assembles customs data for a shipment of an order, values are ex tax unit prices
+// in the order currency. When shipment is nil all physical order products are declared
+func (bc *Client) GetCustomsDeclaration(orderID int64, shipment *Shipment, opts CustomsOptions) (*CustomsDeclaration, error) {
+	if opts.HSCodeField == "" {
+		opts.HSCodeField = "hs_code"
+	}
+	if opts.OriginField == "" {
+		opts.OriginField = "country_of_origin"
+	}
+	var order Order
+	err := bc.getJSON(newURL("/v2/orders").ID(orderID).String(), &order)
+	if err != nil {
+		return nil, err
+	}
+	products, err := bc.GetOrderProducts(orderID)
+	if err != nil {
+		return nil, err
+	}
+	units, err := bc.GetStoreUnits()
+	if err != nil {
+		return nil, err
+	}
+	decl := &CustomsDeclaration{
+		OrderID:    orderID,
+		Currency:   order.CurrencyCode,
+		WeightUnit: units.Weight,
+		Lines:      []CustomsLine{},
+	}
+
+	quantities := map[int64]int{}
+	if shipment != nil {
+		decl.ShipmentID = shipment.ID
+		for _, item := range shipment.Items {
+			quantities[item.OrderProductId] += int(item.Quantity)
+		}
+		if shipment.ShippingAddress != nil {
+			decl.DestinationCountry = shipment.ShippingAddress.CountryIso2
+		}
+	}
+	if decl.DestinationCountry == "" {
+		addresses, err := bc.GetOrderShippingAddresses(orderID)
+		if err == nil && len(addresses) > 0 {
+			decl.DestinationCountry = addresses[0].CountryIso2
+		}
+	}
+
+	customsData := map[int64][2]string{} // product ID -> HS code, origin
+	for _, op := range products {
+		qty := op.Quantity
+		if shipment != nil {
+			qty = quantities[op.ID]
+		}
+		if qty == 0 || op.Type == "digital" {
+			continue
+		}
+		data, ok := customsData[op.ProductID]
+		if !ok {
+			data, err = bc.productCustomsData(op.ProductID, opts)
+			if err != nil {
+				return nil, fmt.Errorf("customs data for product %d: %v", op.ProductID, err)
+			}
+			customsData[op.ProductID] = data
+		}
+		line := CustomsLine{
+			OrderProductID:  op.ID,
+			ProductID:       op.ProductID,
+			Sku:             op.Sku,
+			Description:     op.Name,
+			Quantity:        qty,
+			UnitValue:       parseAmount(op.PriceExTax),
+			UnitWeight:      parseAmount(op.Weight),
+			HSCode:          data[0],
+			CountryOfOrigin: data[1],
+		}
+		if line.CountryOfOrigin == "" {
+			line.CountryOfOrigin = opts.DefaultOrigin
+		}
+		line.TotalValue = line.UnitValue * float64(qty)
+		line.TotalWeight = line.UnitWeight * float64(qty)
+		decl.TotalValue += line.TotalValue
+		decl.TotalWeight += line.TotalWeight
+		decl.Lines = append(decl.Lines, line)
+	}
+	return decl, nil
+}
+
+// productCustomsData returns the HS code and country of origin of a product
+func (bc *Client) productCustomsData(productID int64, opts CustomsOptions) ([2]string, error) {
+	var data [2]string
+	product, err := bc.GetProductByID(productID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return data, nil // product was deleted since the order was placed
+		}
+		return data, err
+	}
+	for _, cf := range product.CustomFields {
+		switch {
+		case strings.EqualFold(cf.Name, opts.HSCodeField):
+			data[0] = strings.TrimSpace(cf.Value)
+		case strings.EqualFold(cf.Name, opts.OriginField):
+			data[1] = strings.ToUpper(strings.TrimSpace(cf.Value))
+		}
+	}
+	if !opts.UseMetafields || (data[0] != "" && data[1] != "") {
+		return data, nil
+	}
+	mfs, err := bc.GetProductMetafields(productID)
+	if err != nil && err != ErrNoContent {
+		return data, err
+	}
+	if mf, ok := mfs[opts.HSCodeField]; ok && data[0] == "" {
+		data[0] = strings.TrimSpace(mf.Value)
+	}
+	if mf, ok := mfs[opts.OriginField]; ok && data[1] == "" {
+		data[1] = strings.ToUpper(strings.TrimSpace(mf.Value))
+	}
+	return data, nil
+}
@@ -0,0 +1,94 @@
+package bigcommerce
+
+import (
+	"sort"
+	"strings"
+)
+
+// DuplicateItem is a product or variant sharing a SKU or name with others
+type DuplicateItem struct {
+	ProductID int64  `json:"product_id"`
+	VariantID int64  `json:"variant_id,omitempty"` // 0 for the product itself
+	Sku       string `json:"sku,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
+
+// DuplicateGroup is a set of items sharing the same (normalized) key
+type DuplicateGroup struct {
+	Key   string          `json:"key"`
+	Items []DuplicateItem `json:"items"`
+}
+
+// FindDuplicateSKUs scans the catalog page by page, including variant SKUs, and returns
+// groups of products/variants sharing a SKU (case insensitive), which would make imports fail with 409s
+func (bc *Client) FindDuplicateSKUs() ([]DuplicateGroup, error) {
+	groups := map[string][]DuplicateItem{}
+	err := bc.scanProducts(map[string]string{"include": "variants", "include_fields": "sku,name", "limit": "250"}, func(p *Product) {
+		seen := map[string]bool{}
+		if p.Sku != "" {
+			key := strings.ToLower(strings.TrimSpace(p.Sku))
+			groups[key] = append(groups[key], DuplicateItem{ProductID: p.ID, Sku: p.Sku})
+			seen[key] = true
+		}
+		for _, v := range p.Variants {
+			key := strings.ToLower(strings.TrimSpace(v.Sku))
+			if v.Sku == "" || seen[key] {
+				continue // the base variant repeats the product SKU
+			}
+			groups[key] = append(groups[key], DuplicateItem{ProductID: p.ID, VariantID: v.ID, Sku: v.Sku})
+			seen[key] = true
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return duplicateGroups(groups), nil
+}
+
+// FindDuplicateNames scans the catalog page by page and returns groups of products sharing a name,
+// names are compared case insensitive with whitespace collapsed
+func (bc *Client) FindDuplicateNames() ([]DuplicateGroup, error) {
+	groups := map[string][]DuplicateItem{}
+	err := bc.scanProducts(map[string]string{"include_fields": "sku,name", "limit": "250"}, func(p *Product) {
+		key := strings.ToLower(strings.Join(strings.Fields(p.Name), " "))
+		groups[key] = append(groups[key], DuplicateItem{ProductID: p.ID, Sku: p.Sku, Name: p.Name})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return duplicateGroups(groups), nil
+}
+
+// scanProducts calls fn for every product, fetching one page at a time so the catalog is never held in memory
+func (bc *Client) scanProducts(args map[string]string, fn func(p *Product)) error {
+	page := 1
+	for {
+		products, more, err := bc.GetProducts(args, page)
+		if err != nil {
+			if err == ErrNoContent {
+				return nil
+			}
+			return err
+		}
+		for i := range products {
+			fn(&products[i])
+		}
+		if !more {
+			return nil
+		}
+		page++
+	}
+}
+
+func duplicateGroups(groups map[string][]DuplicateItem) []DuplicateGroup {
+	ret := []DuplicateGroup{}
+	for key, items := range groups {
+		if len(items) > 1 {
+			ret = append(ret, DuplicateGroup{Key: key, Items: items})
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Key < ret[j].Key
+	})
+	return ret
+}
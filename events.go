@@ -0,0 +1,230 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrEventSourceClosed is returned for webhooks handed to a HybridEventSource after Run returned
+var ErrEventSourceClosed = errors.New("event source closed")
+
+const (
+	EventSourceWebhook = "webhook"
+	EventSourcePoll    = "poll"
+)
+
+// Event is a store change notification, either delivered by a webhook or discovered by polling
+type Event struct {
+	Scope      string          `json:"scope"`
+	ResourceID int64           `json:"resource_id"`
+	Source     string          `json:"source"`
+	Time       time.Time       `json:"time"`
+	Payload    *WebhookPayload `json:"payload,omitempty"` // only set for webhook events
+}
+
+// EventSource is a unified stream of store events
+type EventSource interface {
+	Events() <-chan Event
+}
+
+// HybridEventSource prefers webhooks, but falls back to polling orders and products
+// modified since the last seen event when no webhook arrived for QuietPeriod.
+// Poll interval starts at PollInterval and doubles on every empty poll up to MaxPollInterval.
+type HybridEventSource struct {
+	Client          *Client
+	QuietPeriod     time.Duration
+	PollInterval    time.Duration
+	MaxPollInterval time.Duration
+
+	events      chan Event
+	done        chan struct{}
+	sendMu      sync.RWMutex
+	mu          sync.Mutex
+	lastWebhook time.Time
+	since       time.Time
+}
+
+// NewHybridEventSource returns a HybridEventSource for client which starts polling
+// after quietPeriod without webhook deliveries
+func NewHybridEventSource(client *Client, quietPeriod time.Duration) *HybridEventSource {
+	now := time.Now()
+	return &HybridEventSource{
+		Client:          client,
+		QuietPeriod:     quietPeriod,
+		PollInterval:    time.Second * 30,
+		MaxPollInterval: time.Minute * 15,
+		events:          make(chan Event, 100),
+		done:            make(chan struct{}),
+		lastWebhook:     now,
+		since:           now,
+	}
+}
+
+// Events returns the event channel, it is closed when Run returns
+func (h *HybridEventSource) Events() <-chan Event {
+	return h.events
+}
+
+// HandleWebhook feeds a received webhook payload into the event stream
+// and resets the quiet period timer, it waits while the event buffer is full
+func (h *HybridEventSource) HandleWebhook(payload *WebhookPayload) {
+	_ = h.HandleWebhookContext(context.Background(), payload)
+}
+
+// HandleWebhookContext is HandleWebhook giving up when ctx is done, e.g. with the context of the
+// webhook request, or Run returned. The quiet period is only reset when the event was queued,
+// so a webhook that wasn't is picked up by the next poll
+func (h *HybridEventSource) HandleWebhookContext(ctx context.Context, payload *WebhookPayload) error {
+	id := payload.Data.ID
+	if id == 0 {
+		id = payload.Data.OrderID
+	}
+	e := Event{
+		Scope:      payload.Scope,
+		ResourceID: id,
+		Source:     EventSourceWebhook,
+		Time:       time.Unix(payload.CreatedAt, 0),
+		Payload:    payload,
+	}
+
+	// Run closes events holding sendMu, after closing done
+	h.sendMu.RLock()
+	defer h.sendMu.RUnlock()
+	select {
+	case <-h.done:
+		return ErrEventSourceClosed
+	default:
+	}
+	select {
+	case h.events <- e:
+	case <-h.done:
+		return ErrEventSourceClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	now := time.Now()
+	h.mu.Lock()
+	h.lastWebhook = now
+	h.since = now
+	h.mu.Unlock()
+	return nil
+}
+
+// Run watches webhook deliveries and polls the API when they stop arriving,
+// until ctx is cancelled
+func (h *HybridEventSource) Run(ctx context.Context) error {
+	defer func() {
+		close(h.done)
+		h.sendMu.Lock()
+		close(h.events)
+		h.sendMu.Unlock()
+	}()
+	interval := h.PollInterval
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+
+		h.mu.Lock()
+		quiet := time.Since(h.lastWebhook) >= h.QuietPeriod
+		since := h.since
+		h.mu.Unlock()
+		if !quiet {
+			interval = h.PollInterval
+			continue
+		}
+
+		pollStart := time.Now()
+		found, err := h.poll(ctx, since)
+		if err != nil {
+			// keep the same since, the next poll retries the window
+			interval = h.backoff(interval)
+			continue
+		}
+		h.mu.Lock()
+		if h.since.Equal(since) {
+			h.since = pollStart
+		}
+		h.mu.Unlock()
+		if found > 0 {
+			interval = h.PollInterval
+		} else {
+			interval = h.backoff(interval)
+		}
+	}
+}
+
+func (h *HybridEventSource) backoff(interval time.Duration) time.Duration {
+	interval *= 2
+	if interval > h.MaxPollInterval {
+		interval = h.MaxPollInterval
+	}
+	return interval
+}
+
+// poll emits events for orders and products modified since the given time, reading all pages
+func (h *HybridEventSource) poll(ctx context.Context, since time.Time) (int, error) {
+	found := 0
+	for page := 1; ; page++ {
+		orders, err := h.Client.GetOrders(map[string]string{
+			"min_date_modified": since.UTC().Format(time.RFC1123Z),
+			"sort":              "date_modified:asc",
+			"page":              strconv.Itoa(page),
+			"limit":             strconv.Itoa(v2PageLimit),
+		})
+		if err != nil {
+			return found, err
+		}
+		for _, o := range orders {
+			modified, _ := time.Parse(time.RFC1123Z, o.DateModified)
+			if !h.emit(ctx, Event{Scope: "store/order/updated", ResourceID: o.ID, Source: EventSourcePoll, Time: modified}) {
+				return found, ctx.Err()
+			}
+			found++
+		}
+		if len(orders) < v2PageLimit {
+			break
+		}
+	}
+
+	page := 1
+	more := true
+	for more {
+		var products []Product
+		var err error
+		products, more, err = h.Client.GetProducts(map[string]string{
+			"date_modified:min": since.UTC().Format(time.RFC3339),
+			"sort":              "date_modified",
+			"include_fields":    "id,date_modified",
+		}, page)
+		if err != nil {
+			if err == ErrNoContent {
+				break
+			}
+			return found, err
+		}
+		for _, p := range products {
+			if !h.emit(ctx, Event{Scope: "store/product/updated", ResourceID: p.ID, Source: EventSourcePoll, Time: p.DateModified}) {
+				return found, ctx.Err()
+			}
+			found++
+		}
+		page++
+	}
+	return found, nil
+}
+
+func (h *HybridEventSource) emit(ctx context.Context, e Event) bool {
+	select {
+	case h.events <- e:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,89 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// ordersServer serves n orders from /v2/orders, paginated, and no products
+func ordersServer(t *testing.T, n int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/stores/store/v2/orders" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		if page < 1 || limit < 1 {
+			t.Errorf("got page %q and limit %q", r.URL.Query().Get("page"), r.URL.Query().Get("limit"))
+			page, limit = 1, 50
+		}
+		orders := []Order{}
+		for id := (page-1)*limit + 1; id <= page*limit && id <= n; id++ {
+			orders = append(orders, Order{ID: int64(id), DateModified: time.Now().Format(time.RFC1123Z)})
+		}
+		if len(orders) == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		json.NewEncoder(w).Encode(orders)
+	}))
+}
+
+func TestHybridEventSourcePollReadsAllPages(t *testing.T) {
+	for _, n := range []int{0, 3, v2PageLimit, 2*v2PageLimit + 7} {
+		t.Run(strconv.Itoa(n), func(t *testing.T) {
+			srv := ordersServer(t, n)
+			defer srv.Close()
+			h := NewHybridEventSource(newTestClient(srv), time.Minute)
+			h.events = make(chan Event, 3*v2PageLimit)
+
+			found, err := h.poll(context.Background(), time.Now().Add(-time.Hour))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if found != n || len(h.events) != n {
+				t.Errorf("got %d events, %d queued, want %d", found, len(h.events), n)
+			}
+		})
+	}
+}
+
+func TestHybridEventSourceHandleWebhook(t *testing.T) {
+	h := NewHybridEventSource(NewClient("store", "token"), time.Minute)
+	h.events = make(chan Event, 1)
+	payload := &WebhookPayload{Scope: "store/order/created"}
+	payload.Data.ID = 1
+
+	if err := h.HandleWebhookContext(context.Background(), payload); err != nil {
+		t.Fatal(err)
+	}
+	// the buffer is full, the webhook is not queued
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := h.HandleWebhookContext(ctx, payload); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v with a full buffer, want context.DeadlineExceeded", err)
+	}
+
+	// a blocked webhook is released when Run returns, later ones fail instead of panicking
+	blocked := make(chan error)
+	go func() {
+		blocked <- h.HandleWebhookContext(context.Background(), payload)
+	}()
+	runCtx, stop := context.WithCancel(context.Background())
+	stop()
+	h.Run(runCtx)
+	if err := <-blocked; !errors.Is(err, ErrEventSourceClosed) && err != nil {
+		t.Errorf("got error %v for the blocked webhook, want ErrEventSourceClosed or nil", err)
+	}
+	if err := h.HandleWebhookContext(context.Background(), payload); !errors.Is(err, ErrEventSourceClosed) {
+		t.Errorf("got error %v after Run returned, want ErrEventSourceClosed", err)
+	}
+	h.HandleWebhook(payload)
+}
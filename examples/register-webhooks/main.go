@@ -0,0 +1,42 @@
+// register-webhooks creates (or re-activates) webhooks for a list of scopes
+//
+//	go run ./examples/register-webhooks -store abc123 -token xyz -destination https://example.com/hooks -scopes store/order/created,store/shipment/created
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/ewarehousing-solutions/bigcommerce-api-go"
+)
+
+func main() {
+	storeHash := flag.String("store", os.Getenv("BC_STORE_HASH"), "BigCommerce store hash")
+	token := flag.String("token", os.Getenv("BC_AUTH_TOKEN"), "BigCommerce X-Auth-Token")
+	destination := flag.String("destination", "", "webhook destination URL (https)")
+	scopes := flag.String("scopes", "store/order/created", "comma separated webhook scopes")
+	flag.Parse()
+
+	if *destination == "" {
+		log.Fatal("-destination is required")
+	}
+
+	client := bigcommerce.NewClient(*storeHash, *token)
+	for _, scope := range strings.Split(*scopes, ",") {
+		id, err := client.CreateWebhook(strings.TrimSpace(scope), *destination, nil)
+		if err != nil {
+			log.Printf("%s: %v", scope, err)
+			continue
+		}
+		log.Printf("%s: webhook %d", scope, id)
+	}
+	hooks, err := client.GetWebhooks()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, h := range hooks {
+		log.Printf("%d %s -> %s active=%v", h.ID, h.Scope, h.Destination, h.IsActive)
+	}
+}
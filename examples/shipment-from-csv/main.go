@@ -0,0 +1,108 @@
+// shipment-from-csv creates order shipments from a CSV file with
+// order_id,order_product_id,quantity,tracking_number,carrier rows.
+// Rows with the same order ID and tracking number end up in one shipment.
+//
+//	go run ./examples/shipment-from-csv -store abc123 -token xyz -file shipped.csv
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/ewarehousing-solutions/bigcommerce-api-go"
+)
+
+type shipmentKey struct {
+	orderID        int64
+	trackingNumber string
+}
+
+// csvFields is the number of fields of a row
+const csvFields = 5
+
+func main() {
+	storeHash := flag.String("store", os.Getenv("BC_STORE_HASH"), "BigCommerce store hash")
+	token := flag.String("token", os.Getenv("BC_AUTH_TOKEN"), "BigCommerce X-Auth-Token")
+	file := flag.String("file", "shipped.csv", "CSV file with order_id,order_product_id,quantity,tracking_number,carrier rows")
+	flag.Parse()
+
+	f, err := os.Open(*file)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	keys, shipments, err := readShipments(f)
+	if err != nil {
+		log.Fatal(err)
+	}
+	createShipments(bigcommerce.NewClient(*storeHash, *token), keys, shipments)
+}
+
+// readShipments groups the rows by order ID and tracking number, in the order they first appear,
+// logging and skipping invalid rows with their line number
+func readShipments(in io.Reader) ([]shipmentKey, map[shipmentKey]*bigcommerce.Shipment, error) {
+	shipments := map[shipmentKey]*bigcommerce.Shipment{}
+	keys := []shipmentKey{}
+	r := csv.NewReader(in)
+	r.FieldsPerRecord = -1
+	for {
+		row, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		line, _ := r.FieldPos(0)
+		if len(row) != csvFields {
+			log.Printf("line %d: skipping row with %d fields, want %d", line, len(row), csvFields)
+			continue
+		}
+		orderID, err1 := strconv.ParseInt(row[0], 10, 64)
+		orderProductID, err2 := strconv.ParseInt(row[1], 10, 64)
+		qty, err3 := strconv.ParseInt(row[2], 10, 64)
+		if err1 != nil || err2 != nil || err3 != nil {
+			log.Printf("line %d: skipping row %v", line, row)
+			continue
+		}
+		key := shipmentKey{orderID: orderID, trackingNumber: row[3]}
+		s, ok := shipments[key]
+		if !ok {
+			s = &bigcommerce.Shipment{
+				TrackingNumber:  row[3],
+				TrackingCarrier: row[4],
+			}
+			shipments[key] = s
+			keys = append(keys, key)
+		}
+		s.Items = append(s.Items, bigcommerce.ShipmentItem{
+			OrderProductId: orderProductID,
+			Quantity:       qty,
+		})
+	}
+	return keys, shipments, nil
+}
+
+// createShipments creates the shipments to the first shipping address of their order
+func createShipments(client bigcommerce.ClientInterface, keys []shipmentKey, shipments map[shipmentKey]*bigcommerce.Shipment) {
+	for _, key := range keys {
+		addresses, err := client.GetOrderShippingAddresses(key.orderID)
+		if err != nil || len(addresses) == 0 {
+			log.Printf("order %d: no shipping address: %v", key.orderID, err)
+			continue
+		}
+		s := shipments[key]
+		s.OrderAddressId = addresses[0].ID
+		created, err := client.CreateOrderShipment(key.orderID, *s)
+		if err != nil {
+			log.Printf("order %d: %v", key.orderID, err)
+			continue
+		}
+		log.Printf("order %d: created shipment %d", key.orderID, created.ID)
+	}
+}
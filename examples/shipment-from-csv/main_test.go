@@ -0,0 +1,47 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ewarehousing-solutions/bigcommerce-api-go"
+	"github.com/ewarehousing-solutions/bigcommerce-api-go/bctest"
+)
+
+func TestShipmentsFromCSV(t *testing.T) {
+	srv := bctest.NewServer()
+	defer srv.Close()
+	srv.AddOrder(bigcommerce.Order{ID: 100, StatusID: bigcommerce.OrderStatusAwaitingFulfillment},
+		[]bigcommerce.OrderProduct{{ID: 1, Quantity: 2, OrderAddressID: 5}, {ID: 2, Quantity: 1, OrderAddressID: 5}})
+	srv.AddOrder(bigcommerce.Order{ID: 101, StatusID: bigcommerce.OrderStatusAwaitingFulfillment},
+		[]bigcommerce.OrderProduct{{ID: 3, Quantity: 1, OrderAddressID: 6}})
+
+	csv := strings.Join([]string{
+		"100,1,2,TRACK1,ups",
+		"100,2,1,TRACK1,ups",
+		"101,3",                // too few fields
+		"101,3,one,TRACK2,ups", // invalid quantity
+		"101,3,1,TRACK2,dhl,extra",
+		"101,3,1,TRACK3,dhl",
+	}, "\n")
+	keys, shipments, err := readShipments(strings.NewReader(csv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("got %d shipments, want 2", len(keys))
+	}
+	createShipments(srv.Client(), keys, shipments)
+
+	got := srv.Shipments(100)
+	if len(got) != 1 || len(got[0].Items) != 2 || got[0].OrderAddressId != 5 || got[0].TrackingNumber != "TRACK1" {
+		t.Errorf("got shipments %+v for order 100, want one with both products", got)
+	}
+	got = srv.Shipments(101)
+	if len(got) != 1 || got[0].TrackingNumber != "TRACK3" || got[0].OrderAddressId != 6 {
+		t.Errorf("got shipments %+v for order 101, want one with TRACK3", got)
+	}
+	if order, _ := srv.Order(100); order.StatusID != bigcommerce.OrderStatusShipped {
+		t.Errorf("got order status %d, want shipped", order.StatusID)
+	}
+}
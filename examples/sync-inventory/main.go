@@ -0,0 +1,74 @@
+// sync-inventory sets absolute stock levels at a location from a CSV file with sku,quantity rows
+//
+//	go run ./examples/sync-inventory -store abc123 -token xyz -location 1 -file stock.csv
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/ewarehousing-solutions/bigcommerce-api-go"
+)
+
+func main() {
+	storeHash := flag.String("store", os.Getenv("BC_STORE_HASH"), "BigCommerce store hash")
+	token := flag.String("token", os.Getenv("BC_AUTH_TOKEN"), "BigCommerce X-Auth-Token")
+	location := flag.Int64("location", 1, "inventory location ID")
+	file := flag.String("file", "stock.csv", "CSV file with sku,quantity rows")
+	reason := flag.String("reason", "stock sync", "adjustment reason")
+	flag.Parse()
+
+	f, err := os.Open(*file)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	adjustment, err := readAdjustment(f, *location, *reason)
+	if err != nil {
+		log.Fatal(err)
+	}
+	client := bigcommerce.NewClient(*storeHash, *token)
+	err = client.AdjustInventoryAbsolute(adjustment)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("updated %d SKUs at location %d", len(adjustment.Items), *location)
+}
+
+// readAdjustment returns the adjustment of the rows at the location, logging and skipping invalid rows
+// with their line number
+func readAdjustment(in io.Reader, location int64, reason string) (*bigcommerce.Adjustment, error) {
+	adjustment := &bigcommerce.Adjustment{Reason: reason}
+	r := csv.NewReader(in)
+	r.FieldsPerRecord = -1
+	for {
+		row, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := r.FieldPos(0)
+		if len(row) != 2 {
+			log.Printf("line %d: skipping row with %d fields, want 2", line, len(row))
+			continue
+		}
+		qty, err := strconv.Atoi(row[1])
+		if err != nil {
+			log.Printf("line %d: skipping %s: %v", line, row[0], err)
+			continue
+		}
+		adjustment.Items = append(adjustment.Items, bigcommerce.AdjustmentItem{
+			LocationId: location,
+			Sku:        row[0],
+			Quantity:   qty,
+		})
+	}
+	return adjustment, nil
+}
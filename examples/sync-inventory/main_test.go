@@ -0,0 +1,34 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ewarehousing-solutions/bigcommerce-api-go"
+	"github.com/ewarehousing-solutions/bigcommerce-api-go/bctest"
+)
+
+func TestSyncInventoryFromCSV(t *testing.T) {
+	srv := bctest.NewServer()
+	defer srv.Close()
+	srv.SetInventory(1, bigcommerce.Inventory{Identity: bigcommerce.Identity{VariantID: 77, Sku: "MUG"}, AvailableToSell: 3})
+
+	adjustment, err := readAdjustment(strings.NewReader("MUG,10\nSHIRT\nCAP,many\nCAP,4\n"), 1, "stock sync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(adjustment.Items) != 2 {
+		t.Fatalf("got %d items, want the 2 valid rows", len(adjustment.Items))
+	}
+	err = srv.Client().AdjustInventoryAbsolute(adjustment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stock := map[string]int{}
+	for _, item := range srv.Inventory(1) {
+		stock[item.Identity.Sku] = item.AvailableToSell
+	}
+	if stock["MUG"] != 10 || stock["CAP"] != 4 {
+		t.Errorf("got stock %v, want MUG 10 and CAP 4", stock)
+	}
+}
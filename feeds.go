@@ -0,0 +1,258 @@
+package bigcommerce
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Feed availability values, shared by Google Merchant and Facebook catalogs
+const (
+	FeedInStock    = "in stock"
+	FeedOutOfStock = "out of stock"
+	FeedPreorder   = "preorder"
+)
+
+// FeedItem is one product or variant in a Google Merchant / Facebook catalog feed
+type FeedItem struct {
+	ID                   string   `xml:"g:id" json:"id"`
+	ItemGroupID          string   `xml:"g:item_group_id,omitempty" json:"item_group_id,omitempty"`
+	Title                string   `xml:"g:title" json:"title"`
+	Description          string   `xml:"g:description" json:"description"`
+	Link                 string   `xml:"g:link" json:"link"`
+	ImageLink            string   `xml:"g:image_link,omitempty" json:"image_link,omitempty"`
+	AdditionalImageLinks []string `xml:"g:additional_image_link,omitempty" json:"additional_image_link,omitempty"`
+	Availability         string   `xml:"g:availability" json:"availability"`
+	Price                string   `xml:"g:price" json:"price"`
+	SalePrice            string   `xml:"g:sale_price,omitempty" json:"sale_price,omitempty"`
+	Brand                string   `xml:"g:brand,omitempty" json:"brand,omitempty"`
+	GTIN                 string   `xml:"g:gtin,omitempty" json:"gtin,omitempty"`
+	MPN                  string   `xml:"g:mpn,omitempty" json:"mpn,omitempty"`
+	Condition            string   `xml:"g:condition" json:"condition"`
+	IdentifierExists     string   `xml:"g:identifier_exists,omitempty" json:"identifier_exists,omitempty"`
+}
+
+// FeedOptions configures how products are turned into feed items
+type FeedOptions struct {
+	StoreURL string // storefront base URL, product custom URLs are appended to it
+	Currency string // ISO currency code prices are in, e.g. "EUR"
+	// Brands maps brand IDs to names, GetFeedItems fills it when nil
+	Brands map[int64]string
+	// Variants emits one item per variant grouped by product ID, instead of one item per product
+	Variants bool
+	// DescriptionLength cuts descriptions, Google allows 5000 characters, 0 means no limit
+	DescriptionLength int
+}
+
+// GetFeedItems fetches visible products with variants and images and builds feed items for them
+func (bc *Client) GetFeedItems(opts FeedOptions) ([]FeedItem, error) {
+	if opts.Brands == nil {
+		brands, err := bc.GetAllBrands(map[string]string{})
+		if err != nil {
+			return nil, err
+		}
+		opts.Brands = map[int64]string{}
+		for _, b := range brands {
+			opts.Brands[b.ID] = b.Name
+		}
+	}
+	if opts.StoreURL == "" || opts.Currency == "" {
+		info, err := bc.GetStoreInfo()
+		if err != nil {
+			return nil, err
+		}
+		if opts.StoreURL == "" {
+			opts.StoreURL = info.SecureURL
+		}
+		if opts.Currency == "" {
+			opts.Currency = info.Currency
+		}
+	}
+	products, err := bc.GetAllProducts(map[string]string{
+		"is_visible": "true",
+		"include":    "variants,images",
+	})
+	if err != nil {
+		return nil, err
+	}
+	return BuildFeedItems(products, opts), nil
+}
+
+// BuildFeedItems turns products into feed items, products need variants and images included
+func BuildFeedItems(products []Product, opts FeedOptions) []FeedItem {
+	items := []FeedItem{}
+	for i := range products {
+		p := &products[i]
+		base := FeedItem{
+			ID:           strconv.FormatInt(p.ID, 10),
+			Title:        p.Name,
+			Description:  PlainText(p.Description),
+			Link:         strings.TrimRight(opts.StoreURL, "/") + p.CustomURL.URL,
+			Availability: feedAvailability(p.Availability, p.InventoryTracking, p.InventoryLevel),
+			Price:        feedPrice(p.Price, opts.Currency),
+			Brand:        opts.Brands[p.BrandID],
+			GTIN:         firstNonEmpty(p.Gtin, p.Upc),
+			MPN:          p.Mpn,
+			Condition:    feedCondition(p.Condition),
+		}
+		if opts.DescriptionLength > 0 {
+			base.Description = TruncateText(base.Description, opts.DescriptionLength)
+		}
+		if p.SalePrice > 0 && p.SalePrice < p.Price {
+			base.SalePrice = feedPrice(p.SalePrice, opts.Currency)
+		}
+		for _, img := range p.Images {
+			if img.IsThumbnail && base.ImageLink == "" {
+				base.ImageLink = img.URLZoom
+			} else {
+				base.AdditionalImageLinks = append(base.AdditionalImageLinks, img.URLZoom)
+			}
+		}
+		if base.ImageLink == "" && len(base.AdditionalImageLinks) > 0 {
+			base.ImageLink = base.AdditionalImageLinks[0]
+			base.AdditionalImageLinks = base.AdditionalImageLinks[1:]
+		}
+		if !opts.Variants || len(p.Variants) <= 1 {
+			base.IdentifierExists = feedIdentifierExists(base)
+			items = append(items, base)
+			continue
+		}
+		for _, v := range p.Variants {
+			if v.PurchasingDisabled {
+				continue
+			}
+			item := base
+			item.ID = firstNonEmpty(v.Sku, strconv.FormatInt(v.ID, 10))
+			item.ItemGroupID = base.ID
+			if labels := optionLabels(v.OptionValues); labels != "" {
+				item.Title = base.Title + " - " + labels
+			}
+			price := v.Price
+			if price == 0 {
+				price = p.Price
+			}
+			item.Price = feedPrice(price, opts.Currency)
+			item.SalePrice = ""
+			if v.SalePrice > 0 && v.SalePrice < price {
+				item.SalePrice = feedPrice(v.SalePrice, opts.Currency)
+			}
+			item.Availability = feedAvailability(p.Availability, p.InventoryTracking, v.InventoryLevel)
+			item.GTIN = firstNonEmpty(v.Gtin, v.Upc, base.GTIN)
+			item.MPN = firstNonEmpty(v.Mpn, base.MPN)
+			if v.ImageURL != "" {
+				item.ImageLink = v.ImageURL
+			}
+			item.IdentifierExists = feedIdentifierExists(item)
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// WriteGoogleFeedXML writes items as a Google Merchant RSS 2.0 feed
+func WriteGoogleFeedXML(w io.Writer, title, link string, items []FeedItem) error {
+	feed := struct {
+		XMLName xml.Name `xml:"rss"`
+		Version string   `xml:"version,attr"`
+		NS      string   `xml:"xmlns:g,attr"`
+		Channel struct {
+			Title string     `xml:"title"`
+			Link  string     `xml:"link"`
+			Items []FeedItem `xml:"item"`
+		} `xml:"channel"`
+	}{Version: "2.0", NS: "http://base.google.com/ns/1.0"}
+	feed.Channel.Title = title
+	feed.Channel.Link = link
+	feed.Channel.Items = items
+
+	_, err := io.WriteString(w, xml.Header)
+	if err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	return enc.Encode(feed)
+}
+
+// feedCSVHeader are the columns WriteFeedCSV writes, as accepted by Facebook catalogs and Google Merchant
+var feedCSVHeader = []string{"id", "item_group_id", "title", "description", "link", "image_link", "additional_image_link",
+	"availability", "price", "sale_price", "brand", "gtin", "mpn", "condition"}
+
+// WriteFeedCSV writes items as a CSV feed with a header row
+func WriteFeedCSV(w io.Writer, items []FeedItem) error {
+	cw := csv.NewWriter(w)
+	err := cw.Write(feedCSVHeader)
+	if err != nil {
+		return err
+	}
+	for _, i := range items {
+		err = cw.Write([]string{i.ID, i.ItemGroupID, i.Title, i.Description, i.Link, i.ImageLink,
+			strings.Join(i.AdditionalImageLinks, ","), i.Availability, i.Price, i.SalePrice,
+			i.Brand, i.GTIN, i.MPN, i.Condition})
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func feedPrice(price float64, currency string) string {
+	return strconv.FormatFloat(price, 'f', 2, 64) + " " + currency
+}
+
+func feedAvailability(availability, tracking string, level int) string {
+	if availability == "preorder" {
+		return FeedPreorder
+	}
+	if availability == "disabled" {
+		return FeedOutOfStock
+	}
+	if tracking != "" && tracking != "none" && level <= 0 {
+		return FeedOutOfStock
+	}
+	return FeedInStock
+}
+
+func feedCondition(condition string) string {
+	switch strings.ToLower(condition) {
+	case "used":
+		return "used"
+	case "refurbished":
+		return "refurbished"
+	}
+	return "new"
+}
+
+func feedIdentifierExists(item FeedItem) string {
+	if item.GTIN == "" && item.MPN == "" {
+		return "no"
+	}
+	return ""
+}
+
+// optionLabels joins the labels of variant option values, e.g. "Red / XL"
+func optionLabels(optionValues []interface{}) string {
+	labels := []string{}
+	for _, ov := range optionValues {
+		m, ok := ov.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if label, ok := m["label"].(string); ok && label != "" {
+			labels = append(labels, label)
+		}
+	}
+	return strings.Join(labels, " / ")
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,299 @@
+package bigcommerce
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FilterResource is a list endpoint a FilterBuilder builds filters for
+type FilterResource string
+
+// The list endpoints FilterBuilder knows the filters of
+const (
+	FilterOrders     FilterResource = "orders"     // GetOrders
+	FilterShipments  FilterResource = "shipments"  // GetOrderShipments
+	FilterProducts   FilterResource = "products"   // GetAllProducts, GetProducts, ProductsIterator
+	FilterCategories FilterResource = "categories" // GetAllCategories, GetCategories
+	FilterBrands     FilterResource = "brands"     // GetAllBrands, GetBrands
+	FilterCustomers  FilterResource = "customers"  // /v3/customers
+	FilterInventory  FilterResource = "inventory"  // GetInventoryForLocation, InventoryForLocationIterator
+)
+
+// ErrUnsupportedFilter is returned by FilterBuilder.Build for filters the endpoint doesn't support
+var ErrUnsupportedFilter = errors.New("unsupported filter")
+
+// maxFilterLimit is the largest page size of the list endpoints
+const maxFilterLimit = 250
+
+// filterSpec is what a list endpoint supports
+type filterSpec struct {
+	// v2 endpoints take min_field and max_field with RFC1123Z dates, v3 endpoints field:min with RFC3339 dates
+	v2 bool
+	// fields are the operators supported per field, "eq" is field=value
+	fields map[string]string
+	// sorts are the fields the endpoint sorts by, nil when it can't sort
+	sorts []string
+	// direction is set for endpoints taking the sort direction as separate parameter instead of "field:desc"
+	direction bool
+}
+
+const (
+	numberOps = "eq,in,not_in,min,max,greater,less"
+	rangeOps  = "eq,min,max,greater,less"
+)
+
+var filterSpecs = map[FilterResource]filterSpec{
+	FilterOrders: {
+		v2: true,
+		fields: map[string]string{
+			"id": "min,max", "total": "min,max", "customer_id": "eq", "email": "eq", "status_id": "eq",
+			"cart_id": "eq", "payment_method": "eq", "channel_id": "eq", "is_deleted": "eq",
+			"date_created": "min,max", "date_modified": "min,max",
+		},
+		sorts: []string{"id", "customer_id", "date_created", "date_modified", "status_id", "channel_id"},
+	},
+	FilterShipments: {
+		v2:     true,
+		fields: map[string]string{"date_created": "min,max"},
+	},
+	FilterProducts: {
+		fields: map[string]string{
+			"id": numberOps, "name": "eq,like", "sku": "eq,in", "upc": "eq", "price": rangeOps, "weight": rangeOps,
+			"condition": "eq", "brand_id": "eq", "date_modified": rangeOps, "date_last_imported": rangeOps,
+			"is_visible": "eq", "is_featured": "eq", "is_free_shipping": "eq", "inventory_level": numberOps,
+			"inventory_low": "eq", "out_of_stock": "eq", "total_sold": rangeOps, "type": "eq", "categories": "eq,in",
+			"keyword": "eq", "keyword_context": "eq", "status": "eq", "availability": "eq", "channel_id": "in",
+			"include": "eq", "include_fields": "eq", "exclude_fields": "eq",
+		},
+		sorts:     []string{"id", "name", "sku", "price", "date_modified", "date_last_imported", "inventory_level", "is_visible", "total_sold"},
+		direction: true,
+	},
+	FilterCategories: {
+		fields: map[string]string{
+			"id": numberOps, "name": "eq,like,not_in", "parent_id": numberOps, "page_title": "eq,like,not_in",
+			"keyword": "eq", "is_visible": "eq", "include_fields": "eq", "exclude_fields": "eq",
+		},
+	},
+	FilterBrands: {
+		fields: map[string]string{
+			"id": numberOps, "name": "eq,like", "page_title": "eq", "include_fields": "eq", "exclude_fields": "eq",
+		},
+	},
+	FilterCustomers: {
+		fields: map[string]string{
+			"id": "in", "email": "in", "name": "in,like", "company": "in", "phone": "in", "customer_group_id": "in",
+			"registration_ip_address": "in", "date_created": "eq,min,max", "date_modified": "eq,min,max", "include": "eq",
+		},
+		sorts: []string{"date_created", "date_modified", "last_name"},
+	},
+	FilterInventory: {
+		fields: map[string]string{"sku": "in", "variant_id": "in", "product_id": "in"},
+	},
+}
+
+type filterCondition struct {
+	field, op string
+	values    []string
+	time      time.Time
+}
+
+// FilterBuilder builds the filters of list endpoints, checking them against what the endpoint supports:
+//
+//	filters, err := bigcommerce.Filter().DateCreatedMin(since).Limit(250).Sort("date_created:desc").Build(bigcommerce.FilterOrders)
+//	orders, err := bc.GetOrders(filters)
+//
+// Dates are formatted the way the endpoint expects, values are escaped when the request is built
+type FilterBuilder struct {
+	conditions []filterCondition
+	sort       string
+	page       int
+	limit      int
+	err        error
+}
+
+// Filter returns an empty FilterBuilder
+func Filter() *FilterBuilder {
+	return &FilterBuilder{}
+}
+
+func (f *FilterBuilder) add(field, op string, values ...string) *FilterBuilder {
+	if values == nil {
+		values = []string{} // nil values are a time condition
+	}
+	f.conditions = append(f.conditions, filterCondition{field: field, op: op, values: values})
+	return f
+}
+
+func (f *FilterBuilder) addTime(field, op string, t time.Time) *FilterBuilder {
+	f.conditions = append(f.conditions, filterCondition{field: field, op: op, time: t})
+	return f
+}
+
+// Eq filters on field equal to value
+func (f *FilterBuilder) Eq(field, value string) *FilterBuilder {
+	return f.add(field, "eq", value)
+}
+
+// In filters on field being one of values
+func (f *FilterBuilder) In(field string, values ...string) *FilterBuilder {
+	return f.add(field, "in", values...)
+}
+
+// InIDs filters on field being one of ids
+func (f *FilterBuilder) InIDs(field string, ids ...int64) *FilterBuilder {
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = strconv.FormatInt(id, 10)
+	}
+	return f.add(field, "in", values...)
+}
+
+// NotIn filters on field being none of values
+func (f *FilterBuilder) NotIn(field string, values ...string) *FilterBuilder {
+	return f.add(field, "not_in", values...)
+}
+
+// Min filters on field being at least value
+func (f *FilterBuilder) Min(field, value string) *FilterBuilder {
+	return f.add(field, "min", value)
+}
+
+// Max filters on field being at most value
+func (f *FilterBuilder) Max(field, value string) *FilterBuilder {
+	return f.add(field, "max", value)
+}
+
+// Like filters on field containing value
+func (f *FilterBuilder) Like(field, value string) *FilterBuilder {
+	return f.add(field, "like", value)
+}
+
+// DateCreatedMin filters on created at or after t
+func (f *FilterBuilder) DateCreatedMin(t time.Time) *FilterBuilder {
+	return f.addTime("date_created", "min", t)
+}
+
+// DateCreatedMax filters on created at or before t
+func (f *FilterBuilder) DateCreatedMax(t time.Time) *FilterBuilder {
+	return f.addTime("date_created", "max", t)
+}
+
+// DateModifiedMin filters on modified at or after t
+func (f *FilterBuilder) DateModifiedMin(t time.Time) *FilterBuilder {
+	return f.addTime("date_modified", "min", t)
+}
+
+// DateModifiedMax filters on modified at or before t
+func (f *FilterBuilder) DateModifiedMax(t time.Time) *FilterBuilder {
+	return f.addTime("date_modified", "max", t)
+}
+
+// Include asks for sub-resources, e.g. "variants", "images"
+func (f *FilterBuilder) Include(resources ...string) *FilterBuilder {
+	return f.add("include", "eq", resources...)
+}
+
+// Sort sorts by a field, "field" for ascending or "field:asc" / "field:desc"
+func (f *FilterBuilder) Sort(sort string) *FilterBuilder {
+	f.sort = sort
+	return f
+}
+
+// Page sets the page to get, starting at 1
+func (f *FilterBuilder) Page(page int) *FilterBuilder {
+	f.page = page
+	return f
+}
+
+// Limit sets the page size, at most 250
+func (f *FilterBuilder) Limit(limit int) *FilterBuilder {
+	if limit < 1 || limit > maxFilterLimit {
+		f.err = fmt.Errorf("%w: limit %d, must be 1 to %d", ErrUnsupportedFilter, limit, maxFilterLimit)
+	}
+	f.limit = limit
+	return f
+}
+
+// Build returns the filters for a list endpoint, or an error wrapping ErrUnsupportedFilter for filters,
+// operators or sorts it doesn't support
+func (f *FilterBuilder) Build(resource FilterResource) (map[string]string, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	spec, ok := filterSpecs[resource]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown resource %q", ErrUnsupportedFilter, resource)
+	}
+	args := map[string]string{}
+	for _, c := range f.conditions {
+		ops, ok := spec.fields[c.field]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s can't be filtered on %s", ErrUnsupportedFilter, resource, c.field)
+		}
+		if !containsOp(ops, c.op) {
+			return nil, fmt.Errorf("%w: %s can't filter %s with %s, only %s", ErrUnsupportedFilter, resource, c.field, c.op, ops)
+		}
+		value := ""
+		if c.values == nil {
+			if spec.v2 {
+				value = c.time.UTC().Format(time.RFC1123Z)
+			} else {
+				value = c.time.UTC().Format(time.RFC3339)
+			}
+		} else {
+			value = strings.Join(c.values, ",")
+		}
+		args[filterKey(spec, c.field, c.op)] = value
+	}
+	if f.sort != "" {
+		field, direction, _ := strings.Cut(f.sort, ":")
+		if direction != "" && direction != "asc" && direction != "desc" {
+			return nil, fmt.Errorf("%w: sort direction %q", ErrUnsupportedFilter, direction)
+		}
+		if !containsOp(strings.Join(spec.sorts, ","), field) {
+			return nil, fmt.Errorf("%w: %s can't be sorted by %s", ErrUnsupportedFilter, resource, field)
+		}
+		switch {
+		case spec.direction:
+			args["sort"] = field
+			if direction != "" {
+				args["direction"] = direction
+			}
+		case direction != "":
+			args["sort"] = field + ":" + direction
+		default:
+			args["sort"] = field
+		}
+	}
+	if f.page > 0 {
+		args["page"] = strconv.Itoa(f.page)
+	}
+	if f.limit > 0 {
+		args["limit"] = strconv.Itoa(f.limit)
+	}
+	return args, nil
+}
+
+// filterKey returns the query parameter of a filter: field, min_field for v2 and field:min for v3
+func filterKey(spec filterSpec, field, op string) string {
+	switch {
+	case op == "eq":
+		return field
+	case spec.v2:
+		return op + "_" + field
+	}
+	return field + ":" + op
+}
+
+// containsOp returns true if the comma separated list contains op
+func containsOp(list, op string) bool {
+	for _, o := range strings.Split(list, ",") {
+		if o == op {
+			return true
+		}
+	}
+	return false
+}
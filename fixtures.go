@@ -0,0 +1,135 @@
+package bigcommerce
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FixtureResources maps resource names accepted by RecordFixtures to the API path they are fetched from
+var FixtureResources = map[string]string{
+	"store":      "/v2/store",
+	"currencies": "/v2/currencies",
+	"orders":     "/v2/orders?limit=50",
+	"products":   "/v3/catalog/products?limit=50&include=variants,images,custom_fields",
+	"categories": "/v3/catalog/categories?limit=250",
+	"brands":     "/v3/catalog/brands?limit=250",
+	"customers":  "/v3/customers?limit=50",
+	"addresses":  "/v3/customers/addresses?limit=50",
+	"channels":   "/v3/channels",
+	"locations":  "/v3/inventory/locations",
+	"coupons":    "/v3/coupons?limit=50",
+	"webhooks":   "/v3/hooks",
+}
+
+// fixtureRedactedKeys are JSON keys whose string values are replaced when recording fixtures
+var fixtureRedactedKeys = map[string]bool{
+	"email":                   true,
+	"phone":                   true,
+	"first_name":              true,
+	"last_name":               true,
+	"company":                 true,
+	"street_1":                true,
+	"street_2":                true,
+	"address1":                true,
+	"address2":                true,
+	"address":                 true,
+	"ip_address":              true,
+	"ip_address_v6":           true,
+	"registration_ip_address": true,
+	"admin_email":             true,
+	"order_email":             true,
+	"customer_message":        true,
+	"staff_notes":             true,
+	"notes":                   true,
+	"headers":                 true,
+}
+
+// RecordFixtures fetches live responses for the given resources, redacts personal data
+// and writes them as indented JSON files into dir, one <resource>.json file per resource.
+// resources: names from FixtureResources or raw API paths like "/v2/orders/100/products"
+func (bc *Client) RecordFixtures(resources []string, dir string) error {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+	for _, resource := range resources {
+		path, ok := FixtureResources[resource]
+		if !ok {
+			if !strings.HasPrefix(resource, "/") {
+				return fmt.Errorf("unknown fixture resource %s", resource)
+			}
+			path = resource
+		}
+
+		req := bc.getAPIRequest(http.MethodGet, path, nil)
+		res, err := bc.do(req)
+		if err != nil {
+			return err
+		}
+		body, err := processBody(res)
+		res.Body.Close()
+		if err != nil {
+			if err != ErrNoContent {
+				return fmt.Errorf("%s: %v", resource, err)
+			}
+			body = []byte("[]")
+		}
+
+		var payload interface{}
+		err = bc.unmarshal(body, &payload)
+		if err != nil {
+			return fmt.Errorf("%s: %v", resource, err)
+		}
+		b, err := json.MarshalIndent(redactFixture(payload), "", "  ")
+		if err != nil {
+			return err
+		}
+		err = ioutil.WriteFile(filepath.Join(dir, FixtureFileName(resource)), b, 0644)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FixtureFileName returns the file name a resource is recorded to,
+// raw paths have their query string dropped and slashes replaced, e.g. "/v2/orders/1" -> "v2_orders_1.json"
+func FixtureFileName(resource string) string {
+	if i := strings.Index(resource, "?"); i >= 0 {
+		resource = resource[:i]
+	}
+	return strings.ReplaceAll(strings.Trim(resource, "/"), "/", "_") + ".json"
+}
+
+func redactFixture(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, val := range t {
+			if fixtureRedactedKeys[k] {
+				switch val.(type) {
+				case string:
+					t[k] = "REDACTED"
+					continue
+				case map[string]interface{}:
+					if k == "headers" {
+						t[k] = map[string]interface{}{}
+						continue
+					}
+				}
+			}
+			t[k] = redactFixture(val)
+		}
+		return t
+	case []interface{}:
+		for i := range t {
+			t[i] = redactFixture(t[i])
+		}
+		return t
+	}
+	return v
+}
@@ -0,0 +1,96 @@
+package bigcommerce
+
+// Fulfillment statuses computed from shipments, these match the BigCommerce order status names
+const (
+	FulfillmentUnshipped        = "Unshipped"
+	FulfillmentPartiallyShipped = "Partially Shipped"
+	FulfillmentShipped          = "Shipped"
+)
+
+// LineFulfillment is the fulfillment state of one order product
+type LineFulfillment struct {
+	OrderProductID int64  `json:"order_product_id"`
+	ProductID      int64  `json:"product_id"`
+	Sku            string `json:"sku"`
+	Name           string `json:"name"`
+	Ordered        int    `json:"ordered"`
+	Refunded       int    `json:"refunded"`
+	Shipped        int    `json:"shipped"`
+	Remaining      int    `json:"remaining"` // ordered - refunded - shipped, never negative
+}
+
+// Fulfillment is the fulfillment state of an order as shipments show it
+type Fulfillment struct {
+	OrderID int64             `json:"order_id"`
+	Status  string            `json:"status"`
+	Lines   []LineFulfillment `json:"lines"`
+}
+
+// ComputeFulfillmentStatus returns the fulfillment state of an order computed from its shipments and products,
+// independent of the order status which merchants sometimes set by hand
+func (bc *Client) ComputeFulfillmentStatus(orderID int64) (*Fulfillment, error) {
+	products, err := bc.GetOrderProducts(orderID)
+	if err != nil {
+		return nil, err
+	}
+	shipments, err := bc.GetAllOrderShipments(orderID)
+	if err != nil && err != ErrNoContent {
+		return nil, err
+	}
+	f := FulfillmentStatusOf(products, shipments)
+	f.OrderID = orderID
+	return f, nil
+}
+
+// FulfillmentStatusOf computes the fulfillment state from order products and shipments,
+// digital products and fully refunded lines don't need shipping and are left out
+func FulfillmentStatusOf(products []OrderProduct, shipments []Shipment) *Fulfillment {
+	shipped := map[int64]int{}
+	for _, s := range shipments {
+		for _, item := range s.Items {
+			shipped[item.OrderProductId] += int(item.Quantity)
+		}
+	}
+	f := &Fulfillment{Lines: []LineFulfillment{}}
+	anyShipped := false
+	allShipped := true
+	for _, p := range products {
+		if p.Type == "digital" {
+			continue
+		}
+		line := LineFulfillment{
+			OrderProductID: p.ID,
+			ProductID:      p.ProductID,
+			Sku:            p.Sku,
+			Name:           p.Name,
+			Ordered:        p.Quantity,
+			Refunded:       p.QuantityRefunded,
+			Shipped:        shipped[p.ID],
+		}
+		line.Remaining = line.Ordered - line.Refunded - line.Shipped
+		if line.Remaining < 0 {
+			line.Remaining = 0
+		}
+		if line.Ordered-line.Refunded <= 0 {
+			continue
+		}
+		if line.Shipped > 0 {
+			anyShipped = true
+		}
+		if line.Remaining > 0 {
+			allShipped = false
+		}
+		f.Lines = append(f.Lines, line)
+	}
+	switch {
+	case len(f.Lines) == 0:
+		f.Status = FulfillmentShipped // nothing to ship
+	case !anyShipped:
+		f.Status = FulfillmentUnshipped
+	case allShipped:
+		f.Status = FulfillmentShipped
+	default:
+		f.Status = FulfillmentPartiallyShipped
+	}
+	return f
+}
@@ -0,0 +1,263 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FulfillmentConfirmation is a shipping confirmation from a 3PL or WMS: an order, the tracking details
+// and the shipped quantities by SKU. Adapters turn the formats of fulfillment partners into confirmations
+type FulfillmentConfirmation struct {
+	OrderID          int64             `json:"order_id"`
+	TrackingNumber   string            `json:"tracking_number"`
+	TrackingCarrier  string            `json:"tracking_carrier,omitempty"`
+	ShippingProvider string            `json:"shipping_provider,omitempty"`
+	ShippingMethod   string            `json:"shipping_method,omitempty"`
+	Comments         string            `json:"comments,omitempty"`
+	Lines            []FulfillmentLine `json:"lines"`
+}
+
+// FulfillmentLine is a shipped quantity of a SKU
+type FulfillmentLine struct {
+	Sku      string `json:"sku"`
+	Quantity int64  `json:"quantity"`
+}
+
+// FulfillmentAdapter parses a message of a fulfillment partner into confirmations.
+// Implement it for a partner's format and pass it to CreateShipmentsFromMessage
+type FulfillmentAdapter interface {
+	Confirmations(message []byte) ([]FulfillmentConfirmation, error)
+}
+
+// FulfillmentAdapterFunc turns a function into a FulfillmentAdapter
+type FulfillmentAdapterFunc func(message []byte) ([]FulfillmentConfirmation, error)
+
+// Confirmations calls f(message)
+func (f FulfillmentAdapterFunc) Confirmations(message []byte) ([]FulfillmentConfirmation, error) {
+	return f(message)
+}
+
+// FulfillmentLineError is returned when a confirmed SKU can't be matched to unshipped order products
+type FulfillmentLineError struct {
+	OrderID int64
+	Sku     string
+	// Quantity is the confirmed quantity, Unallocated the part of it no order product was left for
+	Quantity    int64
+	Unallocated int64
+}
+
+func (e *FulfillmentLineError) Error() string {
+	if e.Unallocated == e.Quantity {
+		return fmt.Sprintf("order %d: no unshipped product with SKU %q", e.OrderID, e.Sku)
+	}
+	return fmt.Sprintf("order %d: %d of %d confirmed for SKU %q exceed the unshipped quantity",
+		e.OrderID, e.Unallocated, e.Quantity, e.Sku)
+}
+
+// ShipmentsFromConfirmation resolves the SKUs of a confirmation to order products and returns the shipments to
+// create, one per order address the products ship to. Quantities go to the order products with the SKU that have
+// the most left to ship first, SKUs are matched case-insensitively. Digital and refunded quantities are not shippable
+func (bc *Client) ShipmentsFromConfirmation(c FulfillmentConfirmation) ([]Shipment, error) {
+	products, err := bc.GetOrderProducts(c.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	return shipmentsFromConfirmation(c, products)
+}
+
+// CreateShipmentsFromConfirmation creates the shipments of a confirmation, see ShipmentsFromConfirmation
+func (bc *Client) CreateShipmentsFromConfirmation(c FulfillmentConfirmation) ([]Shipment, error) {
+	shipments, err := bc.ShipmentsFromConfirmation(c)
+	if err != nil {
+		return nil, err
+	}
+	created := []Shipment{}
+	for _, s := range shipments {
+		cs, err := bc.CreateOrderShipment(c.OrderID, s)
+		if err != nil {
+			return created, fmt.Errorf("order %d: error creating shipment %s: %w", c.OrderID, c.TrackingNumber, err)
+		}
+		created = append(created, *cs)
+	}
+	return created, nil
+}
+
+// CreateShipmentsFromMessage parses a message with adapter and creates the shipments of every confirmation in it.
+// It continues after a failed confirmation and returns the shipments created with the first error
+func (bc *Client) CreateShipmentsFromMessage(adapter FulfillmentAdapter, message []byte) ([]Shipment, error) {
+	confirmations, err := adapter.Confirmations(message)
+	if err != nil {
+		return nil, err
+	}
+	created := []Shipment{}
+	var firstErr error
+	for _, c := range confirmations {
+		shipments, err := bc.CreateShipmentsFromConfirmation(c)
+		created = append(created, shipments...)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return created, firstErr
+}
+
+// shipmentsFromConfirmation allocates the confirmed quantities to the order products
+func shipmentsFromConfirmation(c FulfillmentConfirmation, products []OrderProduct) ([]Shipment, error) {
+	left := map[int64]int64{}
+	bySKU := map[string][]OrderProduct{}
+	for _, p := range products {
+		remaining := p.QuantityToShip()
+		if p.Type == "digital" || remaining <= 0 {
+			continue
+		}
+		left[p.ID] = int64(remaining)
+		sku := strings.ToLower(strings.TrimSpace(p.Sku))
+		bySKU[sku] = append(bySKU[sku], p)
+	}
+	for _, ps := range bySKU {
+		sort.SliceStable(ps, func(i, j int) bool {
+			return left[ps[i].ID] > left[ps[j].ID]
+		})
+	}
+
+	shipments := map[int64]*Shipment{}
+	addressIDs := []int64{}
+	for _, line := range c.Lines {
+		qty := line.Quantity
+		for _, p := range bySKU[strings.ToLower(strings.TrimSpace(line.Sku))] {
+			if qty == 0 {
+				break
+			}
+			n := left[p.ID]
+			if n > qty {
+				n = qty
+			}
+			if n == 0 {
+				continue
+			}
+			left[p.ID] -= n
+			qty -= n
+			s, ok := shipments[p.OrderAddressID]
+			if !ok {
+				s = &Shipment{
+					OrderAddressId:   p.OrderAddressID,
+					TrackingNumber:   c.TrackingNumber,
+					TrackingCarrier:  c.TrackingCarrier,
+					ShippingProvider: c.ShippingProvider,
+					ShippingMethod:   c.ShippingMethod,
+					Comments:         TruncateShipmentComments(c.Comments),
+				}
+				shipments[p.OrderAddressID] = s
+				addressIDs = append(addressIDs, p.OrderAddressID)
+			}
+			s.Items = addShipmentItem(s.Items, p.ID, n)
+		}
+		if qty > 0 {
+			return nil, &FulfillmentLineError{OrderID: c.OrderID, Sku: line.Sku, Quantity: line.Quantity, Unallocated: qty}
+		}
+	}
+	ret := make([]Shipment, 0, len(addressIDs))
+	for _, id := range addressIDs {
+		ret = append(ret, *shipments[id])
+	}
+	return ret, nil
+}
+
+// addShipmentItem adds a quantity of an order product to shipment items, merging lines for the same product
+func addShipmentItem(items []ShipmentItem, orderProductID, quantity int64) []ShipmentItem {
+	for i := range items {
+		if items[i].OrderProductId == orderProductID {
+			items[i].Quantity += quantity
+			return items
+		}
+	}
+	return append(items, ShipmentItem{OrderProductId: orderProductID, Quantity: quantity})
+}
+
+// JSONFulfillmentAdapter reads a JSON array of FulfillmentConfirmation, or a single one
+var JSONFulfillmentAdapter = FulfillmentAdapterFunc(func(message []byte) ([]FulfillmentConfirmation, error) {
+	trimmed := bytes.TrimSpace(message)
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		var c FulfillmentConfirmation
+		err := json.Unmarshal(trimmed, &c)
+		if err != nil {
+			return nil, err
+		}
+		return []FulfillmentConfirmation{c}, nil
+	}
+	var cs []FulfillmentConfirmation
+	err := json.Unmarshal(trimmed, &cs)
+	return cs, err
+})
+
+// CSVFulfillmentAdapter reads a CSV with a header row naming the columns order_id, sku, quantity,
+// tracking_number and optionally tracking_carrier, shipping_provider and shipping_method, in any order.
+// Rows with the same order ID and tracking number make up one confirmation
+var CSVFulfillmentAdapter = FulfillmentAdapterFunc(func(message []byte) ([]FulfillmentConfirmation, error) {
+	r := csv.NewReader(bytes.NewReader(message))
+	r.FieldsPerRecord = -1
+	header, err := r.Read()
+	if err != nil {
+		return nil, err
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"order_id", "sku", "quantity", "tracking_number"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("fulfillment CSV has no %s column", required)
+		}
+	}
+	field := func(row []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(row) {
+			return ""
+		}
+		return strings.TrimSpace(row[i])
+	}
+
+	type key struct {
+		orderID        int64
+		trackingNumber string
+	}
+	index := map[key]int{}
+	confirmations := []FulfillmentConfirmation{}
+	for line := 2; ; line++ {
+		row, err := r.Read()
+		if err == io.EOF {
+			return confirmations, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		orderID, err := strconv.ParseInt(field(row, "order_id"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("fulfillment CSV line %d: invalid order_id: %w", line, err)
+		}
+		qty, err := strconv.ParseInt(field(row, "quantity"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("fulfillment CSV line %d: invalid quantity: %w", line, err)
+		}
+		k := key{orderID: orderID, trackingNumber: field(row, "tracking_number")}
+		i, ok := index[k]
+		if !ok {
+			i = len(confirmations)
+			index[k] = i
+			confirmations = append(confirmations, FulfillmentConfirmation{
+				OrderID:          orderID,
+				TrackingNumber:   k.trackingNumber,
+				TrackingCarrier:  field(row, "tracking_carrier"),
+				ShippingProvider: field(row, "shipping_provider"),
+				ShippingMethod:   field(row, "shipping_method"),
+			})
+		}
+		confirmations[i].Lines = append(confirmations[i].Lines, FulfillmentLine{Sku: field(row, "sku"), Quantity: qty})
+	}
+})
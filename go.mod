@@ -1,6 +1,6 @@
 module github.com/ewarehousing-solutions/bigcommerce-api-go
 
-go 1.17
+go 1.21
 
 require (
 	github.com/go-chi/jwtauth/v5 v5.0.2
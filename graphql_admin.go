@@ -0,0 +1,281 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GraphQLError is a single error entry from a BigCommerce GraphQL response
+type GraphQLError struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// GraphQLErrors is returned when a GraphQL response contains errors
+type GraphQLErrors []GraphQLError
+
+func (e GraphQLErrors) Error() string {
+	msgs := []string{}
+	for _, ge := range e {
+		msgs = append(msgs, ge.Message)
+	}
+	return "graphql: " + strings.Join(msgs, ", ")
+}
+
+// ProductTranslation is the localized content of a product for a channel locale
+type ProductTranslation struct {
+	Name            string `json:"name,omitempty"`
+	Description     string `json:"description,omitempty"`
+	PageTitle       string `json:"pageTitle,omitempty"`
+	MetaDescription string `json:"metaDescription,omitempty"`
+}
+
+// CategoryTranslation is the localized content of a category for a channel locale
+type CategoryTranslation struct {
+	Name            string `json:"name,omitempty"`
+	Description     string `json:"description,omitempty"`
+	PageTitle       string `json:"page_title,omitempty"`
+	MetaDescription string `json:"meta_description,omitempty"`
+}
+
+type localeContext struct {
+	ChannelID string `json:"channelId"`
+	Locale    string `json:"locale"`
+}
+
+// AdminGraphQL executes a query or mutation against the store's GraphQL Admin API
+// query: the GraphQL document
+// variables: GraphQL variables, may be nil
+// result: pointer to unmarshal the "data" part of the response into, may be nil
+func (bc *Client) AdminGraphQL(query string, variables map[string]interface{}, result interface{}) error {
+	reqJSON, err := bc.marshal(map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return err
+	}
+	req := bc.getAPIRequest(http.MethodPost, "/graphql", bytes.NewReader(reqJSON))
+	res, err := bc.do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil {
+		return err
+	}
+
+	var gqlResponse struct {
+		Data   json.RawMessage `json:"data"`
+		Errors GraphQLErrors   `json:"errors"`
+	}
+	err = bc.unmarshal(body, &gqlResponse)
+	if err != nil {
+		return err
+	}
+	if len(gqlResponse.Errors) > 0 {
+		return gqlResponse.Errors
+	}
+	if result == nil || len(gqlResponse.Data) == 0 {
+		return nil
+	}
+	return bc.codec().Unmarshal(gqlResponse.Data, result)
+}
+
+// GetProductTranslation returns the locale overrides of a product for a channel
+func (bc *Client) GetProductTranslation(productID, channelID int64, locale string) (*ProductTranslation, error) {
+	query := `query ($productId: ID!, $localeContext: LocaleContextInput!) {
+  store {
+    product(id: $productId) {
+      overridesForLocale(localeContext: $localeContext) {
+        basicInformation { name description }
+        seoInformation { pageTitle metaDescription }
+      }
+    }
+  }
+}`
+	var ret struct {
+		Store struct {
+			Product struct {
+				OverridesForLocale struct {
+					BasicInformation struct {
+						Name        string `json:"name"`
+						Description string `json:"description"`
+					} `json:"basicInformation"`
+					SeoInformation struct {
+						PageTitle       string `json:"pageTitle"`
+						MetaDescription string `json:"metaDescription"`
+					} `json:"seoInformation"`
+				} `json:"overridesForLocale"`
+			} `json:"product"`
+		} `json:"store"`
+	}
+	err := bc.AdminGraphQL(query, map[string]interface{}{
+		"productId":     productGID(productID),
+		"localeContext": localeContext{ChannelID: channelGID(channelID), Locale: locale},
+	}, &ret)
+	if err != nil {
+		return nil, err
+	}
+	o := ret.Store.Product.OverridesForLocale
+	return &ProductTranslation{
+		Name:            o.BasicInformation.Name,
+		Description:     o.BasicInformation.Description,
+		PageTitle:       o.SeoInformation.PageTitle,
+		MetaDescription: o.SeoInformation.MetaDescription,
+	}, nil
+}
+
+// UpsertProductTranslation sets the locale overrides of a product for a channel
+// only non-empty fields of translation are sent
+func (bc *Client) UpsertProductTranslation(productID, channelID int64, locale string, translation ProductTranslation) error {
+	lc := localeContext{ChannelID: channelGID(channelID), Locale: locale}
+	if translation.Name != "" || translation.Description != "" {
+		query := `mutation ($input: SetProductBasicInformationInput!) {
+  product {
+    setProductBasicInformation(input: $input) { product { id } }
+  }
+}`
+		err := bc.AdminGraphQL(query, map[string]interface{}{
+			"input": map[string]interface{}{
+				"productId":     productGID(productID),
+				"localeContext": lc,
+				"data": ProductTranslation{
+					Name:        translation.Name,
+					Description: translation.Description,
+				},
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+	if translation.PageTitle != "" || translation.MetaDescription != "" {
+		query := `mutation ($input: SetProductSeoInformationInput!) {
+  product {
+    setProductSeoInformation(input: $input) { product { id } }
+  }
+}`
+		err := bc.AdminGraphQL(query, map[string]interface{}{
+			"input": map[string]interface{}{
+				"productId":     productGID(productID),
+				"localeContext": lc,
+				"data": ProductTranslation{
+					PageTitle:       translation.PageTitle,
+					MetaDescription: translation.MetaDescription,
+				},
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// categoryTranslationFields are the translatable fields of a category by their translation field name
+var categoryTranslationFields = map[string]func(t *CategoryTranslation) *string{
+	"name":             func(t *CategoryTranslation) *string { return &t.Name },
+	"description":      func(t *CategoryTranslation) *string { return &t.Description },
+	"page_title":       func(t *CategoryTranslation) *string { return &t.PageTitle },
+	"meta_description": func(t *CategoryTranslation) *string { return &t.MetaDescription },
+}
+
+// GetCategoryTranslation returns the translated fields of a category for a channel locale,
+// fields without a translation are empty
+func (bc *Client) GetCategoryTranslation(categoryID, channelID int64, locale string) (*CategoryTranslation, error) {
+	query := `query ($channelId: ID!, $localeId: ID!, $resourceId: ID!) {
+  store {
+    translations(filters: {resourceType: CATEGORIES, channelId: $channelId, localeId: $localeId, resourceIds: [$resourceId]}) {
+      edges { node { resourceId fields { fieldName translation } } }
+    }
+  }
+}`
+	var ret struct {
+		Store struct {
+			Translations struct {
+				Edges []struct {
+					Node struct {
+						ResourceID string `json:"resourceId"`
+						Fields     []struct {
+							FieldName   string `json:"fieldName"`
+							Translation string `json:"translation"`
+						} `json:"fields"`
+					} `json:"node"`
+				} `json:"edges"`
+			} `json:"translations"`
+		} `json:"store"`
+	}
+	err := bc.AdminGraphQL(query, map[string]interface{}{
+		"channelId":  channelGID(channelID),
+		"localeId":   localeGID(locale),
+		"resourceId": categoryGID(categoryID),
+	}, &ret)
+	if err != nil {
+		return nil, err
+	}
+	translation := &CategoryTranslation{}
+	for _, edge := range ret.Store.Translations.Edges {
+		for _, f := range edge.Node.Fields {
+			if field, ok := categoryTranslationFields[f.FieldName]; ok {
+				*field(translation) = f.Translation
+			}
+		}
+	}
+	return translation, nil
+}
+
+// UpsertCategoryTranslation sets the translated fields of a category for a channel locale
+// only non-empty fields of translation are sent
+func (bc *Client) UpsertCategoryTranslation(categoryID, channelID int64, locale string, translation CategoryTranslation) error {
+	query := `mutation ($input: UpdateTranslationsInput!) {
+  translation {
+    updateTranslations(input: $input) { __typename }
+  }
+}`
+	fields := []map[string]string{}
+	for name, field := range categoryTranslationFields {
+		if value := *field(&translation); value != "" {
+			fields = append(fields, map[string]string{"fieldName": name, "value": value})
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return bc.AdminGraphQL(query, map[string]interface{}{
+		"input": map[string]interface{}{
+			"resourceType": "CATEGORIES",
+			"channelId":    channelGID(channelID),
+			"localeId":     localeGID(locale),
+			"entities": []map[string]interface{}{
+				{
+					"resourceId": categoryGID(categoryID),
+					"fields":     fields,
+				},
+			},
+		},
+	}, nil)
+}
+
+func productGID(productID int64) string {
+	return fmt.Sprintf("bc/store/product/%d", productID)
+}
+
+func categoryGID(categoryID int64) string {
+	return fmt.Sprintf("bc/store/category/%d", categoryID)
+}
+
+func channelGID(channelID int64) string {
+	return fmt.Sprintf("bc/store/channel/%d", channelID)
+}
+
+// localeGID returns the ID of a locale code like "fr", the translations API takes locale IDs
+func localeGID(locale string) string {
+	return "bc/store/locale/" + locale
+}
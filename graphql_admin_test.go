@@ -0,0 +1,54 @@
+package bigcommerce
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCategoryTranslation(t *testing.T) {
+	var variables map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+		}
+		variables = req.Variables
+		fmt.Fprint(w, `{"data": {"store": {"translations": {"edges": [{"node": {"resourceId": "bc/store/category/12",
+			"fields": [{"fieldName": "name", "translation": "Tasses"}, {"fieldName": "page_title", "translation": "Nos tasses"}]}}]}}}}`)
+	}))
+	defer srv.Close()
+	bc := newTestClient(srv)
+
+	translation, err := bc.GetCategoryTranslation(12, 2, "fr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *translation != (CategoryTranslation{Name: "Tasses", PageTitle: "Nos tasses"}) {
+		t.Errorf("got translation %+v", translation)
+	}
+	if variables["localeId"] != "bc/store/locale/fr" || variables["resourceId"] != "bc/store/category/12" || variables["channelId"] != "bc/store/channel/2" {
+		t.Errorf("got variables %v", variables)
+	}
+
+	err = bc.UpsertCategoryTranslation(12, 2, "fr", CategoryTranslation{Name: "Tasses"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	input, _ := variables["input"].(map[string]interface{})
+	if input["localeId"] != "bc/store/locale/fr" || input["resourceType"] != "CATEGORIES" {
+		t.Errorf("got input %v, want the locale ID of fr", input)
+	}
+	entities, _ := input["entities"].([]interface{})
+	if len(entities) != 1 {
+		t.Fatalf("got entities %v", input["entities"])
+	}
+	fields := entities[0].(map[string]interface{})["fields"].([]interface{})
+	if len(fields) != 1 || fields[0].(map[string]interface{})["value"] != "Tasses" {
+		t.Errorf("got fields %v, want only the name", fields)
+	}
+}
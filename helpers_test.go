@@ -0,0 +1,113 @@
+package bigcommerce
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// newTestClient returns a client for srv that doesn't retry
+func newTestClient(srv *httptest.Server, opts ...Option) *Client {
+	return NewClient("store", "token", append([]Option{WithBaseURL(srv.URL), WithRetryPolicy(nil)}, opts...)...)
+}
+
+// failingServer answers the first fail requests with status, or drops their connection when status is 0,
+// and the ones after with an empty JSON object
+func failingServer(t *testing.T, fail int32, status int, header http.Header) (*httptest.Server, *int32) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) > fail {
+			fmt.Fprint(w, `{}`)
+			return
+		}
+		if status == 0 {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			conn.Close()
+			return
+		}
+		for k, v := range header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(status)
+		fmt.Fprint(w, `{"status": 0, "title": "failed"}`)
+	}))
+	return srv, &requests
+}
+
+// brandsServer serves n brands from /v3/catalog/brands in pages like BigCommerce, failing page failPage
+// with a 500 when it is set
+func brandsServer(t *testing.T, n, failPage int, limits *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		*limits = append(*limits, q.Get("limit"))
+		page, _ := strconv.Atoi(q.Get("page"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		if page < 1 || limit < 1 {
+			t.Errorf("got page %q and limit %q", q.Get("page"), q.Get("limit"))
+			return
+		}
+		if page == failPage {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"status": 500, "title": "Internal error"}`))
+			return
+		}
+		if n == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		brands := []Brand{}
+		for id := (page-1)*limit + 1; id <= page*limit && id <= n; id++ {
+			brands = append(brands, Brand{ID: int64(id)})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": brands,
+			"meta": map[string]interface{}{"pagination": Pagination{
+				Total:       n,
+				Count:       len(brands),
+				PerPage:     limit,
+				CurrentPage: page,
+				TotalPages:  (n + limit - 1) / limit,
+			}},
+		})
+	}))
+}
+
+// batchServer records the sizes of the JSON arrays PUT to path, answering batch failBatch (1 based) with a 422
+func batchServer(t *testing.T, path string, failBatch int) (*httptest.Server, func() []int) {
+	var mu sync.Mutex
+	sizes := []int{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != path {
+			t.Errorf("got request %s %s", r.Method, r.URL.Path)
+			return
+		}
+		var batch []map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+			t.Error(err)
+		}
+		mu.Lock()
+		sizes = append(sizes, len(batch))
+		n := len(sizes)
+		mu.Unlock()
+		if n == failBatch {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"status": 422, "title": "Invalid batch"}`))
+			return
+		}
+		w.Write([]byte(`{"data": []}`))
+	}))
+	return srv, func() []int {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]int(nil), sizes...)
+	}
+}
@@ -0,0 +1,117 @@
+package bigcommerce
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// IdempotencyStore remembers what was created for idempotency keys, set Client.IdempotencyStore to have
+// CreateOrderShipmentIdempotent return the shipment created earlier for a key instead of creating another.
+// Use a shared store, e.g. backed by a database, when retries can happen in another process
+type IdempotencyStore interface {
+	// Get returns the ID created for key, false if there is none
+	Get(key string) (int64, bool)
+	// Put records the ID created for key
+	Put(key string, id int64) error
+}
+
+// MemoryIdempotencyStore is an IdempotencyStore for a single process
+type MemoryIdempotencyStore struct {
+	mu  sync.Mutex
+	ids map[string]int64
+}
+
+// NewMemoryIdempotencyStore returns an empty MemoryIdempotencyStore
+func NewMemoryIdempotencyStore() *MemoryIdempotencyStore {
+	return &MemoryIdempotencyStore{ids: map[string]int64{}}
+}
+
+// Get returns the ID created for key
+func (s *MemoryIdempotencyStore) Get(key string) (int64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id, ok := s.ids[key]
+	return id, ok
+}
+
+// Put records the ID created for key
+func (s *MemoryIdempotencyStore) Put(key string, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ids[key] = id
+	return nil
+}
+
+// NewIdempotencyKey returns a random key, generate it once per operation and pass the same key to its retries
+func NewIdempotencyKey() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// CreateOrderShipmentIdempotent creates a shipment unless it was created before, so it is safe to retry after
+// a network error: with a key and Client.IdempotencyStore set the shipment created for the key is returned,
+// and otherwise an existing shipment of the order with the same tracking number is. A failed POST that may
+// have reached BigCommerce is checked the same way before its error is returned.
+// Shipments without tracking number are only deduplicated by key
+func (bc *Client) CreateOrderShipmentIdempotent(orderID int64, shipment Shipment, key string) (*Shipment, error) {
+	storeKey := ""
+	if key != "" && bc.IdempotencyStore != nil {
+		storeKey = "shipment:" + strconv.FormatInt(orderID, 10) + ":" + key
+		if id, ok := bc.IdempotencyStore.Get(storeKey); ok {
+			existing, err := bc.GetOrderShipment(orderID, id)
+			if err == nil || !errors.Is(err, ErrNotFound) {
+				return existing, err
+			}
+			// deleted since, create it again
+		}
+	}
+	existing, err := bc.findShipmentByTracking(orderID, shipment.TrackingNumber)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		existing, err = bc.CreateOrderShipment(orderID, shipment)
+		if err != nil {
+			var apiErr *APIError
+			if errors.As(err, &apiErr) && apiErr.StatusCode < 500 {
+				return nil, err
+			}
+			// the request may have been processed before the connection dropped or the server failed
+			found, ferr := bc.findShipmentByTracking(orderID, shipment.TrackingNumber)
+			if ferr != nil || found == nil {
+				return nil, err
+			}
+			existing = found
+		}
+	}
+	if storeKey != "" && existing.ID != 0 {
+		err = bc.IdempotencyStore.Put(storeKey, existing.ID)
+		if err != nil {
+			return existing, err
+		}
+	}
+	return existing, nil
+}
+
+// findShipmentByTracking returns the shipment of an order with a tracking number, nil when there is none
+func (bc *Client) findShipmentByTracking(orderID int64, trackingNumber string) (*Shipment, error) {
+	trackingNumber = strings.TrimSpace(trackingNumber)
+	if trackingNumber == "" {
+		return nil, nil
+	}
+	shipments, err := bc.GetAllOrderShipments(orderID)
+	if err != nil && !errors.Is(err, ErrNoContent) {
+		return nil, err
+	}
+	for i := range shipments {
+		if strings.EqualFold(strings.TrimSpace(shipments[i].TrackingNumber), trackingNumber) {
+			return &shipments[i], nil
+		}
+	}
+	return nil, nil
+}
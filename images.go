@@ -1,9 +1,13 @@
 package bigcommerce
 
 import (
-	"encoding/json"
+	"bytes"
+	"fmt"
+	"io"
 	"log"
+	"mime/multipart"
 	"net/http"
+	"regexp"
 	"strconv"
 )
 
@@ -28,7 +32,7 @@ func (bc *Client) GetMainThumbnailURL(productID int64) (string, error) {
 	url := "/v3/catalog/products/" + strconv.FormatInt(productID, 10) + "/images"
 
 	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return "", err
 	}
@@ -45,7 +49,7 @@ func (bc *Client) GetMainThumbnailURL(productID int64) (string, error) {
 			Pagination Pagination `json:"pagination"`
 		} `json:"meta"`
 	}
-	err = json.Unmarshal(body, &pp)
+	err = bc.unmarshal(body, &pp)
 	if err != nil {
 		log.Println(err)
 		return "", err
@@ -57,3 +61,116 @@ func (bc *Client) GetMainThumbnailURL(productID int64) (string, error) {
 	}
 	return "", ErrNoMainThumbnail
 }
+
+// Named image sizes as returned on product images
+const (
+	ImageSizeTiny      = "tiny"
+	ImageSizeThumbnail = "thumbnail"
+	ImageSizeStandard  = "standard"
+	ImageSizeZoom      = "zoom"
+	ImageSizeOriginal  = "original"
+)
+
+// stencil CDN URLs: .../images/stencil/{W}x{H}|original/products/{product}/{image}/{file}
+var stencilSizeRe = regexp.MustCompile(`/images/stencil/(original|\d+x\d+|\d+w)/`)
+
+// legacy CDN URLs: .../products/{product}/images/{image}/{name}.{timestamp}.{W}.{H}.{ext}
+var legacySizeRe = regexp.MustCompile(`^(.*/products/\d+/images/\d+/[^/?]+\.\d+)\.\d+\.\d+(\.[A-Za-z0-9]+)(\?.*)?$`)
+
+// URL returns the image URL for one of the ImageSize names, the zoom URL for ImageSizeOriginal is resized to "original"
+func (i Image) URL(size string) string {
+	switch size {
+	case ImageSizeTiny:
+		return i.URLTiny
+	case ImageSizeThumbnail:
+		return i.URLThumbnail
+	case ImageSizeStandard:
+		return i.URLStandard
+	case ImageSizeZoom:
+		return i.URLZoom
+	case ImageSizeOriginal:
+		return ImageURLOriginal(i.URLZoom)
+	}
+	return i.URLStandard
+}
+
+// SizedURL returns the image URL resized to fit width x height on the BigCommerce CDN
+func (i Image) SizedURL(width, height int) string {
+	return ImageURLWithSize(i.URLZoom, width, height)
+}
+
+// ImageURLWithSize rewrites a BigCommerce CDN image URL to a width x height variant,
+// URLs it doesn't recognize are returned unchanged
+func ImageURLWithSize(imageURL string, width, height int) string {
+	size := strconv.Itoa(width) + "x" + strconv.Itoa(height)
+	if stencilSizeRe.MatchString(imageURL) {
+		return stencilSizeRe.ReplaceAllString(imageURL, "/images/stencil/"+size+"/")
+	}
+	if m := legacySizeRe.FindStringSubmatch(imageURL); m != nil {
+		return m[1] + "." + strconv.Itoa(width) + "." + strconv.Itoa(height) + m[2] + m[3]
+	}
+	return imageURL
+}
+
+// ImageURLOriginal rewrites a BigCommerce stencil CDN image URL to the original (unresized) image,
+// URLs it doesn't recognize are returned unchanged
+func ImageURLOriginal(imageURL string) string {
+	if stencilSizeRe.MatchString(imageURL) {
+		return stencilSizeRe.ReplaceAllString(imageURL, "/images/stencil/original/")
+	}
+	return imageURL
+}
+
+// SetVariantImage creates an image from imageURL and assigns it to the variant, returning the variant's new image URL
+func (bc *Client) SetVariantImage(productID, variantID int64, imageURL string) (string, error) {
+	reqJSON, err := bc.marshal(map[string]string{"image_url": imageURL})
+	if err != nil {
+		return "", err
+	}
+	return bc.postVariantImage(productID, variantID, bytes.NewReader(reqJSON), "application/json")
+}
+
+// SetVariantImageFile uploads an image file and assigns it to the variant, returning the variant's new image URL
+// fileName: name of the uploaded file, its extension should match the image type (jpg, png, gif)
+func (bc *Client) SetVariantImageFile(productID, variantID int64, fileName string, file io.Reader) (string, error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	part, err := w.CreateFormFile("image_file", fileName)
+	if err != nil {
+		return "", err
+	}
+	_, err = io.Copy(part, file)
+	if err != nil {
+		return "", err
+	}
+	err = w.Close()
+	if err != nil {
+		return "", err
+	}
+	return bc.postVariantImage(productID, variantID, &buf, w.FormDataContentType())
+}
+
+func (bc *Client) postVariantImage(productID, variantID int64, reqBody io.Reader, contentType string) (string, error) {
+	url := newURL("/v3/catalog/products").ID(productID).Segment("variants").ID(variantID).Segment("image").String()
+	req := bc.getAPIRequest(http.MethodPost, url, reqBody)
+	req.Header.Set("Content-Type", contentType)
+	res, err := bc.do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil {
+		return "", fmt.Errorf("error setting image of variant %d: %w %s", variantID, err, string(body))
+	}
+	var imageResponse struct {
+		Data struct {
+			ImageURL string `json:"image_url"`
+		} `json:"data"`
+	}
+	err = bc.unmarshal(body, &imageResponse)
+	if err != nil {
+		return "", err
+	}
+	return imageResponse.Data.ImageURL, nil
+}
@@ -1,35 +1,45 @@
 package bigcommerce
 
-import (
-	"net/url"
+//go:generate go run ./internal/clientgen
+
+// The per resource interfaces are subsets of the generated ClientInterface, for services using a few methods
+var (
+	_ StoreClient     = (*Client)(nil)
+	_ CatalogClient   = (*Client)(nil)
+	_ BlogClient      = (*Client)(nil)
+	_ CartClient      = (*Client)(nil)
+	_ CustomerClient  = (*Client)(nil)
+	_ AddressClient   = (*Client)(nil)
+	_ OrderClient     = (*Client)(nil)
+	_ ShipmentClient  = (*Client)(nil)
+	_ InventoryClient = (*Client)(nil)
+	_ CheckoutClient  = (*Client)(nil)
+	_ WebhookClient   = (*Client)(nil)
+	_ MetafieldClient = (*Client)(nil)
 )
 
 // StoreClient interface handles generic store requests
 type StoreClient interface {
 	GetAllChannels() ([]Channel, error)
 	GetChannels(page int) ([]Channel, bool, error)
-	GetClientRequest(requestURLQuery url.Values) (*ClientRequest, error)
 	GetStoreInfo() (StoreInfo, error)
 }
 
 // CatalogClient interface handles catalog-related requests
 type CatalogClient interface {
-	GetAllBrands() ([]Brand, error)
-	GetBrands(page int) ([]Brand, bool, error)
-	GetAllCategories() ([]Category, error)
-	GetCategories(page int) ([]Category, bool, error)
-	GetClientRequest(requestURLQuery url.Values) (*ClientRequest, error)
+	GetAllBrands(args map[string]string) ([]Brand, error)
+	GetBrands(args map[string]string, page int) ([]Brand, bool, error)
+	GetAllCategories(args map[string]string) ([]Category, error)
+	GetCategories(args map[string]string, page int) ([]Category, bool, error)
 	GetMainThumbnailURL(productID int64) (string, error)
-	SetProductFields(fields []string)
-	SetProductInclude(subresources []string)
-	GetAllProducts(map[string]string) ([]Product, error)
-	GetProducts(page int) ([]Product, bool, error)
+	GetAllProducts(args map[string]string) ([]Product, error)
+	GetProducts(args map[string]string, page int) ([]Product, bool, error)
 	GetProductByID(productID int64) (*Product, error)
 }
 
 // BlogClient interface handles blog-related requests
 type BlogClient interface {
-	GetAllPosts(context, xAuthToken string) ([]Post, error)
+	GetAllPosts() ([]Post, error)
 	GetPosts(page int) ([]Post, bool, error)
 }
 
@@ -60,3 +70,64 @@ type AddressClient interface {
 	DeleteAddress(customerID int64, addressID int64) error
 	GetAddresses(customerID int64) ([]Address, error)
 }
+
+// OrderClient interface handles order requests
+type OrderClient interface {
+	GetOrders(filters map[string]string) ([]Order, error)
+	GetOrder(orderID int64) (*Order, error)
+	UpdateOrder(orderID int64, order *UpdateOrder) error
+	GetOrderProducts(orderID int64) ([]OrderProduct, error)
+	GetOrderShippingAddresses(orderID int64) ([]OrderShippingAddress, error)
+	GetOrderCoupons(orderID int64) ([]OrderCoupon, error)
+	GetOrderMessages(orderID int64) ([]OrderMessage, error)
+}
+
+// ShipmentClient interface handles order shipment requests
+type ShipmentClient interface {
+	GetOrderShipments(orderID int64, filters map[string]string) ([]Shipment, error)
+	GetAllOrderShipments(orderID int64) ([]Shipment, error)
+	GetOrderShipment(orderID, shipmentID int64) (*Shipment, error)
+	CreateOrderShipment(orderID int64, shipment Shipment) (*Shipment, error)
+	UpdateOrderShipment(orderID int64, shipment Shipment) (*Shipment, error)
+	DeleteOrderShipment(orderID, shipmentID int64) (bool, error)
+	DeleteOrderShipments(orderID int64) (bool, error)
+}
+
+// InventoryClient interface handles inventory requests
+type InventoryClient interface {
+	GetInventoryForLocation(locationID int64, filters map[string]string) (*InventoryResource, error)
+	AdjustInventoryRelative(adjustment *Adjustment) error
+	AdjustInventoryAbsolute(adjustment *Adjustment) error
+}
+
+// CheckoutClient interface handles checkout requests
+type CheckoutClient interface {
+	GetCheckout(checkoutID string) (*Checkout, error)
+	SetCheckoutBillingAddress(checkoutID string, address CheckoutAddress) (*Checkout, error)
+	AddCheckoutConsignments(checkoutID string, consignments []ConsignmentRequest) (*Checkout, error)
+	GetConsignmentShippingOptions(checkoutID, consignmentID string) ([]ShippingOption, error)
+	UpdateConsignmentShippingOption(checkoutID, consignmentID, shippingOptionID string) (*Checkout, error)
+	CreateCheckoutOrder(checkoutID string) (int64, error)
+	ApplyCouponToCheckout(checkoutID, couponCode string) (*Checkout, error)
+	RemoveCoupon(checkoutID, couponCode string) (*Checkout, error)
+	ApplyGiftCertificateToCheckout(checkoutID, giftCertificateCode string) (*Checkout, error)
+	RemoveGiftCertificateFromCheckout(checkoutID, giftCertificateCode string) (*Checkout, error)
+}
+
+// WebhookClient interface handles webhook requests
+type WebhookClient interface {
+	GetWebhooks() ([]Webhook, error)
+	CreateWebhook(scope, destination string, headers map[string]string) (int64, error)
+}
+
+// MetafieldClient interface handles order and product metafield requests
+type MetafieldClient interface {
+	GetOrderMetafields(orderID int64, namespace string) ([]Metafield, error)
+	CreateOrderMetafield(orderID int64, metafield Metafield) (*Metafield, error)
+	UpdateOrderMetafield(orderID int64, metafield Metafield) (*Metafield, error)
+	DeleteOrderMetafield(orderID, metafieldID int64) error
+	GetProductMetafieldsInNamespace(productID int64, namespace string) ([]Metafield, error)
+	CreateProductMetafield(productID int64, metafield Metafield) (*Metafield, error)
+	UpdateProductMetafield(productID int64, metafield Metafield) (*Metafield, error)
+	DeleteProductMetafield(productID, metafieldID int64) error
+}
@@ -0,0 +1,372 @@
+// Command clientgen generates bigcommerce.ClientInterface, with every exported method of *Client,
+// and the mocks.Client stub implementing it. Run it from the repository root with go generate
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	interfaceFile = "client_interface.go"
+	mockFile      = "mocks/client.go"
+	modulePath    = "github.com/ewarehousing-solutions/bigcommerce-api-go"
+	header        = "// Code generated by clientgen; DO NOT EDIT.\n\n"
+)
+
+func main() {
+	root := flag.String("root", ".", "root directory of the bigcommerce package")
+	flag.Parse()
+	files, err := Generate(*root)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for name, src := range files {
+		err = os.WriteFile(filepath.Join(*root, name), src, 0644)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// method is an exported method of *Client
+type method struct {
+	name    string
+	file    string
+	typ     *ast.FuncType
+	imports map[string]string // package name to import path, of the method's file
+}
+
+// Generate returns the generated files by path relative to root
+func Generate(root string) (map[string][]byte, error) {
+	methods, fset, err := clientMethods(root)
+	if err != nil {
+		return nil, err
+	}
+	iface, err := generateInterface(fset, methods)
+	if err != nil {
+		return nil, err
+	}
+	mock, err := generateMock(fset, methods)
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]byte{interfaceFile: iface, mockFile: mock}, nil
+}
+
+// clientMethods parses the package in root and returns the exported methods of *Client in file order
+func clientMethods(root string) ([]method, *token.FileSet, error) {
+	paths, err := filepath.Glob(filepath.Join(root, "*.go"))
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Strings(paths)
+	fset := token.NewFileSet()
+	methods := []method{}
+	for _, path := range paths {
+		name := filepath.Base(path)
+		if strings.HasSuffix(name, "_test.go") || name == interfaceFile {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, nil, err
+		}
+		imports := map[string]string{}
+		for _, imp := range f.Imports {
+			p, _ := strconv.Unquote(imp.Path.Value)
+			n := p[strings.LastIndex(p, "/")+1:]
+			if imp.Name != nil {
+				n = imp.Name.Name
+			}
+			imports[n] = p
+		}
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || !fn.Name.IsExported() || !isClientReceiver(fn.Recv) {
+				continue
+			}
+			if err := checkExported(fn.Type); err != nil {
+				return nil, nil, fmt.Errorf("%s: Client.%s: %w", name, fn.Name.Name, err)
+			}
+			methods = append(methods, method{name: fn.Name.Name, file: name, typ: fn.Type, imports: imports})
+		}
+	}
+	return methods, fset, nil
+}
+
+func isClientReceiver(recv *ast.FieldList) bool {
+	if len(recv.List) != 1 {
+		return false
+	}
+	star, ok := recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	id, ok := star.X.(*ast.Ident)
+	return ok && id.Name == "Client"
+}
+
+// checkExported returns an error if the signature uses unexported types, the mocks couldn't name them
+func checkExported(typ *ast.FuncType) error {
+	var err error
+	ast.Inspect(typ, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			return false
+		case *ast.Field:
+			ast.Inspect(n.Type, func(n ast.Node) bool {
+				switch n := n.(type) {
+				case *ast.SelectorExpr:
+					return false
+				case *ast.Ident:
+					if !n.IsExported() && types.Universe.Lookup(n.Name) == nil && err == nil {
+						err = fmt.Errorf("unexported type %s in signature", n.Name)
+					}
+				}
+				return true
+			})
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+func generateInterface(fset *token.FileSet, methods []method) ([]byte, error) {
+	imports := map[string]bool{}
+	for _, m := range methods {
+		qualify(m.typ, m.imports, imports)
+	}
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package bigcommerce\n\n")
+	writeImports(&b, imports)
+	b.WriteString("// ClientInterface has every exported method of Client, so services can be tested with the stub in the mocks\n")
+	b.WriteString("// package, or any other implementation, instead of a *Client. Depend on a per resource interface like\n")
+	b.WriteString("// OrderClient where a few methods will do\n")
+	b.WriteString("type ClientInterface interface {\n")
+	file := ""
+	for _, m := range methods {
+		if m.file != file {
+			if file != "" {
+				b.WriteString("\n")
+			}
+			file = m.file
+			fmt.Fprintf(&b, "// %s\n", file)
+		}
+		b.WriteString(m.name)
+		b.WriteString(strings.TrimPrefix(nodeString(fset, m.typ), "func"))
+		b.WriteString("\n")
+	}
+	b.WriteString("}\n\nvar _ ClientInterface = (*Client)(nil)\n")
+	return format.Source(b.Bytes())
+}
+
+func generateMock(fset *token.FileSet, methods []method) ([]byte, error) {
+	imports := map[string]bool{modulePath: true}
+	type mockMethod struct {
+		name, params, results, funcType, args string
+	}
+	mms := make([]mockMethod, 0, len(methods))
+	for _, m := range methods {
+		typ := qualify(m.typ, m.imports, imports).(*ast.FuncType)
+		names := nameParams(typ)
+		mm := mockMethod{name: m.name, funcType: nodeString(fset, typ)}
+		params := []string{}
+		for i, f := range typ.Params.List {
+			params = append(params, strings.Join(names[i], ", ")+" "+nodeString(fset, f.Type))
+			args := strings.Join(names[i], ", ")
+			if _, ok := f.Type.(*ast.Ellipsis); ok {
+				args += "..."
+			}
+			if mm.args != "" {
+				mm.args += ", "
+			}
+			mm.args += args
+		}
+		mm.params = strings.Join(params, ", ")
+		if typ.Results != nil {
+			results := []string{}
+			for _, f := range typ.Results.List {
+				r := nodeString(fset, f.Type)
+				if len(f.Names) > 0 {
+					names := make([]string, len(f.Names))
+					for i, n := range f.Names {
+						names[i] = n.Name
+					}
+					r = strings.Join(names, ", ") + " " + r
+				}
+				results = append(results, r)
+			}
+			mm.results = strings.Join(results, ", ")
+			if len(typ.Results.List) > 1 || len(typ.Results.List[0].Names) > 0 {
+				mm.results = "(" + mm.results + ")"
+			}
+		}
+		mms = append(mms, mm)
+	}
+
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package mocks\n\n")
+	writeImports(&b, imports)
+	b.WriteString(`// Client stubs bigcommerce.ClientInterface: set the Func field of every method the code under test calls,
+// calling a method without one panics so missing stubs show up in tests:
+//
+//	client := &mocks.Client{
+//		GetOrderFunc: func(orderID int64) (*bigcommerce.Order, error) {
+//			return &bigcommerce.Order{ID: orderID}, nil
+//		},
+//	}
+type Client struct {
+`)
+	for _, mm := range mms {
+		fmt.Fprintf(&b, "\t%sFunc %s\n", mm.name, mm.funcType)
+	}
+	b.WriteString("}\n\nvar _ bigcommerce.ClientInterface = (*Client)(nil)\n")
+	for _, mm := range mms {
+		fmt.Fprintf(&b, "\nfunc (c *Client) %s(%s) %s {\n", mm.name, mm.params, mm.results)
+		fmt.Fprintf(&b, "\tif c.%sFunc == nil {\n\t\tpanic(\"mocks.Client.%sFunc is not set\")\n\t}\n", mm.name, mm.name)
+		if mm.results != "" {
+			b.WriteString("\treturn ")
+		} else {
+			b.WriteString("\t")
+		}
+		fmt.Fprintf(&b, "c.%sFunc(%s)\n}\n", mm.name, mm.args)
+	}
+	return format.Source(b.Bytes())
+}
+
+// writeImports writes the import declaration of the import paths
+func writeImports(b *bytes.Buffer, imports map[string]bool) {
+	if len(imports) == 0 {
+		return
+	}
+	paths := make([]string, 0, len(imports))
+	for p := range imports {
+		paths = append(paths, p)
+	}
+	// standard library first, like goimports
+	sort.Slice(paths, func(i, j int) bool {
+		si, sj := !strings.Contains(paths[i], "."), !strings.Contains(paths[j], ".")
+		if si != sj {
+			return si
+		}
+		return paths[i] < paths[j]
+	})
+	b.WriteString("import (\n")
+	for i, p := range paths {
+		if i > 0 && strings.Contains(p, ".") && !strings.Contains(paths[i-1], ".") {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(b, "\t%q\n", p)
+	}
+	b.WriteString(")\n\n")
+}
+
+// nameParams returns the names of the parameters per field, naming unnamed ones and ones clashing with the receiver
+func nameParams(typ *ast.FuncType) [][]string {
+	names := make([][]string, len(typ.Params.List))
+	n := 0
+	for i, f := range typ.Params.List {
+		if len(f.Names) == 0 {
+			names[i] = []string{"p" + strconv.Itoa(n)}
+			n++
+			continue
+		}
+		for _, id := range f.Names {
+			name := id.Name
+			if name == "_" || name == "c" {
+				name = "p" + strconv.Itoa(n)
+			}
+			names[i] = append(names[i], name)
+			n++
+		}
+	}
+	return names
+}
+
+// qualify returns a copy of a type expression of the bigcommerce package as seen from another package,
+// adding the imports it needs to used
+func qualify(expr ast.Expr, fileImports map[string]string, used map[string]bool) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if e.IsExported() {
+			return &ast.SelectorExpr{X: ast.NewIdent("bigcommerce"), Sel: ast.NewIdent(e.Name)}
+		}
+		return ast.NewIdent(e.Name)
+	case *ast.SelectorExpr:
+		if pkg, ok := e.X.(*ast.Ident); ok {
+			if p, ok := fileImports[pkg.Name]; ok {
+				used[p] = true
+			}
+		}
+		return &ast.SelectorExpr{X: ast.NewIdent(e.X.(*ast.Ident).Name), Sel: ast.NewIdent(e.Sel.Name)}
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: qualify(e.X, fileImports, used)}
+	case *ast.ArrayType:
+		var l ast.Expr
+		if e.Len != nil {
+			l = qualify(e.Len, fileImports, used)
+		}
+		return &ast.ArrayType{Len: l, Elt: qualify(e.Elt, fileImports, used)}
+	case *ast.MapType:
+		return &ast.MapType{Key: qualify(e.Key, fileImports, used), Value: qualify(e.Value, fileImports, used)}
+	case *ast.ChanType:
+		return &ast.ChanType{Dir: e.Dir, Value: qualify(e.Value, fileImports, used)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: qualify(e.Elt, fileImports, used)}
+	case *ast.IndexExpr:
+		return &ast.IndexExpr{X: qualify(e.X, fileImports, used), Index: qualify(e.Index, fileImports, used)}
+	case *ast.IndexListExpr:
+		indices := make([]ast.Expr, len(e.Indices))
+		for i, x := range e.Indices {
+			indices[i] = qualify(x, fileImports, used)
+		}
+		return &ast.IndexListExpr{X: qualify(e.X, fileImports, used), Indices: indices}
+	case *ast.FuncType:
+		return &ast.FuncType{Params: qualifyFields(e.Params, fileImports, used), Results: qualifyFields(e.Results, fileImports, used)}
+	case *ast.InterfaceType:
+		return &ast.InterfaceType{Methods: qualifyFields(e.Methods, fileImports, used)}
+	case *ast.StructType:
+		return &ast.StructType{Fields: qualifyFields(e.Fields, fileImports, used)}
+	case *ast.BasicLit:
+		return &ast.BasicLit{Kind: e.Kind, Value: e.Value}
+	}
+	panic(fmt.Sprintf("clientgen: unsupported type expression %T", expr))
+}
+
+func qualifyFields(fl *ast.FieldList, fileImports map[string]string, used map[string]bool) *ast.FieldList {
+	if fl == nil {
+		return nil
+	}
+	ret := &ast.FieldList{List: make([]*ast.Field, len(fl.List))}
+	for i, f := range fl.List {
+		names := make([]*ast.Ident, len(f.Names))
+		for j, n := range f.Names {
+			names[j] = ast.NewIdent(n.Name)
+		}
+		ret.List[i] = &ast.Field{Names: names, Type: qualify(f.Type, fileImports, used), Tag: f.Tag}
+	}
+	return ret
+}
+
+func nodeString(fset *token.FileSet, n ast.Node) string {
+	var b bytes.Buffer
+	printer.Fprint(&b, fset, n)
+	return b.String()
+}
@@ -1,10 +1,7 @@
 package bigcommerce
 
 import (
-	"encoding/json"
-	"fmt"
 	"net/http"
-	"strings"
 )
 
 type InventoryResource struct {
@@ -14,8 +11,8 @@ type InventoryResource struct {
 
 type Identity struct {
 	Sku       string `json:"sku"`
-	VariantID int    `json:"variant_id"`
-	ProductID int    `json:"product_id"`
+	VariantID int64  `json:"variant_id"`
+	ProductID int64  `json:"product_id"`
 }
 type Settings struct {
 	SafetyStock      int    `json:"safety_stock"`
@@ -41,15 +38,12 @@ type Meta struct {
 }
 
 func (bc *Client) GetInventoryForLocation(ID int64, filters map[string]string) (*InventoryResource, error) {
-	var params []string
-	for k, v := range filters {
-		params = append(params, fmt.Sprintf("%s=%s", k, v))
-	}
-
-	url := fmt.Sprintf("/v3/inventory/locations/%d/items", ID) + strings.Join(params, "&")
+	return bc.getInventoryForLocation(newURL("/v3/inventory/locations").ID(ID).Segment("items").Args(filters).String())
+}
 
+func (bc *Client) getInventoryForLocation(url string) (*InventoryResource, error) {
 	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -64,7 +58,7 @@ func (bc *Client) GetInventoryForLocation(ID int64, filters map[string]string) (
 	}
 
 	var resource InventoryResource
-	err = json.Unmarshal(body, &resource)
+	err = bc.unmarshal(body, &resource)
 
 	if err != nil {
 		return nil, err
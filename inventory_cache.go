@@ -0,0 +1,185 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// inventoryRefreshBatchSize is how many variants Refresh asks for per request
+const inventoryRefreshBatchSize = 50
+
+// InventoryStore holds the available to sell quantity per location and SKU for an InventoryCache
+type InventoryStore interface {
+	// Get returns the quantity of sku at a location, false when it isn't known
+	Get(locationID int64, sku string) (int, bool)
+	// Set stores the quantity of sku at a location
+	Set(locationID int64, sku string, available int) error
+}
+
+// MemoryInventoryStore is an InventoryStore in memory
+type MemoryInventoryStore struct {
+	mu        sync.RWMutex
+	available map[int64]map[string]int
+}
+
+// NewMemoryInventoryStore returns an empty MemoryInventoryStore
+func NewMemoryInventoryStore() *MemoryInventoryStore {
+	return &MemoryInventoryStore{available: map[int64]map[string]int{}}
+}
+
+// Get returns the quantity of sku at a location
+func (s *MemoryInventoryStore) Get(locationID int64, sku string) (int, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	n, ok := s.available[locationID][sku]
+	return n, ok
+}
+
+// Set stores the quantity of sku at a location
+func (s *MemoryInventoryStore) Set(locationID int64, sku string, available int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.available[locationID] == nil {
+		s.available[locationID] = map[string]int{}
+	}
+	s.available[locationID][sku] = available
+	return nil
+}
+
+// InventoryCache keeps the available to sell stock of locations in a store for instant reads, e.g. for
+// availability checks on every page view. Load it once, then keep it current with inventory webhooks,
+// polling with Run, or both:
+//
+//	cache := bigcommerce.NewInventoryCache(bc, 1, 2)
+//	err := cache.Load()
+//	go cache.Run(ctx, 15*time.Minute)
+//	webhooks.OnSkuInventoryUpdated(func(ctx context.Context, e *webhooks.Envelope, sku *webhooks.SkuData) error {
+//		return cache.Refresh(sku.Sku.VariantID)
+//	})
+//	n, ok := cache.Available(1, "SKU-1")
+type InventoryCache struct {
+	Client    *Client
+	Store     InventoryStore
+	Locations []int64
+
+	mu     sync.RWMutex
+	loaded time.Time
+}
+
+// NewInventoryCache returns a cache of the locations in memory, call Load to fill it
+func NewInventoryCache(client *Client, locationIDs ...int64) *InventoryCache {
+	return &InventoryCache{
+		Client:    client,
+		Store:     NewMemoryInventoryStore(),
+		Locations: locationIDs,
+	}
+}
+
+// Available returns the available to sell quantity of sku at a location, false when the cache doesn't know it
+func (c *InventoryCache) Available(locationID int64, sku string) (int, bool) {
+	return c.Store.Get(locationID, sku)
+}
+
+// AvailableAnywhere returns the available to sell quantity of sku summed over the cached locations
+func (c *InventoryCache) AvailableAnywhere(sku string) int {
+	total := 0
+	for _, l := range c.Locations {
+		if n, ok := c.Store.Get(l, sku); ok && n > 0 {
+			total += n
+		}
+	}
+	return total
+}
+
+// LoadedAt returns when the cache was last loaded completely, zero before Load
+func (c *InventoryCache) LoadedAt() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.loaded
+}
+
+// Load reads the stock of all items at the cached locations
+func (c *InventoryCache) Load() error {
+	start := time.Now()
+	for _, l := range c.Locations {
+		err := c.load(l, nil)
+		if err != nil {
+			return err
+		}
+	}
+	c.mu.Lock()
+	c.loaded = start
+	c.mu.Unlock()
+	return nil
+}
+
+// Refresh reads the stock of variants at the cached locations again, e.g. when a webhook reported a change
+func (c *InventoryCache) Refresh(variantIDs ...int64) error {
+	for start := 0; start < len(variantIDs); start += inventoryRefreshBatchSize {
+		end := start + inventoryRefreshBatchSize
+		if end > len(variantIDs) {
+			end = len(variantIDs)
+		}
+		filters := map[string]string{"variant_id:in": joinIDs(variantIDs[start:end])}
+		for _, l := range c.Locations {
+			err := c.load(l, filters)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// HandleWebhook refreshes the stock changed by a store/sku/inventory/* or store/product/inventory/* webhook,
+// other webhooks are ignored
+func (c *InventoryCache) HandleWebhook(payload *WebhookPayload) error {
+	switch {
+	case payload.Data.Sku.VariantID != 0:
+		return c.Refresh(payload.Data.Sku.VariantID)
+	case payload.Data.Inventory.VariantID != 0:
+		return c.Refresh(payload.Data.Inventory.VariantID)
+	case payload.Data.Inventory.ProductID != 0:
+		filters := map[string]string{"product_id:in": joinIDs([]int64{payload.Data.Inventory.ProductID})}
+		var errs []error
+		for _, l := range c.Locations {
+			errs = append(errs, c.load(l, filters))
+		}
+		return errors.Join(errs...)
+	}
+	return nil
+}
+
+// Run reloads the cache every interval until ctx is done, failed loads are logged and retried at the next tick
+func (c *InventoryCache) Run(ctx context.Context, interval time.Duration) error {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+			err := c.Load()
+			if err != nil {
+				c.Client.logf("error reloading inventory cache: %v", err)
+			}
+		}
+	}
+}
+
+func (c *InventoryCache) load(locationID int64, filters map[string]string) error {
+	it := c.Client.InventoryForLocationIterator(locationID, filters)
+	for it.Next() {
+		inv := it.Inventory()
+		if inv.Identity.Sku == "" {
+			continue
+		}
+		err := c.Store.Set(locationID, inv.Identity.Sku, inv.AvailableToSell)
+		if err != nil {
+			return err
+		}
+	}
+	return it.Err()
+}
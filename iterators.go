@@ -0,0 +1,173 @@
+package bigcommerce
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// pager keeps the paging state of an iterator, fetch loads the next page and reports if there are more
+type pager struct {
+	fetch func() (n int, more bool, err error)
+	i     int
+	n     int
+	done  bool
+	err   error
+}
+
+// next moves to the next item, fetching pages as needed
+func (p *pager) next() bool {
+	p.i++
+	for p.i >= p.n {
+		if p.done || p.err != nil {
+			return false
+		}
+		n, more, err := p.fetch()
+		if err == ErrNoContent {
+			n, more, err = 0, false, nil
+		}
+		p.i, p.n, p.done, p.err = 0, n, !more, err
+		if err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// ShipmentsIterator walks all shipments of an order, page by page:
+//
+//	it := bc.OrderShipmentsIterator(orderID, nil)
+//	for it.Next() {
+//		s := it.Shipment()
+//	}
+//	if it.Err() != nil { ... }
+type ShipmentsIterator struct {
+	pager
+	items []Shipment
+}
+
+// OrderShipmentsIterator returns an iterator over the shipments of an order, filters are passed to GetOrderShipments
+func (bc *Client) OrderShipmentsIterator(orderID int64, filters map[string]string) *ShipmentsIterator {
+	it := &ShipmentsIterator{}
+	page := 0
+	it.fetch = func() (int, bool, error) {
+		page++
+		args := copyArgs(filters)
+		args["page"] = strconv.Itoa(page)
+		if args["limit"] == "" {
+			args["limit"] = strconv.Itoa(v2PageLimit)
+		}
+		limit, _ := strconv.Atoi(args["limit"])
+		items, err := bc.GetOrderShipments(orderID, args)
+		it.items = items
+		return len(items), len(items) >= limit, err
+	}
+	return it
+}
+
+// Next moves to the next shipment, false when there are no more or an error occurred
+func (it *ShipmentsIterator) Next() bool {
+	return it.next()
+}
+
+// Shipment returns the current shipment
+func (it *ShipmentsIterator) Shipment() Shipment {
+	return it.items[it.i]
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *ShipmentsIterator) Err() error {
+	return it.err
+}
+
+// InventoryIterator walks the inventory of a location, following the pagination links of the responses
+type InventoryIterator struct {
+	pager
+	items []Inventory
+}
+
+// InventoryForLocationIterator returns an iterator over the inventory of a location, filters are passed to
+// GetInventoryForLocation for the first page
+func (bc *Client) InventoryForLocationIterator(locationID int64, filters map[string]string) *InventoryIterator {
+	it := &InventoryIterator{}
+	args := copyArgs(filters)
+	it.fetch = func() (int, bool, error) {
+		resource, err := bc.GetInventoryForLocation(locationID, args)
+		if err != nil {
+			return 0, false, err
+		}
+		it.items = resource.Inventories
+		next := resource.Meta.Pagination.Links.Next
+		if next == "" {
+			return len(it.items), false, nil
+		}
+		q, err := url.ParseQuery(strings.TrimPrefix(next, "?"))
+		if err != nil {
+			return len(it.items), false, err
+		}
+		args = map[string]string{}
+		for k := range q {
+			args[k] = q.Get(k)
+		}
+		return len(it.items), true, nil
+	}
+	return it
+}
+
+// Next moves to the next inventory item, false when there are no more or an error occurred
+func (it *InventoryIterator) Next() bool {
+	return it.next()
+}
+
+// Inventory returns the current inventory item
+func (it *InventoryIterator) Inventory() Inventory {
+	return it.items[it.i]
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *InventoryIterator) Err() error {
+	return it.err
+}
+
+// ProductsIterator walks the catalog one page at a time, without holding it in memory
+type ProductsIterator struct {
+	pager
+	items []Product
+}
+
+// ProductsIterator returns an iterator over the products, args are passed to GetProducts
+func (bc *Client) ProductsIterator(args map[string]string) *ProductsIterator {
+	it := &ProductsIterator{}
+	page := 0
+	it.fetch = func() (int, bool, error) {
+		page++
+		items, more, err := bc.GetProducts(args, page)
+		it.items = items
+		return len(items), more, err
+	}
+	return it
+}
+
+// Next moves to the next product, false when there are no more or an error occurred
+func (it *ProductsIterator) Next() bool {
+	return it.next()
+}
+
+// Product returns the current product
+func (it *ProductsIterator) Product() Product {
+	return it.items[it.i]
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *ProductsIterator) Err() error {
+	return it.err
+}
+
+// copyArgs returns a copy of a filters map, so iterators can set paging arguments
+func copyArgs(args map[string]string) map[string]string {
+	ret := make(map[string]string, len(args)+2)
+	for k, v := range args {
+		ret[k] = v
+	}
+	return ret
+}
@@ -0,0 +1,174 @@
+package bigcommerce
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// KitNamespace is the product metafield namespace kits are defined in: a kit product has a metafield with
+// key KitComponentsKey holding its components as JSON, e.g. [{"sku":"CUP","quantity":2},{"sku":"SAUCER","quantity":2}]
+const KitNamespace = "kit"
+
+// KitComponentsKey is the metafield key of a kit's components
+const KitComponentsKey = "components"
+
+// KitComponent is a SKU and the quantity of it in one kit
+type KitComponent struct {
+	Sku      string `json:"sku"`
+	Quantity int    `json:"quantity"`
+}
+
+// ComponentPick is a SKU and quantity to pick for an order line, a kit line explodes into one pick per component
+type ComponentPick struct {
+	OrderProductID int64 `json:"order_product_id"`
+	// KitSku is the SKU of the kit the pick is a component of, empty for lines that are no kit
+	KitSku   string `json:"kit_sku,omitempty"`
+	Sku      string `json:"sku"`
+	Quantity int    `json:"quantity"`
+}
+
+// GetKitComponents returns the components of a kit product, an empty list for products that are no kit
+func (bc *Client) GetKitComponents(productID int64) ([]KitComponent, error) {
+	metafields, err := bc.GetProductMetafieldsInNamespace(productID, KitNamespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, mf := range metafields {
+		if mf.Key != KitComponentsKey {
+			continue
+		}
+		var components []KitComponent
+		err = json.Unmarshal([]byte(mf.Value), &components)
+		if err != nil {
+			return nil, fmt.Errorf("product %d: invalid kit components: %w", productID, err)
+		}
+		return components, nil
+	}
+	return []KitComponent{}, nil
+}
+
+// SetKitComponents makes a product a kit of components, no components turn it back into a regular product
+func (bc *Client) SetKitComponents(productID int64, components []KitComponent) error {
+	metafields, err := bc.GetProductMetafieldsInNamespace(productID, KitNamespace)
+	if err != nil {
+		return err
+	}
+	var existing *Metafield
+	for i := range metafields {
+		if metafields[i].Key == KitComponentsKey {
+			existing = &metafields[i]
+		}
+	}
+	if len(components) == 0 {
+		if existing == nil {
+			return nil
+		}
+		return bc.DeleteProductMetafield(productID, existing.ID)
+	}
+	for _, c := range components {
+		if c.Sku == "" || c.Quantity <= 0 {
+			return fmt.Errorf("product %d: kit component needs a SKU and a positive quantity", productID)
+		}
+	}
+	value, err := json.Marshal(components)
+	if err != nil {
+		return err
+	}
+	mf := Metafield{
+		Namespace:   KitNamespace,
+		Key:         KitComponentsKey,
+		Value:       string(value),
+		Description: "Kit components",
+	}
+	if existing != nil {
+		mf.ID = existing.ID
+		_, err = bc.UpdateProductMetafield(productID, mf)
+		return err
+	}
+	_, err = bc.CreateProductMetafield(productID, mf)
+	return err
+}
+
+// ExplodeOrder returns what to pick for the unshipped quantities of an order, with kits exploded into components
+func (bc *Client) ExplodeOrder(orderID int64) ([]ComponentPick, error) {
+	products, err := bc.GetOrderProducts(orderID)
+	if err != nil {
+		return nil, err
+	}
+	quantities := map[int64]int{}
+	for _, p := range products {
+		quantities[p.ID] = p.QuantityToShip()
+	}
+	return bc.explodeKits(products, quantities)
+}
+
+// AdjustKitInventory takes the components of the kits in a shipment from stock at a location.
+// BigCommerce only tracks the stock of the kit product itself, this keeps the component stock in line
+func (bc *Client) AdjustKitInventory(orderID, locationID int64, shipment *Shipment) error {
+	products, err := bc.GetOrderProducts(orderID)
+	if err != nil {
+		return err
+	}
+	quantities := map[int64]int{}
+	for _, item := range shipment.Items {
+		quantities[item.OrderProductId] += int(item.Quantity)
+	}
+	picks, err := bc.explodeKits(products, quantities)
+	if err != nil {
+		return err
+	}
+	adjustment := &Adjustment{
+		Reason:    "Kit components of shipment " + strconv.FormatInt(shipment.ID, 10) + " of order " + strconv.FormatInt(orderID, 10),
+		Reference: strconv.FormatInt(orderID, 10),
+	}
+	for _, pick := range picks {
+		if pick.KitSku == "" {
+			continue
+		}
+		adjustment.Items = append(adjustment.Items, AdjustmentItem{
+			LocationId: locationID,
+			Sku:        pick.Sku,
+			Quantity:   -pick.Quantity,
+		})
+	}
+	if len(adjustment.Items) == 0 {
+		return nil
+	}
+	return bc.AdjustInventoryRelative(adjustment)
+}
+
+// explodeKits returns the picks for quantities of order products, by order product ID.
+// Kit components are looked up once per product
+func (bc *Client) explodeKits(products []OrderProduct, quantities map[int64]int) ([]ComponentPick, error) {
+	kits := map[int64][]KitComponent{}
+	picks := []ComponentPick{}
+	for _, p := range products {
+		qty := quantities[p.ID]
+		if qty <= 0 || p.Type == "digital" {
+			continue
+		}
+		components, ok := kits[p.ProductID]
+		if !ok {
+			var err error
+			components, err = bc.GetKitComponents(p.ProductID)
+			if err != nil {
+				return nil, err
+			}
+			kits[p.ProductID] = components
+		}
+		if len(components) == 0 {
+			picks = append(picks, ComponentPick{OrderProductID: p.ID, Sku: p.Sku, Quantity: qty})
+			continue
+		}
+		for _, c := range components {
+			picks = append(picks, ComponentPick{
+				OrderProductID: p.ID,
+				KitSku:         p.Sku,
+				Sku:            c.Sku,
+				Quantity:       c.Quantity * qty,
+			})
+		}
+	}
+	return picks, nil
+}
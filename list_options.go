@@ -0,0 +1,73 @@
+package bigcommerce
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ShipmentListOptions filters and pages the shipments of an order, the zero value lists the first page
+type ShipmentListOptions struct {
+	MinDateCreated time.Time
+	MaxDateCreated time.Time
+	Page           int
+	Limit          int
+}
+
+// Values returns the options as escaped query parameters
+func (o ShipmentListOptions) Values() url.Values {
+	q := url.Values{}
+	if !o.MinDateCreated.IsZero() {
+		q.Set("min_date_created", o.MinDateCreated.UTC().Format(time.RFC1123Z))
+	}
+	if !o.MaxDateCreated.IsZero() {
+		q.Set("max_date_created", o.MaxDateCreated.UTC().Format(time.RFC1123Z))
+	}
+	setPaging(q, o.Page, o.Limit)
+	return q
+}
+
+// InventoryListOptions filters and pages the inventory items of a location, the zero value lists the first page
+type InventoryListOptions struct {
+	Skus       []string
+	VariantIDs []int64
+	ProductIDs []int64
+	Page       int
+	Limit      int
+}
+
+// Values returns the options as escaped query parameters
+func (o InventoryListOptions) Values() url.Values {
+	q := url.Values{}
+	if len(o.Skus) > 0 {
+		q.Set("sku:in", strings.Join(o.Skus, ","))
+	}
+	if len(o.VariantIDs) > 0 {
+		q.Set("variant_id:in", joinIDs(o.VariantIDs))
+	}
+	if len(o.ProductIDs) > 0 {
+		q.Set("product_id:in", joinIDs(o.ProductIDs))
+	}
+	setPaging(q, o.Page, o.Limit)
+	return q
+}
+
+// GetOrderShipmentsWithOptions retrieves the shipments of an order matching opts
+func (bc *Client) GetOrderShipmentsWithOptions(orderID int64, opts ShipmentListOptions) ([]Shipment, error) {
+	return bc.getOrderShipments(newURL("/v2/orders").ID(orderID).Segment("shipments").Values(opts.Values()).String())
+}
+
+// GetInventoryForLocationWithOptions retrieves the inventory items of a location matching opts
+func (bc *Client) GetInventoryForLocationWithOptions(locationID int64, opts InventoryListOptions) (*InventoryResource, error) {
+	return bc.getInventoryForLocation(newURL("/v3/inventory/locations").ID(locationID).Segment("items").Values(opts.Values()).String())
+}
+
+func setPaging(q url.Values, page, limit int) {
+	if page > 0 {
+		q.Set("page", strconv.Itoa(page))
+	}
+	if limit > 0 {
+		q.Set("limit", strconv.Itoa(limit))
+	}
+}
@@ -2,11 +2,7 @@ package bigcommerce
 
 import (
 	"bytes"
-	"encoding/json"
-	"errors"
-	"fmt"
 	"net/http"
-	"strings"
 )
 
 type LocationResource struct {
@@ -74,14 +70,10 @@ type Weekday struct {
 // GetLocations returns all locations using filters.
 // filters: request query parameters for BigCommerce locations endpoint, for example {"is_active": true}
 func (bc *Client) GetLocations(filters map[string]string) ([]Location, error) {
-	var params []string
-	for k, v := range filters {
-		params = append(params, fmt.Sprintf("%s=%s", k, v))
-	}
-	url := "/v3/inventory/locations?" + strings.Join(params, "&")
+	url := newURL("/v3/inventory/locations").Args(filters).String()
 
 	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +88,7 @@ func (bc *Client) GetLocations(filters map[string]string) ([]Location, error) {
 	}
 
 	var resource LocationResource
-	err = json.Unmarshal(body, &resource)
+	err = bc.unmarshal(body, &resource)
 
 	if err != nil {
 		return nil, err
@@ -108,31 +100,29 @@ func (bc *Client) GetLocations(filters map[string]string) ([]Location, error) {
 func (bc *Client) CreateLocations(location *[]Location) error {
 	url := "/v3/inventory/locations"
 
-	reqJSON, _ := json.Marshal(location)
+	reqJSON, _ := bc.marshal(location)
 
 	req := bc.getAPIRequest(http.MethodPost, url, bytes.NewReader(reqJSON))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return err
 	}
-
-	if res.StatusCode != 200 {
-		return errors.New(res.Status)
-	}
-
-	return nil
+	defer res.Body.Close()
+	_, err = processBody(res)
+	return err
 }
 
 // UpdateLocation alters the locations values
 func (bc *Client) UpdateLocation(location *Location) error {
 	url := "/v3/inventory/locations"
 
-	reqJSON, _ := json.Marshal(location)
+	reqJSON, _ := bc.marshal(location)
 	req := bc.getAPIRequest(http.MethodPut, url, bytes.NewReader(reqJSON))
-	_, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return err
 	}
-
-	return nil
+	defer res.Body.Close()
+	_, err = processBody(res)
+	return err
 }
@@ -0,0 +1,114 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// Metafield permission sets
+const (
+	MetafieldAppOnly          = "app_only"
+	MetafieldRead             = "read"
+	MetafieldWrite            = "write"
+	MetafieldReadAndSFAccess  = "read_and_sf_access"
+	MetafieldWriteAndSFAccess = "write_and_sf_access"
+)
+
+// GetOrderMetafields returns the metafields of an order, only from namespace when not empty
+func (bc *Client) GetOrderMetafields(orderID int64, namespace string) ([]Metafield, error) {
+	return bc.getMetafields("/v3/orders/"+strconv.FormatInt(orderID, 10)+"/metafields", namespace)
+}
+
+// CreateOrderMetafield creates a metafield on an order
+func (bc *Client) CreateOrderMetafield(orderID int64, metafield Metafield) (*Metafield, error) {
+	return bc.saveMetafield(http.MethodPost, "/v3/orders/"+strconv.FormatInt(orderID, 10)+"/metafields", metafield)
+}
+
+// UpdateOrderMetafield updates an existing order metafield, metafield ID is required
+func (bc *Client) UpdateOrderMetafield(orderID int64, metafield Metafield) (*Metafield, error) {
+	return bc.saveMetafield(http.MethodPut, "/v3/orders/"+strconv.FormatInt(orderID, 10)+"/metafields/"+strconv.FormatInt(metafield.ID, 10), metafield)
+}
+
+// DeleteOrderMetafield deletes an order metafield
+func (bc *Client) DeleteOrderMetafield(orderID, metafieldID int64) error {
+	return bc.deleteMetafield("/v3/orders/" + strconv.FormatInt(orderID, 10) + "/metafields/" + strconv.FormatInt(metafieldID, 10))
+}
+
+// getMetafields gets all metafields of a resource, handling pagination
+// path: the resource's metafields endpoint, e.g. /v3/orders/1/metafields
+func (bc *Client) getMetafields(path, namespace string) ([]Metafield, error) {
+	args := map[string]string{}
+	if namespace != "" {
+		args["namespace"] = namespace
+	}
+	return ListPages[Metafield](bc, path, args)
+}
+
+// saveMetafield creates (POST) or updates (PUT) a metafield
+func (bc *Client) saveMetafield(method, path string, metafield Metafield) (*Metafield, error) {
+	if metafield.PermissionSet == "" {
+		metafield.PermissionSet = MetafieldAppOnly
+	}
+	// read only fields
+	metafield.ID = 0
+	metafield.ResourceID = 0
+	metafield.ResourceType = ""
+	reqJSON, err := bc.marshal(metafield)
+	if err != nil {
+		return nil, err
+	}
+	req := bc.getAPIRequest(method, path, bytes.NewReader(reqJSON))
+	res, err := bc.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil {
+		return nil, fmt.Errorf("error saving metafield %s.%s: %w %s", metafield.Namespace, metafield.Key, err, string(body))
+	}
+	var mfResponse struct {
+		Data Metafield `json:"data"`
+	}
+	err = bc.unmarshal(body, &mfResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &mfResponse.Data, nil
+}
+
+func (bc *Client) deleteMetafield(path string) error {
+	req := bc.getAPIRequest(http.MethodDelete, path, nil)
+	res, err := bc.do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, err = processBody(res)
+	if err != nil && err != ErrNoContent {
+		return err
+	}
+	return nil
+}
+
+// GetProductMetafieldsInNamespace returns the metafields of a product, only from namespace when not empty
+func (bc *Client) GetProductMetafieldsInNamespace(productID int64, namespace string) ([]Metafield, error) {
+	return bc.getMetafields("/v3/catalog/products/"+strconv.FormatInt(productID, 10)+"/metafields", namespace)
+}
+
+// CreateProductMetafield creates a metafield on a product
+func (bc *Client) CreateProductMetafield(productID int64, metafield Metafield) (*Metafield, error) {
+	return bc.saveMetafield(http.MethodPost, "/v3/catalog/products/"+strconv.FormatInt(productID, 10)+"/metafields", metafield)
+}
+
+// UpdateProductMetafield updates an existing product metafield, metafield ID is required
+func (bc *Client) UpdateProductMetafield(productID int64, metafield Metafield) (*Metafield, error) {
+	return bc.saveMetafield(http.MethodPut, "/v3/catalog/products/"+strconv.FormatInt(productID, 10)+"/metafields/"+strconv.FormatInt(metafield.ID, 10), metafield)
+}
+
+// DeleteProductMetafield deletes a product metafield
+func (bc *Client) DeleteProductMetafield(productID, metafieldID int64) error {
+	return bc.deleteMetafield("/v3/catalog/products/" + strconv.FormatInt(productID, 10) + "/metafields/" + strconv.FormatInt(metafieldID, 10))
+}
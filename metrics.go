@@ -0,0 +1,59 @@
+package bigcommerce
+
+import (
+	"net/http"
+	"time"
+)
+
+// Metrics receives API usage of a client, set it with WithMetrics. Endpoints are paths with IDs replaced,
+// e.g. "/v2/orders/{id}/shipments", so they make labels of bounded cardinality. A Prometheus collector:
+//
+//	type promMetrics struct {
+//		requests  *prometheus.HistogramVec // labels endpoint, method, status
+//		retries   *prometheus.CounterVec   // labels endpoint, method
+//		remaining prometheus.Gauge
+//	}
+//
+//	func (m promMetrics) ObserveRequest(endpoint, method string, status int, d time.Duration) {
+//		m.requests.WithLabelValues(endpoint, method, strconv.Itoa(status)).Observe(d.Seconds())
+//	}
+//
+// Alert on the remaining requests before BigCommerce starts answering 429
+type Metrics interface {
+	// ObserveRequest is called for every request sent, including retries, status is 0 when no response came back
+	ObserveRequest(endpoint, method string, status int, duration time.Duration)
+	// IncRetry is called before a request is retried
+	IncRetry(endpoint, method string)
+	// SetRateLimit is called with the rate limit headers of every response that has them
+	SetRateLimit(requestsLeft, requestsQuota int)
+}
+
+// WithMetrics reports the client's API usage to m
+func WithMetrics(m Metrics) Option {
+	return func(bc *Client) {
+		bc.Metrics = m
+	}
+}
+
+// observeRequest reports a request attempt to the client's Metrics
+func (bc *Client) observeRequest(req *http.Request, res *http.Response, latency time.Duration) {
+	if bc.Metrics == nil {
+		return
+	}
+	status := 0
+	if res != nil {
+		status = res.StatusCode
+	}
+	bc.Metrics.ObserveRequest(resourceName(req.URL.Path), req.Method, status, latency)
+	if status != 0 && res.Header.Get("X-Rate-Limit-Requests-Left") != "" {
+		rl := bc.RateLimitStatus()
+		bc.Metrics.SetRateLimit(rl.RequestsLeft, rl.RequestsQuota)
+	}
+}
+
+// observeRetry reports a retry to the client's Metrics
+func (bc *Client) observeRetry(req *http.Request) {
+	if bc.Metrics != nil {
+		bc.Metrics.IncRetry(resourceName(req.URL.Path), req.Method)
+	}
+}
@@ -0,0 +1,66 @@
+package bigcommerce
+
+import (
+	"net/http"
+)
+
+// Middleware wraps the sending of requests, to add headers, audit or measure calls without forking the package:
+//
+//	audit := func(next http.RoundTripper) http.RoundTripper {
+//		return bigcommerce.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+//			res, err := next.RoundTrip(req)
+//			log.Printf("%s %s: %v", req.Method, req.URL, err)
+//			return res, err
+//		})
+//	}
+//	client := bigcommerce.NewClient(storeHash, token, bigcommerce.WithMiddleware(audit))
+//
+// Middleware runs for every attempt, so retries and replays after a token refresh pass through it too.
+// The first middleware is the outermost
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc turns a function into an http.RoundTripper
+type RoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req)
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// WithMiddleware adds middleware to the client, after middleware added before
+func WithMiddleware(mw ...Middleware) Option {
+	return func(bc *Client) {
+		bc.Middleware = append(bc.Middleware, mw...)
+	}
+}
+
+// OnRequest returns middleware calling fn before every request is sent, e.g. to set headers
+func OnRequest(fn func(req *http.Request)) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			fn(req)
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+// OnResponse returns middleware calling fn with the outcome of every request, res is nil when err is set.
+// fn must not read the response body
+func OnResponse(fn func(req *http.Request, res *http.Response, err error)) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			res, err := next.RoundTrip(req)
+			fn(req, res, err)
+			return res, err
+		})
+	}
+}
+
+// transport returns the HTTP client wrapped in the client's middleware
+func (bc *Client) transport() http.RoundTripper {
+	var rt http.RoundTripper = RoundTripperFunc(bc.HTTPClient.Do)
+	for i := len(bc.Middleware) - 1; i >= 0; i-- {
+		rt = bc.Middleware[i](rt)
+	}
+	return rt
+}
@@ -0,0 +1,516 @@
+package bigcommerce
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Resources a Migrator copies, in the order Migrate copies them
+const (
+	MigrateCategories = "categories"
+	MigrateBrands     = "brands"
+	MigrateProducts   = "products"
+	MigrateCustomers  = "customers"
+	MigrateRedirects  = "redirects"
+)
+
+// MigrateAll are all resources a Migrator copies, products depend on categories and brands being copied first
+var MigrateAll = []string{MigrateCategories, MigrateBrands, MigrateProducts, MigrateCustomers, MigrateRedirects}
+
+// customerBatchSize is the most customers BigCommerce creates in one request
+const customerBatchSize = 10
+
+// redirectBatchSize is how many redirects are upserted per request
+const redirectBatchSize = 100
+
+// migrationReadOnly are the fields of exported records that the create endpoints reject or ignore
+var migrationReadOnly = map[string][]string{
+	MigrateCategories: {"id", "views"},
+	MigrateBrands:     {"id"},
+	MigrateProducts: {"id", "date_created", "date_modified", "calculated_price", "base_variant_id", "view_count",
+		"total_sold", "reviews_count", "reviews_rating_sum", "option_set_id", "option_set_display", "map_price"},
+	MigrateCustomers: {"id", "date_created", "date_modified", "address_count", "attribute_count", "store_credit_amounts",
+		"registration_ip_address", "addresses", "authentication"},
+	MigrateRedirects: {"id", "to_url"},
+}
+
+// IDMap maps IDs in the source store to the IDs of the copies in the target store. It marshals to JSON,
+// save it to resume an interrupted migration or to rewrite references elsewhere
+type IDMap struct {
+	Categories map[int64]int64 `json:"categories"`
+	Brands     map[int64]int64 `json:"brands"`
+	Products   map[int64]int64 `json:"products"`
+	Variants   map[int64]int64 `json:"variants"`
+	Customers  map[int64]int64 `json:"customers"`
+	Redirects  map[int64]int64 `json:"redirects"`
+}
+
+// Migrator copies catalog, customers and redirects from one store into another, for relaunching a store
+// or setting up a staging copy. References between resources, like product categories and brands,
+// are rewritten to the new IDs. Records already in IDs are skipped, so a failed run can be resumed:
+//
+//	m := bigcommerce.NewMigrator(oldStore, newStore)
+//	err := m.Migrate()
+//	json.NewEncoder(f).Encode(m.IDs)
+//
+// Customer passwords can't be exported, copied customers are asked to reset theirs
+type Migrator struct {
+	From *Client
+	To   *Client
+	IDs  IDMap
+	// SiteID is the site of the target store redirects are created for, the source site ID when 0
+	SiteID int64
+	// ContinueOnError keeps copying when a record fails, the errors are returned together at the end
+	ContinueOnError bool
+	// Progress, when set, is called for every copied record
+	Progress func(resource string, oldID, newID int64)
+}
+
+// NewMigrator returns a Migrator copying from one store client to another
+func NewMigrator(from, to *Client) *Migrator {
+	return &Migrator{
+		From: from,
+		To:   to,
+		IDs: IDMap{
+			Categories: map[int64]int64{},
+			Brands:     map[int64]int64{},
+			Products:   map[int64]int64{},
+			Variants:   map[int64]int64{},
+			Customers:  map[int64]int64{},
+			Redirects:  map[int64]int64{},
+		},
+	}
+}
+
+// Migrate copies resources, MigrateAll when none are given
+func (m *Migrator) Migrate(resources ...string) error {
+	if len(resources) == 0 {
+		resources = MigrateAll
+	}
+	var errs []error
+	for _, r := range resources {
+		var err error
+		switch r {
+		case MigrateCategories:
+			err = m.MigrateCategories()
+		case MigrateBrands:
+			err = m.MigrateBrands()
+		case MigrateProducts:
+			err = m.MigrateProducts()
+		case MigrateCustomers:
+			err = m.MigrateCustomers()
+		case MigrateRedirects:
+			err = m.MigrateRedirects()
+		default:
+			err = fmt.Errorf("unknown migration resource %s", r)
+		}
+		if err != nil {
+			if !m.ContinueOnError {
+				return err
+			}
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// MigrateCategories copies the category tree, parents before their children
+func (m *Migrator) MigrateCategories() error {
+	categories, err := ListPages[map[string]interface{}](m.From, "/v3/catalog/categories", nil)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	// copy the categories whose parent has been copied until no more can be
+	for len(categories) > 0 {
+		left := categories[:0]
+		for _, c := range categories {
+			parentID := idValue(c["parent_id"])
+			if parentID != 0 && m.IDs.Categories[parentID] == 0 {
+				left = append(left, c)
+				continue
+			}
+			id := idValue(c["id"])
+			if m.IDs.Categories[id] != 0 {
+				continue
+			}
+			c = migrationRecord(MigrateCategories, c)
+			c["parent_id"] = m.IDs.Categories[parentID]
+			newID, err := m.create(MigrateCategories, "/v3/catalog/categories", id, c)
+			if err != nil {
+				if !m.ContinueOnError {
+					return err
+				}
+				errs = append(errs, err)
+				continue
+			}
+			m.IDs.Categories[id] = newID
+		}
+		if len(left) == len(categories) {
+			for _, c := range left {
+				errs = append(errs, fmt.Errorf("error migrating category %d: parent %d was not copied", idValue(c["id"]), idValue(c["parent_id"])))
+			}
+			break
+		}
+		categories = left
+	}
+	return errors.Join(errs...)
+}
+
+// MigrateBrands copies the brands
+func (m *Migrator) MigrateBrands() error {
+	brands, err := ListPages[map[string]interface{}](m.From, "/v3/catalog/brands", nil)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, b := range brands {
+		id := idValue(b["id"])
+		if m.IDs.Brands[id] != 0 {
+			continue
+		}
+		newID, err := m.create(MigrateBrands, "/v3/catalog/brands", id, migrationRecord(MigrateBrands, b))
+		if err != nil {
+			if !m.ContinueOnError {
+				return err
+			}
+			errs = append(errs, err)
+			continue
+		}
+		m.IDs.Brands[id] = newID
+	}
+	return errors.Join(errs...)
+}
+
+// MigrateProducts copies the products with their variants, images and custom fields. Categories and brands
+// that were not copied are dropped from the products
+func (m *Migrator) MigrateProducts() error {
+	products, err := ListPages[map[string]interface{}](m.From, "/v3/catalog/products", map[string]string{
+		"include": "variants,images,custom_fields",
+		"limit":   "50",
+	})
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, p := range products {
+		id := idValue(p["id"])
+		if m.IDs.Products[id] != 0 {
+			continue
+		}
+		err := m.migrateProduct(id, p)
+		if err != nil {
+			if !m.ContinueOnError {
+				return err
+			}
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (m *Migrator) migrateProduct(id int64, p map[string]interface{}) error {
+	oldVariants, _ := p["variants"].([]interface{})
+	baseVariantID := idValue(p["base_variant_id"])
+	p = migrationRecord(MigrateProducts, p)
+	if brandID := idValue(p["brand_id"]); brandID != 0 {
+		p["brand_id"] = m.IDs.Brands[brandID]
+	}
+	categories := []int64{}
+	for _, c := range sliceValue(p["categories"]) {
+		if newID := m.IDs.Categories[idValue(c)]; newID != 0 {
+			categories = append(categories, newID)
+		}
+	}
+	p["categories"] = categories
+
+	images := []map[string]interface{}{}
+	for _, img := range sliceValue(p["images"]) {
+		img, _ := img.(map[string]interface{})
+		url := firstNonEmpty(stringValue(img["url_zoom"]), stringValue(img["url_standard"]))
+		if url == "" {
+			continue
+		}
+		images = append(images, map[string]interface{}{
+			"image_url":    url,
+			"is_thumbnail": img["is_thumbnail"],
+			"sort_order":   img["sort_order"],
+			"description":  img["description"],
+		})
+	}
+	p["images"] = images
+
+	fields := []map[string]interface{}{}
+	for _, f := range sliceValue(p["custom_fields"]) {
+		f, _ := f.(map[string]interface{})
+		fields = append(fields, map[string]interface{}{"name": f["name"], "value": f["value"]})
+	}
+	p["custom_fields"] = fields
+
+	// the base variant is created with the product, only variants with options are sent
+	variants := []map[string]interface{}{}
+	for _, v := range oldVariants {
+		v, _ := v.(map[string]interface{})
+		options := sliceValue(v["option_values"])
+		if len(options) == 0 {
+			continue
+		}
+		nv := map[string]interface{}{}
+		for k, val := range v {
+			switch k {
+			case "id", "product_id", "sku_id", "calculated_price", "calculated_weight", "option_values":
+			default:
+				nv[k] = val
+			}
+		}
+		values := make([]map[string]interface{}, 0, len(options))
+		for _, o := range options {
+			o, _ := o.(map[string]interface{})
+			values = append(values, map[string]interface{}{"option_display_name": o["option_display_name"], "label": o["label"]})
+		}
+		nv["option_values"] = values
+		variants = append(variants, nv)
+	}
+	if len(variants) > 0 {
+		p["variants"] = variants
+	} else {
+		delete(p, "variants")
+	}
+
+	var ret struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	err := m.To.sendJSON(http.MethodPost, "/v3/catalog/products?include=variants", p, &ret)
+	if err != nil {
+		return fmt.Errorf("error migrating product %d (%s): %w", id, stringValue(p["sku"]), err)
+	}
+	newID := idValue(ret.Data["id"])
+	m.IDs.Products[id] = newID
+	if baseVariantID != 0 {
+		m.IDs.Variants[baseVariantID] = idValue(ret.Data["base_variant_id"])
+	}
+	// variants are matched by SKU, their order is not guaranteed
+	newBySku := map[string]int64{}
+	for _, v := range sliceValue(ret.Data["variants"]) {
+		v, _ := v.(map[string]interface{})
+		newBySku[stringValue(v["sku"])] = idValue(v["id"])
+	}
+	for _, v := range oldVariants {
+		v, _ := v.(map[string]interface{})
+		oldID := idValue(v["id"])
+		if newVariantID := newBySku[stringValue(v["sku"])]; newVariantID != 0 && oldID != baseVariantID {
+			m.IDs.Variants[oldID] = newVariantID
+		}
+	}
+	if m.Progress != nil {
+		m.Progress(MigrateProducts, id, newID)
+	}
+	return nil
+}
+
+// MigrateCustomers copies the customers with their addresses, they have to reset their password on first login
+func (m *Migrator) MigrateCustomers() error {
+	customers, err := ListPages[map[string]interface{}](m.From, "/v3/customers", map[string]string{"include": "addresses"})
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for start := 0; start < len(customers); start += customerBatchSize {
+		end := start + customerBatchSize
+		if end > len(customers) {
+			end = len(customers)
+		}
+		ids := []int64{}
+		batch := []map[string]interface{}{}
+		for _, c := range customers[start:end] {
+			id := idValue(c["id"])
+			if m.IDs.Customers[id] != 0 {
+				continue
+			}
+			addresses := []map[string]interface{}{}
+			for _, a := range sliceValue(c["addresses"]) {
+				a, _ := a.(map[string]interface{})
+				na := map[string]interface{}{}
+				for k, v := range a {
+					if k != "id" && k != "customer_id" && k != "country" {
+						na[k] = v
+					}
+				}
+				addresses = append(addresses, na)
+			}
+			c = migrationRecord(MigrateCustomers, c)
+			c["addresses"] = addresses
+			c["authentication"] = map[string]interface{}{"force_password_reset": true}
+			ids = append(ids, id)
+			batch = append(batch, c)
+		}
+		if len(batch) == 0 {
+			continue
+		}
+		var ret struct {
+			Data []map[string]interface{} `json:"data"`
+		}
+		err := m.To.sendJSON(http.MethodPost, "/v3/customers", batch, &ret)
+		if err != nil {
+			err = fmt.Errorf("error migrating customers %s: %w", joinIDs(ids), err)
+			if !m.ContinueOnError {
+				return err
+			}
+			errs = append(errs, err)
+			continue
+		}
+		// created customers are matched by email, which is unique per store
+		newByEmail := map[string]int64{}
+		for _, c := range ret.Data {
+			newByEmail[strings.ToLower(stringValue(c["email"]))] = idValue(c["id"])
+		}
+		for i, c := range batch {
+			newID := newByEmail[strings.ToLower(stringValue(c["email"]))]
+			if newID == 0 {
+				continue
+			}
+			m.IDs.Customers[ids[i]] = newID
+			if m.Progress != nil {
+				m.Progress(MigrateCustomers, ids[i], newID)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// MigrateRedirects copies the storefront redirects, pointing product, category and brand redirects at the copies
+// and page redirects at the page's path. Redirects to resources that were not copied are dropped
+func (m *Migrator) MigrateRedirects() error {
+	redirects, err := ListPages[map[string]interface{}](m.From, "/v3/storefront/redirects", map[string]string{"include": "to_url"})
+	if err != nil {
+		return err
+	}
+	var errs []error
+	ids := []int64{}
+	batch := []map[string]interface{}{}
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		var ret struct {
+			Data []map[string]interface{} `json:"data"`
+		}
+		err := m.To.sendJSON(http.MethodPut, "/v3/storefront/redirects", batch, &ret)
+		if err != nil {
+			return fmt.Errorf("error migrating redirects %s: %w", joinIDs(ids), err)
+		}
+		// redirects are matched by from path, which is unique per site
+		newByPath := map[string]int64{}
+		for _, r := range ret.Data {
+			newByPath[stringValue(r["from_path"])] = idValue(r["id"])
+		}
+		for i, r := range batch {
+			if newID := newByPath[stringValue(r["from_path"])]; newID != 0 {
+				m.IDs.Redirects[ids[i]] = newID
+				if m.Progress != nil {
+					m.Progress(MigrateRedirects, ids[i], newID)
+				}
+			}
+		}
+		return nil
+	}
+	for _, r := range redirects {
+		id := idValue(r["id"])
+		if m.IDs.Redirects[id] != 0 {
+			continue
+		}
+		to, _ := r["to"].(map[string]interface{})
+		toURL := stringValue(r["to_url"])
+		r = migrationRecord(MigrateRedirects, r)
+		if m.SiteID != 0 {
+			r["site_id"] = m.SiteID
+		}
+		if to != nil {
+			var idMap map[int64]int64
+			switch stringValue(to["type"]) {
+			case "product":
+				idMap = m.IDs.Products
+			case "category":
+				idMap = m.IDs.Categories
+			case "brand":
+				idMap = m.IDs.Brands
+			case "page":
+				// pages are not copied, keep pointing at the same path
+				r["to"] = map[string]interface{}{"type": "url", "url": toURL}
+			}
+			if idMap != nil {
+				newID := idMap[idValue(to["entity_id"])]
+				if newID == 0 {
+					continue
+				}
+				r["to"] = map[string]interface{}{"type": to["type"], "entity_id": newID}
+			}
+		}
+		ids = append(ids, id)
+		batch = append(batch, r)
+		if len(batch) == redirectBatchSize {
+			err = flush()
+			if err != nil {
+				if !m.ContinueOnError {
+					return err
+				}
+				errs = append(errs, err)
+			}
+			ids, batch = ids[:0], batch[:0]
+		}
+	}
+	err = flush()
+	if err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// create posts a record to a v3 endpoint of the target store and returns the new ID
+func (m *Migrator) create(resource, path string, oldID int64, record map[string]interface{}) (int64, error) {
+	var ret struct {
+		Data struct {
+			ID int64 `json:"id"`
+		} `json:"data"`
+	}
+	err := m.To.sendJSON(http.MethodPost, path, record, &ret)
+	if err != nil {
+		return 0, fmt.Errorf("error migrating %s %d: %w", strings.TrimSuffix(resource, "s"), oldID, err)
+	}
+	if m.Progress != nil {
+		m.Progress(resource, oldID, ret.Data.ID)
+	}
+	return ret.Data.ID, nil
+}
+
+// migrationRecord returns a copy of an exported record without the fields the target store sets itself
+func migrationRecord(resource string, record map[string]interface{}) map[string]interface{} {
+	ret := make(map[string]interface{}, len(record))
+	for k, v := range record {
+		ret[k] = v
+	}
+	for _, k := range migrationReadOnly[resource] {
+		delete(ret, k)
+	}
+	return ret
+}
+
+// idValue returns an ID decoded from JSON into an interface{}
+func idValue(v interface{}) int64 {
+	if f, ok := v.(float64); ok {
+		return int64(f)
+	}
+	return 0
+}
+
+func stringValue(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+func sliceValue(v interface{}) []interface{} {
+	s, _ := v.([]interface{})
+	return s
+}
@@ -2,8 +2,11 @@ package mocks
 
 import (
 	"strconv"
+
+	"github.com/ewarehousing-solutions/bigcommerce-api-go"
 )
 
+// CartClient keeps carts in memory
 type CartClient struct {
 	carts       map[string]*bigcommerce.Cart
 	CartContent map[string]bigcommerce.LineItem
@@ -11,6 +14,8 @@ type CartClient struct {
 	CustomerID  int64
 }
 
+var _ bigcommerce.CartClient = (*CartClient)(nil)
+
 func (cm *CartClient) CreateCart(items []bigcommerce.LineItem) (*bigcommerce.Cart, error) {
 	if cm.carts == nil {
 		cm.carts = map[string]*bigcommerce.Cart{}
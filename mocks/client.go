@@ -0,0 +1,1819 @@
+// Code generated by clientgen; DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/ewarehousing-solutions/bigcommerce-api-go"
+)
+
+// Client stubs bigcommerce.ClientInterface: set the Func field of every method the code under test calls,
+// calling a method without one panics so missing stubs show up in tests:
+//
+//	client := &mocks.Client{
+//		GetOrderFunc: func(orderID int64) (*bigcommerce.Order, error) {
+//			return &bigcommerce.Order{ID: orderID}, nil
+//		},
+//	}
+type Client struct {
+	GetAbandonedCartEmailSettingsFunc     func(channelID int64) (*bigcommerce.AbandonedCartEmailSettings, error)
+	UpdateAbandonedCartEmailSettingsFunc  func(channelID int64, settings bigcommerce.AbandonedCartEmailSettings) (*bigcommerce.AbandonedCartEmailSettings, error)
+	GetAbandonedCartEmailsFunc            func() ([]bigcommerce.AbandonedCartEmail, error)
+	GetAbandonedCartEmailFunc             func(emailID int64) (*bigcommerce.AbandonedCartEmail, error)
+	CreateAbandonedCartEmailFunc          func(email bigcommerce.AbandonedCartEmail) (*bigcommerce.AbandonedCartEmail, error)
+	UpdateAbandonedCartEmailFunc          func(email bigcommerce.AbandonedCartEmail) (*bigcommerce.AbandonedCartEmail, error)
+	DeleteAbandonedCartEmailFunc          func(emailID int64) error
+	GetAddressesFunc                      func(customerID int64) ([]bigcommerce.Address, error)
+	GetAddressPageFunc                    func(customerID int64, page int) ([]bigcommerce.Address, bool, error)
+	CreateAddressFunc                     func(customerID int64, address *bigcommerce.Address) (*bigcommerce.Address, error)
+	UpdateAddressFunc                     func(customerID int64, address *bigcommerce.Address) (*bigcommerce.Address, error)
+	DeleteAddressFunc                     func(customerID, addressID int64) error
+	GetCountriesFunc                      func() ([]bigcommerce.Country, error)
+	GetCountryStatesFunc                  func(countryID int64) ([]bigcommerce.CountryState, error)
+	BackfillAddressCountriesFunc          func(opts bigcommerce.BackfillOptions) ([]bigcommerce.AddressFix, error)
+	AdjustInventoryRelativeFunc           func(adjustment *bigcommerce.Adjustment) error
+	AdjustInventoryAbsoluteFunc           func(adjustment *bigcommerce.Adjustment) error
+	AnonymizeOrderFunc                    func(orderID int64) error
+	CheckBackordersFunc                   func(orderID, locationID int64, policy bigcommerce.BackorderPolicy) (*bigcommerce.BackorderDecision, error)
+	ApplyBackorderPolicyFunc              func(orderID, locationID int64, policy bigcommerce.BackorderPolicy) (*bigcommerce.BackorderDecision, error)
+	GetAllBrandsFunc                      func(args map[string]string) ([]bigcommerce.Brand, error)
+	GetBrandsFunc                         func(args map[string]string, page int) ([]bigcommerce.Brand, bool, error)
+	CreateCartFunc                        func(items []bigcommerce.LineItem) (*bigcommerce.Cart, error)
+	CreateCartWithCustomItemsFunc         func(items []bigcommerce.LineItem, customItems []bigcommerce.LineItem) (*bigcommerce.Cart, error)
+	GetCartFunc                           func(cartID string) (*bigcommerce.Cart, error)
+	CartAddItemsFunc                      func(cartID string, items []bigcommerce.LineItem) (*bigcommerce.Cart, error)
+	CartAddCustomItemsFunc                func(cartID string, customItems []bigcommerce.LineItem) (*bigcommerce.Cart, error)
+	CartEditItemFunc                      func(cartID string, item bigcommerce.LineItem) (*bigcommerce.Cart, error)
+	CartDeleteItemFunc                    func(cartID string, item bigcommerce.LineItem) (*bigcommerce.Cart, error)
+	CartUpdateCustomerIDFunc              func(cartID, customerID string) (*bigcommerce.Cart, error)
+	DeleteCartFunc                        func(cartID string) error
+	PackOrderFunc                         func(orderID int64, packer *bigcommerce.CartonPacker) ([]bigcommerce.Carton, error)
+	GetAllCategoriesFunc                  func(args map[string]string) ([]bigcommerce.Category, error)
+	GetCategoriesFunc                     func(args map[string]string, page int) ([]bigcommerce.Category, bool, error)
+	ImportCategoryTreeFunc                func(tree []*bigcommerce.CategoryNode, parentID int64) (map[*bigcommerce.CategoryNode]int64, error)
+	GetCategoryPathFunc                   func(categoryID int64) ([]bigcommerce.CategoryCrumb, error)
+	ResolveCategoryByPathFunc             func(path string) (*bigcommerce.Category, error)
+	ResetCategoryCacheFunc                func()
+	ListProductsModifiedSinceFunc         func(t time.Time, args map[string]string) ([]bigcommerce.Product, error)
+	ListVariantsModifiedSinceFunc         func(t time.Time) ([]bigcommerce.Variant, error)
+	GetAllChannelsFunc                    func() ([]bigcommerce.Channel, error)
+	GetChannelsFunc                       func(page int) ([]bigcommerce.Channel, bool, error)
+	UpdateChannelStatusFunc               func(channelID int64, status string) (*bigcommerce.Channel, error)
+	GetStorefrontStatusSettingsFunc       func(channelID int64) (*bigcommerce.StorefrontStatusSettings, error)
+	UpdateStorefrontStatusSettingsFunc    func(channelID int64, settings bigcommerce.StorefrontStatusSettings) error
+	SetChannelMaintenanceFunc             func(channelID int64, message string) error
+	SetChannelActiveFunc                  func(channelID int64) error
+	GetCheckoutFunc                       func(checkoutID string) (*bigcommerce.Checkout, error)
+	SetCheckoutBillingAddressFunc         func(checkoutID string, address bigcommerce.CheckoutAddress) (*bigcommerce.Checkout, error)
+	AddCheckoutConsignmentsFunc           func(checkoutID string, consignments []bigcommerce.ConsignmentRequest) (*bigcommerce.Checkout, error)
+	CreateCheckoutOrderFunc               func(checkoutID string) (int64, error)
+	CompleteCheckoutFunc                  func(cartID string, billing bigcommerce.CheckoutAddress, consignments []bigcommerce.ConsignmentRequest, payment *bigcommerce.PaymentRequest) (int64, error)
+	GetConsignmentShippingOptionsFunc     func(checkoutID, consignmentID string) ([]bigcommerce.ShippingOption, error)
+	UpdateConsignmentShippingOptionFunc   func(checkoutID, consignmentID, shippingOptionID string) (*bigcommerce.Checkout, error)
+	ApplyCouponToCheckoutFunc             func(checkoutID, couponCode string) (*bigcommerce.Checkout, error)
+	RemoveCouponFunc                      func(checkoutID, couponCode string) (*bigcommerce.Checkout, error)
+	ApplyGiftCertificateToCheckoutFunc    func(checkoutID, giftCertificateCode string) (*bigcommerce.Checkout, error)
+	RemoveGiftCertificateFromCheckoutFunc func(checkoutID, giftCertificateCode string) (*bigcommerce.Checkout, error)
+	WithContextFunc                       func(ctx context.Context) *bigcommerce.Client
+	WithRawPayloadFunc                    func(raw *[]json.RawMessage) *bigcommerce.Client
+	UpdateProductIfUnmodifiedSinceFunc    func(productID int64, since time.Time, updates map[string]interface {
+	}) error
+	UpdateOrderIfUnmodifiedSinceFunc    func(orderID int64, since time.Time, order *bigcommerce.UpdateOrder) error
+	SaveAccountIfUnmodifiedSinceFunc    func(since time.Time, payload *bigcommerce.SaveAccountPayload) (*bigcommerce.Customer, error)
+	RecordCartConvertedWebhookFunc      func(body []byte) (*bigcommerce.CartConversion, error)
+	CreateCouponFunc                    func(coupon bigcommerce.Coupon) (*bigcommerce.Coupon, error)
+	GetCouponFunc                       func(couponID int64) (*bigcommerce.Coupon, error)
+	UpdateCouponFunc                    func(couponID int64, coupon bigcommerce.Coupon) (*bigcommerce.Coupon, error)
+	DeleteCouponFunc                    func(couponID int64) error
+	GetAllCouponsFunc                   func(args map[string]string) ([]bigcommerce.Coupon, error)
+	GetCouponsFunc                      func(args map[string]string, page int) ([]bigcommerce.Coupon, bool, error)
+	GetCurrenciesFunc                   func() ([]bigcommerce.Currency, error)
+	GetCustomerAttributesFunc           func() ([]bigcommerce.CustomerAttribute, error)
+	GetCustomerAttributeByNameFunc      func(name string) (*bigcommerce.CustomerAttribute, error)
+	GetCustomerAttributeValuesFunc      func(customerID int64) ([]bigcommerce.CustomerAttributeValue, error)
+	UpsertCustomerAttributeValuesFunc   func(values []bigcommerce.CustomerAttributeValue) ([]bigcommerce.CustomerAttributeValue, error)
+	CustomerAttributesFunc              func(customerID int64) *bigcommerce.CustomerAttributes
+	GetAllCustomersFunc                 func(args map[string]string) ([]bigcommerce.Customer, error)
+	GetChannelCustomersFunc             func(filter bigcommerce.CustomerChannelFilter, args map[string]string) ([]bigcommerce.Customer, error)
+	GetCustomerGroupsFunc               func() ([]bigcommerce.CustomerGroup, error)
+	ValidateCredentialsFunc             func(email, password string) (int64, error)
+	CreateAccountFunc                   func(payload *bigcommerce.CreateAccountPayload) (*bigcommerce.Customer, error)
+	SaveAccountFunc                     func(payload *bigcommerce.SaveAccountPayload) (*bigcommerce.Customer, error)
+	CustomerSetFormFieldsFunc           func(customerID int64, formFields []bigcommerce.FormField) error
+	CustomerGetFormFieldsFunc           func(customerID int64) ([]bigcommerce.FormField, error)
+	GetCustomerByIDFunc                 func(customerID int64) (*bigcommerce.Customer, error)
+	GetCustomerByEmailFunc              func(email string) (*bigcommerce.Customer, error)
+	GetCustomsDeclarationFunc           func(orderID int64, shipment *bigcommerce.Shipment, opts bigcommerce.CustomsOptions) (*bigcommerce.CustomsDeclaration, error)
+	FindDuplicateSKUsFunc               func() ([]bigcommerce.DuplicateGroup, error)
+	FindDuplicateNamesFunc              func() ([]bigcommerce.DuplicateGroup, error)
+	GetFeedItemsFunc                    func(opts bigcommerce.FeedOptions) ([]bigcommerce.FeedItem, error)
+	RecordFixturesFunc                  func(resources []string, dir string) error
+	ComputeFulfillmentStatusFunc        func(orderID int64) (*bigcommerce.Fulfillment, error)
+	ShipmentsFromConfirmationFunc       func(c bigcommerce.FulfillmentConfirmation) ([]bigcommerce.Shipment, error)
+	CreateShipmentsFromConfirmationFunc func(c bigcommerce.FulfillmentConfirmation) ([]bigcommerce.Shipment, error)
+	CreateShipmentsFromMessageFunc      func(adapter bigcommerce.FulfillmentAdapter, message []byte) ([]bigcommerce.Shipment, error)
+	AdminGraphQLFunc                    func(query string, variables map[string]interface {
+	}, result interface {
+	}) error
+	GetProductTranslationFunc              func(productID, channelID int64, locale string) (*bigcommerce.ProductTranslation, error)
+	UpsertProductTranslationFunc           func(productID, channelID int64, locale string, translation bigcommerce.ProductTranslation) error
+	GetCategoryTranslationFunc             func(categoryID, channelID int64, locale string) (*bigcommerce.CategoryTranslation, error)
+	UpsertCategoryTranslationFunc          func(categoryID, channelID int64, locale string, translation bigcommerce.CategoryTranslation) error
+	CreateOrderShipmentIdempotentFunc      func(orderID int64, shipment bigcommerce.Shipment, key string) (*bigcommerce.Shipment, error)
+	CreateProductImageFileFunc             func(productID int64, image bigcommerce.Image, fileName string, file io.Reader) (*bigcommerce.Image, error)
+	CreateProductImageFromSourceFunc       func(productID int64, image bigcommerce.Image, src bigcommerce.ImageSource) (*bigcommerce.Image, error)
+	SetVariantImageFromSourceFunc          func(productID, variantID int64, src bigcommerce.ImageSource) (string, error)
+	GetMainThumbnailURLFunc                func(productID int64) (string, error)
+	SetVariantImageFunc                    func(productID, variantID int64, imageURL string) (string, error)
+	SetVariantImageFileFunc                func(productID, variantID int64, fileName string, file io.Reader) (string, error)
+	GetInventoryForLocationFunc            func(ID int64, filters map[string]string) (*bigcommerce.InventoryResource, error)
+	OrderShipmentsIteratorFunc             func(orderID int64, filters map[string]string) *bigcommerce.ShipmentsIterator
+	InventoryForLocationIteratorFunc       func(locationID int64, filters map[string]string) *bigcommerce.InventoryIterator
+	ProductsIteratorFunc                   func(args map[string]string) *bigcommerce.ProductsIterator
+	GetKitComponentsFunc                   func(productID int64) ([]bigcommerce.KitComponent, error)
+	SetKitComponentsFunc                   func(productID int64, components []bigcommerce.KitComponent) error
+	ExplodeOrderFunc                       func(orderID int64) ([]bigcommerce.ComponentPick, error)
+	AdjustKitInventoryFunc                 func(orderID, locationID int64, shipment *bigcommerce.Shipment) error
+	GetOrderShipmentsWithOptionsFunc       func(orderID int64, opts bigcommerce.ShipmentListOptions) ([]bigcommerce.Shipment, error)
+	GetInventoryForLocationWithOptionsFunc func(locationID int64, opts bigcommerce.InventoryListOptions) (*bigcommerce.InventoryResource, error)
+	GetLocationsFunc                       func(filters map[string]string) ([]bigcommerce.Location, error)
+	CreateLocationsFunc                    func(location *[]bigcommerce.Location) error
+	UpdateLocationFunc                     func(location *bigcommerce.Location) error
+	GetOrderMetafieldsFunc                 func(orderID int64, namespace string) ([]bigcommerce.Metafield, error)
+	CreateOrderMetafieldFunc               func(orderID int64, metafield bigcommerce.Metafield) (*bigcommerce.Metafield, error)
+	UpdateOrderMetafieldFunc               func(orderID int64, metafield bigcommerce.Metafield) (*bigcommerce.Metafield, error)
+	DeleteOrderMetafieldFunc               func(orderID, metafieldID int64) error
+	GetProductMetafieldsInNamespaceFunc    func(productID int64, namespace string) ([]bigcommerce.Metafield, error)
+	CreateProductMetafieldFunc             func(productID int64, metafield bigcommerce.Metafield) (*bigcommerce.Metafield, error)
+	UpdateProductMetafieldFunc             func(productID int64, metafield bigcommerce.Metafield) (*bigcommerce.Metafield, error)
+	DeleteProductMetafieldFunc             func(productID, metafieldID int64) error
+	FillProductsOpenGraphFunc              func(products []bigcommerce.Product) (int, error)
+	OrderTagsFunc                          func(orderID int64) *bigcommerce.OrderTags
+	GetOrderRefundsFunc                    func(orderID int64) ([]bigcommerce.OrderRefund, error)
+	GetOrderTransactionsFunc               func(orderID int64) ([]bigcommerce.OrderTransaction, error)
+	GetOrderTimelineFunc                   func(orderID int64) ([]bigcommerce.TimelineEvent, error)
+	GetOrdersFunc                          func(filters map[string]string) ([]bigcommerce.Order, error)
+	GetOrderFunc                           func(orderID int64) (*bigcommerce.Order, error)
+	UpdateOrderFunc                        func(orderId int64, order *bigcommerce.UpdateOrder) error
+	GetOrderProductsFunc                   func(orderID int64) ([]bigcommerce.OrderProduct, error)
+	GetOrderProductsPageFunc               func(orderID int64, page int) ([]bigcommerce.OrderProduct, bool, error)
+	GetOrderShippingAddressesFunc          func(orderID int64) ([]bigcommerce.OrderShippingAddress, error)
+	GetOrderCouponsFunc                    func(orderID int64) ([]bigcommerce.OrderCoupon, error)
+	GetOrderMessagesFunc                   func(orderID int64) ([]bigcommerce.OrderMessage, error)
+	GetOrderMessagesPageFunc               func(orderID int64, page int) ([]bigcommerce.OrderMessage, bool, error)
+	GetOrderShippingMarginFunc             func(order *bigcommerce.Order) (float64, error)
+	CreateWidgetTemplateFunc               func(pt *bigcommerce.PageBuilderTemplate) (*bigcommerce.PageBuilderTemplate, error)
+	GetWidgetTemplatesFunc                 func() ([]bigcommerce.PageBuilderTemplate, error)
+	DeleteWidgetTemplateFunc               func(uuid string) error
+	ReconcileOrderPaymentsFunc             func(orderID int64) (*bigcommerce.PaymentReconciliation, error)
+	PayOrderFunc                           func(orderID int64, payment bigcommerce.PaymentRequest) (*bigcommerce.PaymentResult, error)
+	CreatePaymentAccessTokenFunc           func(orderID int64) (string, error)
+	ProcessPaymentFunc                     func(accessToken string, payment bigcommerce.PaymentRequest) (*bigcommerce.PaymentResult, error)
+	GetCustomerStoredInstrumentsFunc       func(customerID int64) ([]bigcommerce.StoredInstrument, error)
+	GetOrderStoredInstrumentsFunc          func(orderID int64) ([]bigcommerce.StoredInstrument, error)
+	PayOrderWithStoredInstrumentFunc       func(orderID int64, paymentMethodID, token string) (*bigcommerce.PaymentResult, error)
+	CaptureOrderPaymentFunc                func(orderID int64) error
+	GetPickupMethodsFunc                   func() ([]bigcommerce.PickupMethod, error)
+	CreatePickupMethodsFunc                func(methods []bigcommerce.PickupMethod) ([]bigcommerce.PickupMethod, error)
+	UpdatePickupMethodsFunc                func(methods []bigcommerce.PickupMethod) ([]bigcommerce.PickupMethod, error)
+	DeletePickupMethodsFunc                func(ids []int64) error
+	GetPickupOptionsFunc                   func(request bigcommerce.PickupOptionsRequest) ([]bigcommerce.PickupOption, error)
+	GetOrderPickupsFunc                    func(orderIDs ...int64) ([]bigcommerce.Pickup, error)
+	CreateOrderPickupsFunc                 func(pickups []bigcommerce.Pickup) ([]bigcommerce.Pickup, error)
+	DeleteOrderPickupsFunc                 func(ids []int64) error
+	GetAllPostsFunc                        func() ([]bigcommerce.Post, error)
+	GetPostsFunc                           func(page int) ([]bigcommerce.Post, bool, error)
+	GetPriceListRecordsFunc                func(priceListID int64, args map[string]string) ([]bigcommerce.PriceListRecord, error)
+	UpsertPriceListRecordsFunc             func(priceListID int64, records []bigcommerce.PriceListRecord) error
+	RecalculatePriceListFunc               func(priceListID int64, rule bigcommerce.PricingRule, opts bigcommerce.RecalculateOptions) ([]bigcommerce.PriceChange, error)
+	ProductWorkflowFunc                    func() *bigcommerce.ProductWorkflow
+	GetAllProductsFunc                     func(args map[string]string) ([]bigcommerce.Product, error)
+	GetProductsFunc                        func(args map[string]string, page int) ([]bigcommerce.Product, bool, error)
+	GetProductByIDFunc                     func(productID int64) (*bigcommerce.Product, error)
+	GetProductMetafieldsFunc               func(productID int64) (map[string]bigcommerce.Metafield, error)
+	SetProductsSortOrderFunc               func(sortOrders map[int64]int) error
+	SetProductsFeaturedFunc                func(productIDs []int64, featured bool) error
+	CreatePromotionFunc                    func(promotion bigcommerce.Promotion) (*bigcommerce.Promotion, error)
+	CreateSegmentPromotionFunc             func(segmentName string, promotion bigcommerce.Promotion) (*bigcommerce.Promotion, error)
+	RateLimitStatusFunc                    func() bigcommerce.RateLimitStatus
+	RawFunc                                func(method, path string, body []byte) ([]byte, error)
+	SendJSONFunc                           func(method, path string, payload, result interface {
+	}) error
+	PatchFunc func(path string, payload, result interface {
+	}) error
+	GetSalesReportFunc                   func(opts bigcommerce.SalesReportOptions) (*bigcommerce.SalesReport, error)
+	WithFunc                             func(opts ...bigcommerce.RequestOption) *bigcommerce.Client
+	WithResponsesFunc                    func(responses *[]bigcommerce.Response) *bigcommerce.Client
+	ProductScheduleFunc                  func(changes ...bigcommerce.ScheduledChange) *bigcommerce.ProductSchedule
+	CreateScriptFunc                     func(s *bigcommerce.Script) (*bigcommerce.Script, error)
+	GetScriptByIDFunc                    func(uuid string) (*bigcommerce.Script, error)
+	GetScriptsFunc                       func() ([]bigcommerce.Script, error)
+	SeederFunc                           func(opts bigcommerce.SeedOptions) *bigcommerce.Seeder
+	CleanupSeededFunc                    func(r bigcommerce.SeededResources) (bigcommerce.SeededResources, error)
+	GetSegmentsFunc                      func() ([]bigcommerce.Segment, error)
+	GetSegmentByNameFunc                 func(name string) (*bigcommerce.Segment, error)
+	CreateSegmentFunc                    func(segment bigcommerce.Segment) (*bigcommerce.Segment, error)
+	EnsureSegmentFunc                    func(name, description string) (*bigcommerce.Segment, error)
+	CreateOrderShipmentFromLocationFunc  func(orderID, locationID int64, shipment bigcommerce.Shipment, opts bigcommerce.ShipmentLocationOptions) (*bigcommerce.Shipment, error)
+	GetShipmentLocationFunc              func(orderID, shipmentID int64) (int64, error)
+	GetOrderShipmentLocationsFunc        func(orderID int64) (map[int64]int64, error)
+	GetOrderShipmentsFunc                func(orderId int64, filters map[string]string) ([]bigcommerce.Shipment, error)
+	GetAllOrderShipmentsFunc             func(orderId int64) ([]bigcommerce.Shipment, error)
+	CreateOrderShipmentFunc              func(orderId int64, shipment bigcommerce.Shipment) (*bigcommerce.Shipment, error)
+	DeleteOrderShipmentsFunc             func(orderId int64) (bool, error)
+	DeleteOrderShipmentFunc              func(orderId int64, shipmentId int64) (bool, error)
+	GetOrderShipmentFunc                 func(orderId int64, shipmentId int64) (*bigcommerce.Shipment, error)
+	UpdateOrderShipmentFunc              func(orderId int64, shipment bigcommerce.Shipment) (*bigcommerce.Shipment, error)
+	CreateOrderShipmentAndCaptureFunc    func(orderId int64, shipment bigcommerce.Shipment, onCaptureFailure bigcommerce.CaptureFailurePolicy) (*bigcommerce.Shipment, error)
+	ResendShipmentNotificationFunc       func(orderID int64) error
+	GetStoreInfoFunc                     func() (bigcommerce.StoreInfo, error)
+	GetCustomerStoreCreditFunc           func(customerID int64) (float64, error)
+	SetCustomerStoreCreditFunc           func(customerID int64, amount float64, reason, reference string) error
+	AddCustomerStoreCreditFunc           func(customerID int64, amount float64, reason, reference string) (float64, error)
+	CreateStorefrontTokenFunc            func(channelID int64, expiresAt time.Time, allowedOrigins ...string) (*bigcommerce.StorefrontToken, error)
+	CreateCustomerImpersonationTokenFunc func(channelID int64, expiresAt time.Time) (*bigcommerce.StorefrontToken, error)
+	RevokeStorefrontTokenFunc            func(token string) error
+	StorefrontTokenProviderFunc          func(channelID int64, ttl time.Duration, allowedOrigins ...string) *bigcommerce.StorefrontTokenProvider
+	GetActiveThemeConfigFunc             func() (*bigcommerce.ThemeConfig, error)
+	GetThemesFunc                        func() ([]bigcommerce.Theme, error)
+	GetThemeConfigFunc                   func(uuid string) (*bigcommerce.ThemeConfig, error)
+	GetStoreUnitsFunc                    func() (bigcommerce.UnitSystem, error)
+	CreateVariantCombinationsFunc        func(productID int64, combinations []bigcommerce.VariantCombination) error
+	CreateProductVariantsFunc            func(productID int64, baseSku string, options []bigcommerce.VariantOption, skuPattern string) ([]bigcommerce.VariantCombination, error)
+	GetProductVariantsFunc               func(productID int64) ([]bigcommerce.Variant, error)
+	GetVariantFunc                       func(productID, variantID int64) (*bigcommerce.Variant, error)
+	GetWebhooksFunc                      func() ([]bigcommerce.Webhook, error)
+	CreateWebhookFunc                    func(scope, destination string, headers map[string]string) (int64, error)
+}
+
+var _ bigcommerce.ClientInterface = (*Client)(nil)
+
+func (c *Client) GetAbandonedCartEmailSettings(channelID int64) (*bigcommerce.AbandonedCartEmailSettings, error) {
+	if c.GetAbandonedCartEmailSettingsFunc == nil {
+		panic("mocks.Client.GetAbandonedCartEmailSettingsFunc is not set")
+	}
+	return c.GetAbandonedCartEmailSettingsFunc(channelID)
+}
+
+func (c *Client) UpdateAbandonedCartEmailSettings(channelID int64, settings bigcommerce.AbandonedCartEmailSettings) (*bigcommerce.AbandonedCartEmailSettings, error) {
+	if c.UpdateAbandonedCartEmailSettingsFunc == nil {
+		panic("mocks.Client.UpdateAbandonedCartEmailSettingsFunc is not set")
+	}
+	return c.UpdateAbandonedCartEmailSettingsFunc(channelID, settings)
+}
+
+func (c *Client) GetAbandonedCartEmails() ([]bigcommerce.AbandonedCartEmail, error) {
+	if c.GetAbandonedCartEmailsFunc == nil {
+		panic("mocks.Client.GetAbandonedCartEmailsFunc is not set")
+	}
+	return c.GetAbandonedCartEmailsFunc()
+}
+
+func (c *Client) GetAbandonedCartEmail(emailID int64) (*bigcommerce.AbandonedCartEmail, error) {
+	if c.GetAbandonedCartEmailFunc == nil {
+		panic("mocks.Client.GetAbandonedCartEmailFunc is not set")
+	}
+	return c.GetAbandonedCartEmailFunc(emailID)
+}
+
+func (c *Client) CreateAbandonedCartEmail(email bigcommerce.AbandonedCartEmail) (*bigcommerce.AbandonedCartEmail, error) {
+	if c.CreateAbandonedCartEmailFunc == nil {
+		panic("mocks.Client.CreateAbandonedCartEmailFunc is not set")
+	}
+	return c.CreateAbandonedCartEmailFunc(email)
+}
+
+func (c *Client) UpdateAbandonedCartEmail(email bigcommerce.AbandonedCartEmail) (*bigcommerce.AbandonedCartEmail, error) {
+	if c.UpdateAbandonedCartEmailFunc == nil {
+		panic("mocks.Client.UpdateAbandonedCartEmailFunc is not set")
+	}
+	return c.UpdateAbandonedCartEmailFunc(email)
+}
+
+func (c *Client) DeleteAbandonedCartEmail(emailID int64) error {
+	if c.DeleteAbandonedCartEmailFunc == nil {
+		panic("mocks.Client.DeleteAbandonedCartEmailFunc is not set")
+	}
+	return c.DeleteAbandonedCartEmailFunc(emailID)
+}
+
+func (c *Client) GetAddresses(customerID int64) ([]bigcommerce.Address, error) {
+	if c.GetAddressesFunc == nil {
+		panic("mocks.Client.GetAddressesFunc is not set")
+	}
+	return c.GetAddressesFunc(customerID)
+}
+
+func (c *Client) GetAddressPage(customerID int64, page int) ([]bigcommerce.Address, bool, error) {
+	if c.GetAddressPageFunc == nil {
+		panic("mocks.Client.GetAddressPageFunc is not set")
+	}
+	return c.GetAddressPageFunc(customerID, page)
+}
+
+func (c *Client) CreateAddress(customerID int64, address *bigcommerce.Address) (*bigcommerce.Address, error) {
+	if c.CreateAddressFunc == nil {
+		panic("mocks.Client.CreateAddressFunc is not set")
+	}
+	return c.CreateAddressFunc(customerID, address)
+}
+
+func (c *Client) UpdateAddress(customerID int64, address *bigcommerce.Address) (*bigcommerce.Address, error) {
+	if c.UpdateAddressFunc == nil {
+		panic("mocks.Client.UpdateAddressFunc is not set")
+	}
+	return c.UpdateAddressFunc(customerID, address)
+}
+
+func (c *Client) DeleteAddress(customerID, addressID int64) error {
+	if c.DeleteAddressFunc == nil {
+		panic("mocks.Client.DeleteAddressFunc is not set")
+	}
+	return c.DeleteAddressFunc(customerID, addressID)
+}
+
+func (c *Client) GetCountries() ([]bigcommerce.Country, error) {
+	if c.GetCountriesFunc == nil {
+		panic("mocks.Client.GetCountriesFunc is not set")
+	}
+	return c.GetCountriesFunc()
+}
+
+func (c *Client) GetCountryStates(countryID int64) ([]bigcommerce.CountryState, error) {
+	if c.GetCountryStatesFunc == nil {
+		panic("mocks.Client.GetCountryStatesFunc is not set")
+	}
+	return c.GetCountryStatesFunc(countryID)
+}
+
+func (c *Client) BackfillAddressCountries(opts bigcommerce.BackfillOptions) ([]bigcommerce.AddressFix, error) {
+	if c.BackfillAddressCountriesFunc == nil {
+		panic("mocks.Client.BackfillAddressCountriesFunc is not set")
+	}
+	return c.BackfillAddressCountriesFunc(opts)
+}
+
+func (c *Client) AdjustInventoryRelative(adjustment *bigcommerce.Adjustment) error {
+	if c.AdjustInventoryRelativeFunc == nil {
+		panic("mocks.Client.AdjustInventoryRelativeFunc is not set")
+	}
+	return c.AdjustInventoryRelativeFunc(adjustment)
+}
+
+func (c *Client) AdjustInventoryAbsolute(adjustment *bigcommerce.Adjustment) error {
+	if c.AdjustInventoryAbsoluteFunc == nil {
+		panic("mocks.Client.AdjustInventoryAbsoluteFunc is not set")
+	}
+	return c.AdjustInventoryAbsoluteFunc(adjustment)
+}
+
+func (c *Client) AnonymizeOrder(orderID int64) error {
+	if c.AnonymizeOrderFunc == nil {
+		panic("mocks.Client.AnonymizeOrderFunc is not set")
+	}
+	return c.AnonymizeOrderFunc(orderID)
+}
+
+func (c *Client) CheckBackorders(orderID, locationID int64, policy bigcommerce.BackorderPolicy) (*bigcommerce.BackorderDecision, error) {
+	if c.CheckBackordersFunc == nil {
+		panic("mocks.Client.CheckBackordersFunc is not set")
+	}
+	return c.CheckBackordersFunc(orderID, locationID, policy)
+}
+
+func (c *Client) ApplyBackorderPolicy(orderID, locationID int64, policy bigcommerce.BackorderPolicy) (*bigcommerce.BackorderDecision, error) {
+	if c.ApplyBackorderPolicyFunc == nil {
+		panic("mocks.Client.ApplyBackorderPolicyFunc is not set")
+	}
+	return c.ApplyBackorderPolicyFunc(orderID, locationID, policy)
+}
+
+func (c *Client) GetAllBrands(args map[string]string) ([]bigcommerce.Brand, error) {
+	if c.GetAllBrandsFunc == nil {
+		panic("mocks.Client.GetAllBrandsFunc is not set")
+	}
+	return c.GetAllBrandsFunc(args)
+}
+
+func (c *Client) GetBrands(args map[string]string, page int) ([]bigcommerce.Brand, bool, error) {
+	if c.GetBrandsFunc == nil {
+		panic("mocks.Client.GetBrandsFunc is not set")
+	}
+	return c.GetBrandsFunc(args, page)
+}
+
+func (c *Client) CreateCart(items []bigcommerce.LineItem) (*bigcommerce.Cart, error) {
+	if c.CreateCartFunc == nil {
+		panic("mocks.Client.CreateCartFunc is not set")
+	}
+	return c.CreateCartFunc(items)
+}
+
+func (c *Client) CreateCartWithCustomItems(items []bigcommerce.LineItem, customItems []bigcommerce.LineItem) (*bigcommerce.Cart, error) {
+	if c.CreateCartWithCustomItemsFunc == nil {
+		panic("mocks.Client.CreateCartWithCustomItemsFunc is not set")
+	}
+	return c.CreateCartWithCustomItemsFunc(items, customItems)
+}
+
+func (c *Client) GetCart(cartID string) (*bigcommerce.Cart, error) {
+	if c.GetCartFunc == nil {
+		panic("mocks.Client.GetCartFunc is not set")
+	}
+	return c.GetCartFunc(cartID)
+}
+
+func (c *Client) CartAddItems(cartID string, items []bigcommerce.LineItem) (*bigcommerce.Cart, error) {
+	if c.CartAddItemsFunc == nil {
+		panic("mocks.Client.CartAddItemsFunc is not set")
+	}
+	return c.CartAddItemsFunc(cartID, items)
+}
+
+func (c *Client) CartAddCustomItems(cartID string, customItems []bigcommerce.LineItem) (*bigcommerce.Cart, error) {
+	if c.CartAddCustomItemsFunc == nil {
+		panic("mocks.Client.CartAddCustomItemsFunc is not set")
+	}
+	return c.CartAddCustomItemsFunc(cartID, customItems)
+}
+
+func (c *Client) CartEditItem(cartID string, item bigcommerce.LineItem) (*bigcommerce.Cart, error) {
+	if c.CartEditItemFunc == nil {
+		panic("mocks.Client.CartEditItemFunc is not set")
+	}
+	return c.CartEditItemFunc(cartID, item)
+}
+
+func (c *Client) CartDeleteItem(cartID string, item bigcommerce.LineItem) (*bigcommerce.Cart, error) {
+	if c.CartDeleteItemFunc == nil {
+		panic("mocks.Client.CartDeleteItemFunc is not set")
+	}
+	return c.CartDeleteItemFunc(cartID, item)
+}
+
+func (c *Client) CartUpdateCustomerID(cartID, customerID string) (*bigcommerce.Cart, error) {
+	if c.CartUpdateCustomerIDFunc == nil {
+		panic("mocks.Client.CartUpdateCustomerIDFunc is not set")
+	}
+	return c.CartUpdateCustomerIDFunc(cartID, customerID)
+}
+
+func (c *Client) DeleteCart(cartID string) error {
+	if c.DeleteCartFunc == nil {
+		panic("mocks.Client.DeleteCartFunc is not set")
+	}
+	return c.DeleteCartFunc(cartID)
+}
+
+func (c *Client) PackOrder(orderID int64, packer *bigcommerce.CartonPacker) ([]bigcommerce.Carton, error) {
+	if c.PackOrderFunc == nil {
+		panic("mocks.Client.PackOrderFunc is not set")
+	}
+	return c.PackOrderFunc(orderID, packer)
+}
+
+func (c *Client) GetAllCategories(args map[string]string) ([]bigcommerce.Category, error) {
+	if c.GetAllCategoriesFunc == nil {
+		panic("mocks.Client.GetAllCategoriesFunc is not set")
+	}
+	return c.GetAllCategoriesFunc(args)
+}
+
+func (c *Client) GetCategories(args map[string]string, page int) ([]bigcommerce.Category, bool, error) {
+	if c.GetCategoriesFunc == nil {
+		panic("mocks.Client.GetCategoriesFunc is not set")
+	}
+	return c.GetCategoriesFunc(args, page)
+}
+
+func (c *Client) ImportCategoryTree(tree []*bigcommerce.CategoryNode, parentID int64) (map[*bigcommerce.CategoryNode]int64, error) {
+	if c.ImportCategoryTreeFunc == nil {
+		panic("mocks.Client.ImportCategoryTreeFunc is not set")
+	}
+	return c.ImportCategoryTreeFunc(tree, parentID)
+}
+
+func (c *Client) GetCategoryPath(categoryID int64) ([]bigcommerce.CategoryCrumb, error) {
+	if c.GetCategoryPathFunc == nil {
+		panic("mocks.Client.GetCategoryPathFunc is not set")
+	}
+	return c.GetCategoryPathFunc(categoryID)
+}
+
+func (c *Client) ResolveCategoryByPath(path string) (*bigcommerce.Category, error) {
+	if c.ResolveCategoryByPathFunc == nil {
+		panic("mocks.Client.ResolveCategoryByPathFunc is not set")
+	}
+	return c.ResolveCategoryByPathFunc(path)
+}
+
+func (c *Client) ResetCategoryCache() {
+	if c.ResetCategoryCacheFunc == nil {
+		panic("mocks.Client.ResetCategoryCacheFunc is not set")
+	}
+	c.ResetCategoryCacheFunc()
+}
+
+func (c *Client) ListProductsModifiedSince(t time.Time, args map[string]string) ([]bigcommerce.Product, error) {
+	if c.ListProductsModifiedSinceFunc == nil {
+		panic("mocks.Client.ListProductsModifiedSinceFunc is not set")
+	}
+	return c.ListProductsModifiedSinceFunc(t, args)
+}
+
+func (c *Client) ListVariantsModifiedSince(t time.Time) ([]bigcommerce.Variant, error) {
+	if c.ListVariantsModifiedSinceFunc == nil {
+		panic("mocks.Client.ListVariantsModifiedSinceFunc is not set")
+	}
+	return c.ListVariantsModifiedSinceFunc(t)
+}
+
+func (c *Client) GetAllChannels() ([]bigcommerce.Channel, error) {
+	if c.GetAllChannelsFunc == nil {
+		panic("mocks.Client.GetAllChannelsFunc is not set")
+	}
+	return c.GetAllChannelsFunc()
+}
+
+func (c *Client) GetChannels(page int) ([]bigcommerce.Channel, bool, error) {
+	if c.GetChannelsFunc == nil {
+		panic("mocks.Client.GetChannelsFunc is not set")
+	}
+	return c.GetChannelsFunc(page)
+}
+
+func (c *Client) UpdateChannelStatus(channelID int64, status string) (*bigcommerce.Channel, error) {
+	if c.UpdateChannelStatusFunc == nil {
+		panic("mocks.Client.UpdateChannelStatusFunc is not set")
+	}
+	return c.UpdateChannelStatusFunc(channelID, status)
+}
+
+func (c *Client) GetStorefrontStatusSettings(channelID int64) (*bigcommerce.StorefrontStatusSettings, error) {
+	if c.GetStorefrontStatusSettingsFunc == nil {
+		panic("mocks.Client.GetStorefrontStatusSettingsFunc is not set")
+	}
+	return c.GetStorefrontStatusSettingsFunc(channelID)
+}
+
+func (c *Client) UpdateStorefrontStatusSettings(channelID int64, settings bigcommerce.StorefrontStatusSettings) error {
+	if c.UpdateStorefrontStatusSettingsFunc == nil {
+		panic("mocks.Client.UpdateStorefrontStatusSettingsFunc is not set")
+	}
+	return c.UpdateStorefrontStatusSettingsFunc(channelID, settings)
+}
+
+func (c *Client) SetChannelMaintenance(channelID int64, message string) error {
+	if c.SetChannelMaintenanceFunc == nil {
+		panic("mocks.Client.SetChannelMaintenanceFunc is not set")
+	}
+	return c.SetChannelMaintenanceFunc(channelID, message)
+}
+
+func (c *Client) SetChannelActive(channelID int64) error {
+	if c.SetChannelActiveFunc == nil {
+		panic("mocks.Client.SetChannelActiveFunc is not set")
+	}
+	return c.SetChannelActiveFunc(channelID)
+}
+
+func (c *Client) GetCheckout(checkoutID string) (*bigcommerce.Checkout, error) {
+	if c.GetCheckoutFunc == nil {
+		panic("mocks.Client.GetCheckoutFunc is not set")
+	}
+	return c.GetCheckoutFunc(checkoutID)
+}
+
+func (c *Client) SetCheckoutBillingAddress(checkoutID string, address bigcommerce.CheckoutAddress) (*bigcommerce.Checkout, error) {
+	if c.SetCheckoutBillingAddressFunc == nil {
+		panic("mocks.Client.SetCheckoutBillingAddressFunc is not set")
+	}
+	return c.SetCheckoutBillingAddressFunc(checkoutID, address)
+}
+
+func (c *Client) AddCheckoutConsignments(checkoutID string, consignments []bigcommerce.ConsignmentRequest) (*bigcommerce.Checkout, error) {
+	if c.AddCheckoutConsignmentsFunc == nil {
+		panic("mocks.Client.AddCheckoutConsignmentsFunc is not set")
+	}
+	return c.AddCheckoutConsignmentsFunc(checkoutID, consignments)
+}
+
+func (c *Client) CreateCheckoutOrder(checkoutID string) (int64, error) {
+	if c.CreateCheckoutOrderFunc == nil {
+		panic("mocks.Client.CreateCheckoutOrderFunc is not set")
+	}
+	return c.CreateCheckoutOrderFunc(checkoutID)
+}
+
+func (c *Client) CompleteCheckout(cartID string, billing bigcommerce.CheckoutAddress, consignments []bigcommerce.ConsignmentRequest, payment *bigcommerce.PaymentRequest) (int64, error) {
+	if c.CompleteCheckoutFunc == nil {
+		panic("mocks.Client.CompleteCheckoutFunc is not set")
+	}
+	return c.CompleteCheckoutFunc(cartID, billing, consignments, payment)
+}
+
+func (c *Client) GetConsignmentShippingOptions(checkoutID, consignmentID string) ([]bigcommerce.ShippingOption, error) {
+	if c.GetConsignmentShippingOptionsFunc == nil {
+		panic("mocks.Client.GetConsignmentShippingOptionsFunc is not set")
+	}
+	return c.GetConsignmentShippingOptionsFunc(checkoutID, consignmentID)
+}
+
+func (c *Client) UpdateConsignmentShippingOption(checkoutID, consignmentID, shippingOptionID string) (*bigcommerce.Checkout, error) {
+	if c.UpdateConsignmentShippingOptionFunc == nil {
+		panic("mocks.Client.UpdateConsignmentShippingOptionFunc is not set")
+	}
+	return c.UpdateConsignmentShippingOptionFunc(checkoutID, consignmentID, shippingOptionID)
+}
+
+func (c *Client) ApplyCouponToCheckout(checkoutID, couponCode string) (*bigcommerce.Checkout, error) {
+	if c.ApplyCouponToCheckoutFunc == nil {
+		panic("mocks.Client.ApplyCouponToCheckoutFunc is not set")
+	}
+	return c.ApplyCouponToCheckoutFunc(checkoutID, couponCode)
+}
+
+func (c *Client) RemoveCoupon(checkoutID, couponCode string) (*bigcommerce.Checkout, error) {
+	if c.RemoveCouponFunc == nil {
+		panic("mocks.Client.RemoveCouponFunc is not set")
+	}
+	return c.RemoveCouponFunc(checkoutID, couponCode)
+}
+
+func (c *Client) ApplyGiftCertificateToCheckout(checkoutID, giftCertificateCode string) (*bigcommerce.Checkout, error) {
+	if c.ApplyGiftCertificateToCheckoutFunc == nil {
+		panic("mocks.Client.ApplyGiftCertificateToCheckoutFunc is not set")
+	}
+	return c.ApplyGiftCertificateToCheckoutFunc(checkoutID, giftCertificateCode)
+}
+
+func (c *Client) RemoveGiftCertificateFromCheckout(checkoutID, giftCertificateCode string) (*bigcommerce.Checkout, error) {
+	if c.RemoveGiftCertificateFromCheckoutFunc == nil {
+		panic("mocks.Client.RemoveGiftCertificateFromCheckoutFunc is not set")
+	}
+	return c.RemoveGiftCertificateFromCheckoutFunc(checkoutID, giftCertificateCode)
+}
+
+func (c *Client) WithContext(ctx context.Context) *bigcommerce.Client {
+	if c.WithContextFunc == nil {
+		panic("mocks.Client.WithContextFunc is not set")
+	}
+	return c.WithContextFunc(ctx)
+}
+
+func (c *Client) WithRawPayload(raw *[]json.RawMessage) *bigcommerce.Client {
+	if c.WithRawPayloadFunc == nil {
+		panic("mocks.Client.WithRawPayloadFunc is not set")
+	}
+	return c.WithRawPayloadFunc(raw)
+}
+
+func (c *Client) UpdateProductIfUnmodifiedSince(productID int64, since time.Time, updates map[string]interface {
+}) error {
+	if c.UpdateProductIfUnmodifiedSinceFunc == nil {
+		panic("mocks.Client.UpdateProductIfUnmodifiedSinceFunc is not set")
+	}
+	return c.UpdateProductIfUnmodifiedSinceFunc(productID, since, updates)
+}
+
+func (c *Client) UpdateOrderIfUnmodifiedSince(orderID int64, since time.Time, order *bigcommerce.UpdateOrder) error {
+	if c.UpdateOrderIfUnmodifiedSinceFunc == nil {
+		panic("mocks.Client.UpdateOrderIfUnmodifiedSinceFunc is not set")
+	}
+	return c.UpdateOrderIfUnmodifiedSinceFunc(orderID, since, order)
+}
+
+func (c *Client) SaveAccountIfUnmodifiedSince(since time.Time, payload *bigcommerce.SaveAccountPayload) (*bigcommerce.Customer, error) {
+	if c.SaveAccountIfUnmodifiedSinceFunc == nil {
+		panic("mocks.Client.SaveAccountIfUnmodifiedSinceFunc is not set")
+	}
+	return c.SaveAccountIfUnmodifiedSinceFunc(since, payload)
+}
+
+func (c *Client) RecordCartConvertedWebhook(body []byte) (*bigcommerce.CartConversion, error) {
+	if c.RecordCartConvertedWebhookFunc == nil {
+		panic("mocks.Client.RecordCartConvertedWebhookFunc is not set")
+	}
+	return c.RecordCartConvertedWebhookFunc(body)
+}
+
+func (c *Client) CreateCoupon(coupon bigcommerce.Coupon) (*bigcommerce.Coupon, error) {
+	if c.CreateCouponFunc == nil {
+		panic("mocks.Client.CreateCouponFunc is not set")
+	}
+	return c.CreateCouponFunc(coupon)
+}
+
+func (c *Client) GetCoupon(couponID int64) (*bigcommerce.Coupon, error) {
+	if c.GetCouponFunc == nil {
+		panic("mocks.Client.GetCouponFunc is not set")
+	}
+	return c.GetCouponFunc(couponID)
+}
+
+func (c *Client) UpdateCoupon(couponID int64, coupon bigcommerce.Coupon) (*bigcommerce.Coupon, error) {
+	if c.UpdateCouponFunc == nil {
+		panic("mocks.Client.UpdateCouponFunc is not set")
+	}
+	return c.UpdateCouponFunc(couponID, coupon)
+}
+
+func (c *Client) DeleteCoupon(couponID int64) error {
+	if c.DeleteCouponFunc == nil {
+		panic("mocks.Client.DeleteCouponFunc is not set")
+	}
+	return c.DeleteCouponFunc(couponID)
+}
+
+func (c *Client) GetAllCoupons(args map[string]string) ([]bigcommerce.Coupon, error) {
+	if c.GetAllCouponsFunc == nil {
+		panic("mocks.Client.GetAllCouponsFunc is not set")
+	}
+	return c.GetAllCouponsFunc(args)
+}
+
+func (c *Client) GetCoupons(args map[string]string, page int) ([]bigcommerce.Coupon, bool, error) {
+	if c.GetCouponsFunc == nil {
+		panic("mocks.Client.GetCouponsFunc is not set")
+	}
+	return c.GetCouponsFunc(args, page)
+}
+
+func (c *Client) GetCurrencies() ([]bigcommerce.Currency, error) {
+	if c.GetCurrenciesFunc == nil {
+		panic("mocks.Client.GetCurrenciesFunc is not set")
+	}
+	return c.GetCurrenciesFunc()
+}
+
+func (c *Client) GetCustomerAttributes() ([]bigcommerce.CustomerAttribute, error) {
+	if c.GetCustomerAttributesFunc == nil {
+		panic("mocks.Client.GetCustomerAttributesFunc is not set")
+	}
+	return c.GetCustomerAttributesFunc()
+}
+
+func (c *Client) GetCustomerAttributeByName(name string) (*bigcommerce.CustomerAttribute, error) {
+	if c.GetCustomerAttributeByNameFunc == nil {
+		panic("mocks.Client.GetCustomerAttributeByNameFunc is not set")
+	}
+	return c.GetCustomerAttributeByNameFunc(name)
+}
+
+func (c *Client) GetCustomerAttributeValues(customerID int64) ([]bigcommerce.CustomerAttributeValue, error) {
+	if c.GetCustomerAttributeValuesFunc == nil {
+		panic("mocks.Client.GetCustomerAttributeValuesFunc is not set")
+	}
+	return c.GetCustomerAttributeValuesFunc(customerID)
+}
+
+func (c *Client) UpsertCustomerAttributeValues(values []bigcommerce.CustomerAttributeValue) ([]bigcommerce.CustomerAttributeValue, error) {
+	if c.UpsertCustomerAttributeValuesFunc == nil {
+		panic("mocks.Client.UpsertCustomerAttributeValuesFunc is not set")
+	}
+	return c.UpsertCustomerAttributeValuesFunc(values)
+}
+
+func (c *Client) CustomerAttributes(customerID int64) *bigcommerce.CustomerAttributes {
+	if c.CustomerAttributesFunc == nil {
+		panic("mocks.Client.CustomerAttributesFunc is not set")
+	}
+	return c.CustomerAttributesFunc(customerID)
+}
+
+func (c *Client) GetAllCustomers(args map[string]string) ([]bigcommerce.Customer, error) {
+	if c.GetAllCustomersFunc == nil {
+		panic("mocks.Client.GetAllCustomersFunc is not set")
+	}
+	return c.GetAllCustomersFunc(args)
+}
+
+func (c *Client) GetChannelCustomers(filter bigcommerce.CustomerChannelFilter, args map[string]string) ([]bigcommerce.Customer, error) {
+	if c.GetChannelCustomersFunc == nil {
+		panic("mocks.Client.GetChannelCustomersFunc is not set")
+	}
+	return c.GetChannelCustomersFunc(filter, args)
+}
+
+func (c *Client) GetCustomerGroups() ([]bigcommerce.CustomerGroup, error) {
+	if c.GetCustomerGroupsFunc == nil {
+		panic("mocks.Client.GetCustomerGroupsFunc is not set")
+	}
+	return c.GetCustomerGroupsFunc()
+}
+
+func (c *Client) ValidateCredentials(email, password string) (int64, error) {
+	if c.ValidateCredentialsFunc == nil {
+		panic("mocks.Client.ValidateCredentialsFunc is not set")
+	}
+	return c.ValidateCredentialsFunc(email, password)
+}
+
+func (c *Client) CreateAccount(payload *bigcommerce.CreateAccountPayload) (*bigcommerce.Customer, error) {
+	if c.CreateAccountFunc == nil {
+		panic("mocks.Client.CreateAccountFunc is not set")
+	}
+	return c.CreateAccountFunc(payload)
+}
+
+func (c *Client) SaveAccount(payload *bigcommerce.SaveAccountPayload) (*bigcommerce.Customer, error) {
+	if c.SaveAccountFunc == nil {
+		panic("mocks.Client.SaveAccountFunc is not set")
+	}
+	return c.SaveAccountFunc(payload)
+}
+
+func (c *Client) CustomerSetFormFields(customerID int64, formFields []bigcommerce.FormField) error {
+	if c.CustomerSetFormFieldsFunc == nil {
+		panic("mocks.Client.CustomerSetFormFieldsFunc is not set")
+	}
+	return c.CustomerSetFormFieldsFunc(customerID, formFields)
+}
+
+func (c *Client) CustomerGetFormFields(customerID int64) ([]bigcommerce.FormField, error) {
+	if c.CustomerGetFormFieldsFunc == nil {
+		panic("mocks.Client.CustomerGetFormFieldsFunc is not set")
+	}
+	return c.CustomerGetFormFieldsFunc(customerID)
+}
+
+func (c *Client) GetCustomerByID(customerID int64) (*bigcommerce.Customer, error) {
+	if c.GetCustomerByIDFunc == nil {
+		panic("mocks.Client.GetCustomerByIDFunc is not set")
+	}
+	return c.GetCustomerByIDFunc(customerID)
+}
+
+func (c *Client) GetCustomerByEmail(email string) (*bigcommerce.Customer, error) {
+	if c.GetCustomerByEmailFunc == nil {
+		panic("mocks.Client.GetCustomerByEmailFunc is not set")
+	}
+	return c.GetCustomerByEmailFunc(email)
+}
+
+func (c *Client) GetCustomsDeclaration(orderID int64, shipment *bigcommerce.Shipment, opts bigcommerce.CustomsOptions) (*bigcommerce.CustomsDeclaration, error) {
+	if c.GetCustomsDeclarationFunc == nil {
+		panic("mocks.Client.GetCustomsDeclarationFunc is not set")
+	}
+	return c.GetCustomsDeclarationFunc(orderID, shipment, opts)
+}
+
+func (c *Client) FindDuplicateSKUs() ([]bigcommerce.DuplicateGroup, error) {
+	if c.FindDuplicateSKUsFunc == nil {
+		panic("mocks.Client.FindDuplicateSKUsFunc is not set")
+	}
+	return c.FindDuplicateSKUsFunc()
+}
+
+func (c *Client) FindDuplicateNames() ([]bigcommerce.DuplicateGroup, error) {
+	if c.FindDuplicateNamesFunc == nil {
+		panic("mocks.Client.FindDuplicateNamesFunc is not set")
+	}
+	return c.FindDuplicateNamesFunc()
+}
+
+func (c *Client) GetFeedItems(opts bigcommerce.FeedOptions) ([]bigcommerce.FeedItem, error) {
+	if c.GetFeedItemsFunc == nil {
+		panic("mocks.Client.GetFeedItemsFunc is not set")
+	}
+	return c.GetFeedItemsFunc(opts)
+}
+
+func (c *Client) RecordFixtures(resources []string, dir string) error {
+	if c.RecordFixturesFunc == nil {
+		panic("mocks.Client.RecordFixturesFunc is not set")
+	}
+	return c.RecordFixturesFunc(resources, dir)
+}
+
+func (c *Client) ComputeFulfillmentStatus(orderID int64) (*bigcommerce.Fulfillment, error) {
+	if c.ComputeFulfillmentStatusFunc == nil {
+		panic("mocks.Client.ComputeFulfillmentStatusFunc is not set")
+	}
+	return c.ComputeFulfillmentStatusFunc(orderID)
+}
+
+func (c *Client) ShipmentsFromConfirmation(p0 bigcommerce.FulfillmentConfirmation) ([]bigcommerce.Shipment, error) {
+	if c.ShipmentsFromConfirmationFunc == nil {
+		panic("mocks.Client.ShipmentsFromConfirmationFunc is not set")
+	}
+	return c.ShipmentsFromConfirmationFunc(p0)
+}
+
+func (c *Client) CreateShipmentsFromConfirmation(p0 bigcommerce.FulfillmentConfirmation) ([]bigcommerce.Shipment, error) {
+	if c.CreateShipmentsFromConfirmationFunc == nil {
+		panic("mocks.Client.CreateShipmentsFromConfirmationFunc is not set")
+	}
+	return c.CreateShipmentsFromConfirmationFunc(p0)
+}
+
+func (c *Client) CreateShipmentsFromMessage(adapter bigcommerce.FulfillmentAdapter, message []byte) ([]bigcommerce.Shipment, error) {
+	if c.CreateShipmentsFromMessageFunc == nil {
+		panic("mocks.Client.CreateShipmentsFromMessageFunc is not set")
+	}
+	return c.CreateShipmentsFromMessageFunc(adapter, message)
+}
+
+func (c *Client) AdminGraphQL(query string, variables map[string]interface {
+}, result interface {
+}) error {
+	if c.AdminGraphQLFunc == nil {
+		panic("mocks.Client.AdminGraphQLFunc is not set")
+	}
+	return c.AdminGraphQLFunc(query, variables, result)
+}
+
+func (c *Client) GetProductTranslation(productID, channelID int64, locale string) (*bigcommerce.ProductTranslation, error) {
+	if c.GetProductTranslationFunc == nil {
+		panic("mocks.Client.GetProductTranslationFunc is not set")
+	}
+	return c.GetProductTranslationFunc(productID, channelID, locale)
+}
+
+func (c *Client) UpsertProductTranslation(productID, channelID int64, locale string, translation bigcommerce.ProductTranslation) error {
+	if c.UpsertProductTranslationFunc == nil {
+		panic("mocks.Client.UpsertProductTranslationFunc is not set")
+	}
+	return c.UpsertProductTranslationFunc(productID, channelID, locale, translation)
+}
+
+func (c *Client) GetCategoryTranslation(categoryID, channelID int64, locale string) (*bigcommerce.CategoryTranslation, error) {
+	if c.GetCategoryTranslationFunc == nil {
+		panic("mocks.Client.GetCategoryTranslationFunc is not set")
+	}
+	return c.GetCategoryTranslationFunc(categoryID, channelID, locale)
+}
+
+func (c *Client) UpsertCategoryTranslation(categoryID, channelID int64, locale string, translation bigcommerce.CategoryTranslation) error {
+	if c.UpsertCategoryTranslationFunc == nil {
+		panic("mocks.Client.UpsertCategoryTranslationFunc is not set")
+	}
+	return c.UpsertCategoryTranslationFunc(categoryID, channelID, locale, translation)
+}
+
+func (c *Client) CreateOrderShipmentIdempotent(orderID int64, shipment bigcommerce.Shipment, key string) (*bigcommerce.Shipment, error) {
+	if c.CreateOrderShipmentIdempotentFunc == nil {
+		panic("mocks.Client.CreateOrderShipmentIdempotentFunc is not set")
+	}
+	return c.CreateOrderShipmentIdempotentFunc(orderID, shipment, key)
+}
+
+func (c *Client) CreateProductImageFile(productID int64, image bigcommerce.Image, fileName string, file io.Reader) (*bigcommerce.Image, error) {
+	if c.CreateProductImageFileFunc == nil {
+		panic("mocks.Client.CreateProductImageFileFunc is not set")
+	}
+	return c.CreateProductImageFileFunc(productID, image, fileName, file)
+}
+
+func (c *Client) CreateProductImageFromSource(productID int64, image bigcommerce.Image, src bigcommerce.ImageSource) (*bigcommerce.Image, error) {
+	if c.CreateProductImageFromSourceFunc == nil {
+		panic("mocks.Client.CreateProductImageFromSourceFunc is not set")
+	}
+	return c.CreateProductImageFromSourceFunc(productID, image, src)
+}
+
+func (c *Client) SetVariantImageFromSource(productID, variantID int64, src bigcommerce.ImageSource) (string, error) {
+	if c.SetVariantImageFromSourceFunc == nil {
+		panic("mocks.Client.SetVariantImageFromSourceFunc is not set")
+	}
+	return c.SetVariantImageFromSourceFunc(productID, variantID, src)
+}
+
+func (c *Client) GetMainThumbnailURL(productID int64) (string, error) {
+	if c.GetMainThumbnailURLFunc == nil {
+		panic("mocks.Client.GetMainThumbnailURLFunc is not set")
+	}
+	return c.GetMainThumbnailURLFunc(productID)
+}
+
+func (c *Client) SetVariantImage(productID, variantID int64, imageURL string) (string, error) {
+	if c.SetVariantImageFunc == nil {
+		panic("mocks.Client.SetVariantImageFunc is not set")
+	}
+	return c.SetVariantImageFunc(productID, variantID, imageURL)
+}
+
+func (c *Client) SetVariantImageFile(productID, variantID int64, fileName string, file io.Reader) (string, error) {
+	if c.SetVariantImageFileFunc == nil {
+		panic("mocks.Client.SetVariantImageFileFunc is not set")
+	}
+	return c.SetVariantImageFileFunc(productID, variantID, fileName, file)
+}
+
+func (c *Client) GetInventoryForLocation(ID int64, filters map[string]string) (*bigcommerce.InventoryResource, error) {
+	if c.GetInventoryForLocationFunc == nil {
+		panic("mocks.Client.GetInventoryForLocationFunc is not set")
+	}
+	return c.GetInventoryForLocationFunc(ID, filters)
+}
+
+func (c *Client) OrderShipmentsIterator(orderID int64, filters map[string]string) *bigcommerce.ShipmentsIterator {
+	if c.OrderShipmentsIteratorFunc == nil {
+		panic("mocks.Client.OrderShipmentsIteratorFunc is not set")
+	}
+	return c.OrderShipmentsIteratorFunc(orderID, filters)
+}
+
+func (c *Client) InventoryForLocationIterator(locationID int64, filters map[string]string) *bigcommerce.InventoryIterator {
+	if c.InventoryForLocationIteratorFunc == nil {
+		panic("mocks.Client.InventoryForLocationIteratorFunc is not set")
+	}
+	return c.InventoryForLocationIteratorFunc(locationID, filters)
+}
+
+func (c *Client) ProductsIterator(args map[string]string) *bigcommerce.ProductsIterator {
+	if c.ProductsIteratorFunc == nil {
+		panic("mocks.Client.ProductsIteratorFunc is not set")
+	}
+	return c.ProductsIteratorFunc(args)
+}
+
+func (c *Client) GetKitComponents(productID int64) ([]bigcommerce.KitComponent, error) {
+	if c.GetKitComponentsFunc == nil {
+		panic("mocks.Client.GetKitComponentsFunc is not set")
+	}
+	return c.GetKitComponentsFunc(productID)
+}
+
+func (c *Client) SetKitComponents(productID int64, components []bigcommerce.KitComponent) error {
+	if c.SetKitComponentsFunc == nil {
+		panic("mocks.Client.SetKitComponentsFunc is not set")
+	}
+	return c.SetKitComponentsFunc(productID, components)
+}
+
+func (c *Client) ExplodeOrder(orderID int64) ([]bigcommerce.ComponentPick, error) {
+	if c.ExplodeOrderFunc == nil {
+		panic("mocks.Client.ExplodeOrderFunc is not set")
+	}
+	return c.ExplodeOrderFunc(orderID)
+}
+
+func (c *Client) AdjustKitInventory(orderID, locationID int64, shipment *bigcommerce.Shipment) error {
+	if c.AdjustKitInventoryFunc == nil {
+		panic("mocks.Client.AdjustKitInventoryFunc is not set")
+	}
+	return c.AdjustKitInventoryFunc(orderID, locationID, shipment)
+}
+
+func (c *Client) GetOrderShipmentsWithOptions(orderID int64, opts bigcommerce.ShipmentListOptions) ([]bigcommerce.Shipment, error) {
+	if c.GetOrderShipmentsWithOptionsFunc == nil {
+		panic("mocks.Client.GetOrderShipmentsWithOptionsFunc is not set")
+	}
+	return c.GetOrderShipmentsWithOptionsFunc(orderID, opts)
+}
+
+func (c *Client) GetInventoryForLocationWithOptions(locationID int64, opts bigcommerce.InventoryListOptions) (*bigcommerce.InventoryResource, error) {
+	if c.GetInventoryForLocationWithOptionsFunc == nil {
+		panic("mocks.Client.GetInventoryForLocationWithOptionsFunc is not set")
+	}
+	return c.GetInventoryForLocationWithOptionsFunc(locationID, opts)
+}
+
+func (c *Client) GetLocations(filters map[string]string) ([]bigcommerce.Location, error) {
+	if c.GetLocationsFunc == nil {
+		panic("mocks.Client.GetLocationsFunc is not set")
+	}
+	return c.GetLocationsFunc(filters)
+}
+
+func (c *Client) CreateLocations(location *[]bigcommerce.Location) error {
+	if c.CreateLocationsFunc == nil {
+		panic("mocks.Client.CreateLocationsFunc is not set")
+	}
+	return c.CreateLocationsFunc(location)
+}
+
+func (c *Client) UpdateLocation(location *bigcommerce.Location) error {
+	if c.UpdateLocationFunc == nil {
+		panic("mocks.Client.UpdateLocationFunc is not set")
+	}
+	return c.UpdateLocationFunc(location)
+}
+
+func (c *Client) GetOrderMetafields(orderID int64, namespace string) ([]bigcommerce.Metafield, error) {
+	if c.GetOrderMetafieldsFunc == nil {
+		panic("mocks.Client.GetOrderMetafieldsFunc is not set")
+	}
+	return c.GetOrderMetafieldsFunc(orderID, namespace)
+}
+
+func (c *Client) CreateOrderMetafield(orderID int64, metafield bigcommerce.Metafield) (*bigcommerce.Metafield, error) {
+	if c.CreateOrderMetafieldFunc == nil {
+		panic("mocks.Client.CreateOrderMetafieldFunc is not set")
+	}
+	return c.CreateOrderMetafieldFunc(orderID, metafield)
+}
+
+func (c *Client) UpdateOrderMetafield(orderID int64, metafield bigcommerce.Metafield) (*bigcommerce.Metafield, error) {
+	if c.UpdateOrderMetafieldFunc == nil {
+		panic("mocks.Client.UpdateOrderMetafieldFunc is not set")
+	}
+	return c.UpdateOrderMetafieldFunc(orderID, metafield)
+}
+
+func (c *Client) DeleteOrderMetafield(orderID, metafieldID int64) error {
+	if c.DeleteOrderMetafieldFunc == nil {
+		panic("mocks.Client.DeleteOrderMetafieldFunc is not set")
+	}
+	return c.DeleteOrderMetafieldFunc(orderID, metafieldID)
+}
+
+func (c *Client) GetProductMetafieldsInNamespace(productID int64, namespace string) ([]bigcommerce.Metafield, error) {
+	if c.GetProductMetafieldsInNamespaceFunc == nil {
+		panic("mocks.Client.GetProductMetafieldsInNamespaceFunc is not set")
+	}
+	return c.GetProductMetafieldsInNamespaceFunc(productID, namespace)
+}
+
+func (c *Client) CreateProductMetafield(productID int64, metafield bigcommerce.Metafield) (*bigcommerce.Metafield, error) {
+	if c.CreateProductMetafieldFunc == nil {
+		panic("mocks.Client.CreateProductMetafieldFunc is not set")
+	}
+	return c.CreateProductMetafieldFunc(productID, metafield)
+}
+
+func (c *Client) UpdateProductMetafield(productID int64, metafield bigcommerce.Metafield) (*bigcommerce.Metafield, error) {
+	if c.UpdateProductMetafieldFunc == nil {
+		panic("mocks.Client.UpdateProductMetafieldFunc is not set")
+	}
+	return c.UpdateProductMetafieldFunc(productID, metafield)
+}
+
+func (c *Client) DeleteProductMetafield(productID, metafieldID int64) error {
+	if c.DeleteProductMetafieldFunc == nil {
+		panic("mocks.Client.DeleteProductMetafieldFunc is not set")
+	}
+	return c.DeleteProductMetafieldFunc(productID, metafieldID)
+}
+
+func (c *Client) FillProductsOpenGraph(products []bigcommerce.Product) (int, error) {
+	if c.FillProductsOpenGraphFunc == nil {
+		panic("mocks.Client.FillProductsOpenGraphFunc is not set")
+	}
+	return c.FillProductsOpenGraphFunc(products)
+}
+
+func (c *Client) OrderTags(orderID int64) *bigcommerce.OrderTags {
+	if c.OrderTagsFunc == nil {
+		panic("mocks.Client.OrderTagsFunc is not set")
+	}
+	return c.OrderTagsFunc(orderID)
+}
+
+func (c *Client) GetOrderRefunds(orderID int64) ([]bigcommerce.OrderRefund, error) {
+	if c.GetOrderRefundsFunc == nil {
+		panic("mocks.Client.GetOrderRefundsFunc is not set")
+	}
+	return c.GetOrderRefundsFunc(orderID)
+}
+
+func (c *Client) GetOrderTransactions(orderID int64) ([]bigcommerce.OrderTransaction, error) {
+	if c.GetOrderTransactionsFunc == nil {
+		panic("mocks.Client.GetOrderTransactionsFunc is not set")
+	}
+	return c.GetOrderTransactionsFunc(orderID)
+}
+
+func (c *Client) GetOrderTimeline(orderID int64) ([]bigcommerce.TimelineEvent, error) {
+	if c.GetOrderTimelineFunc == nil {
+		panic("mocks.Client.GetOrderTimelineFunc is not set")
+	}
+	return c.GetOrderTimelineFunc(orderID)
+}
+
+func (c *Client) GetOrders(filters map[string]string) ([]bigcommerce.Order, error) {
+	if c.GetOrdersFunc == nil {
+		panic("mocks.Client.GetOrdersFunc is not set")
+	}
+	return c.GetOrdersFunc(filters)
+}
+
+func (c *Client) GetOrder(orderID int64) (*bigcommerce.Order, error) {
+	if c.GetOrderFunc == nil {
+		panic("mocks.Client.GetOrderFunc is not set")
+	}
+	return c.GetOrderFunc(orderID)
+}
+
+func (c *Client) UpdateOrder(orderId int64, order *bigcommerce.UpdateOrder) error {
+	if c.UpdateOrderFunc == nil {
+		panic("mocks.Client.UpdateOrderFunc is not set")
+	}
+	return c.UpdateOrderFunc(orderId, order)
+}
+
+func (c *Client) GetOrderProducts(orderID int64) ([]bigcommerce.OrderProduct, error) {
+	if c.GetOrderProductsFunc == nil {
+		panic("mocks.Client.GetOrderProductsFunc is not set")
+	}
+	return c.GetOrderProductsFunc(orderID)
+}
+
+func (c *Client) GetOrderProductsPage(orderID int64, page int) ([]bigcommerce.OrderProduct, bool, error) {
+	if c.GetOrderProductsPageFunc == nil {
+		panic("mocks.Client.GetOrderProductsPageFunc is not set")
+	}
+	return c.GetOrderProductsPageFunc(orderID, page)
+}
+
+func (c *Client) GetOrderShippingAddresses(orderID int64) ([]bigcommerce.OrderShippingAddress, error) {
+	if c.GetOrderShippingAddressesFunc == nil {
+		panic("mocks.Client.GetOrderShippingAddressesFunc is not set")
+	}
+	return c.GetOrderShippingAddressesFunc(orderID)
+}
+
+func (c *Client) GetOrderCoupons(orderID int64) ([]bigcommerce.OrderCoupon, error) {
+	if c.GetOrderCouponsFunc == nil {
+		panic("mocks.Client.GetOrderCouponsFunc is not set")
+	}
+	return c.GetOrderCouponsFunc(orderID)
+}
+
+func (c *Client) GetOrderMessages(orderID int64) ([]bigcommerce.OrderMessage, error) {
+	if c.GetOrderMessagesFunc == nil {
+		panic("mocks.Client.GetOrderMessagesFunc is not set")
+	}
+	return c.GetOrderMessagesFunc(orderID)
+}
+
+func (c *Client) GetOrderMessagesPage(orderID int64, page int) ([]bigcommerce.OrderMessage, bool, error) {
+	if c.GetOrderMessagesPageFunc == nil {
+		panic("mocks.Client.GetOrderMessagesPageFunc is not set")
+	}
+	return c.GetOrderMessagesPageFunc(orderID, page)
+}
+
+func (c *Client) GetOrderShippingMargin(order *bigcommerce.Order) (float64, error) {
+	if c.GetOrderShippingMarginFunc == nil {
+		panic("mocks.Client.GetOrderShippingMarginFunc is not set")
+	}
+	return c.GetOrderShippingMarginFunc(order)
+}
+
+func (c *Client) CreateWidgetTemplate(pt *bigcommerce.PageBuilderTemplate) (*bigcommerce.PageBuilderTemplate, error) {
+	if c.CreateWidgetTemplateFunc == nil {
+		panic("mocks.Client.CreateWidgetTemplateFunc is not set")
+	}
+	return c.CreateWidgetTemplateFunc(pt)
+}
+
+func (c *Client) GetWidgetTemplates() ([]bigcommerce.PageBuilderTemplate, error) {
+	if c.GetWidgetTemplatesFunc == nil {
+		panic("mocks.Client.GetWidgetTemplatesFunc is not set")
+	}
+	return c.GetWidgetTemplatesFunc()
+}
+
+func (c *Client) DeleteWidgetTemplate(uuid string) error {
+	if c.DeleteWidgetTemplateFunc == nil {
+		panic("mocks.Client.DeleteWidgetTemplateFunc is not set")
+	}
+	return c.DeleteWidgetTemplateFunc(uuid)
+}
+
+func (c *Client) ReconcileOrderPayments(orderID int64) (*bigcommerce.PaymentReconciliation, error) {
+	if c.ReconcileOrderPaymentsFunc == nil {
+		panic("mocks.Client.ReconcileOrderPaymentsFunc is not set")
+	}
+	return c.ReconcileOrderPaymentsFunc(orderID)
+}
+
+func (c *Client) PayOrder(orderID int64, payment bigcommerce.PaymentRequest) (*bigcommerce.PaymentResult, error) {
+	if c.PayOrderFunc == nil {
+		panic("mocks.Client.PayOrderFunc is not set")
+	}
+	return c.PayOrderFunc(orderID, payment)
+}
+
+func (c *Client) CreatePaymentAccessToken(orderID int64) (string, error) {
+	if c.CreatePaymentAccessTokenFunc == nil {
+		panic("mocks.Client.CreatePaymentAccessTokenFunc is not set")
+	}
+	return c.CreatePaymentAccessTokenFunc(orderID)
+}
+
+func (c *Client) ProcessPayment(accessToken string, payment bigcommerce.PaymentRequest) (*bigcommerce.PaymentResult, error) {
+	if c.ProcessPaymentFunc == nil {
+		panic("mocks.Client.ProcessPaymentFunc is not set")
+	}
+	return c.ProcessPaymentFunc(accessToken, payment)
+}
+
+func (c *Client) GetCustomerStoredInstruments(customerID int64) ([]bigcommerce.StoredInstrument, error) {
+	if c.GetCustomerStoredInstrumentsFunc == nil {
+		panic("mocks.Client.GetCustomerStoredInstrumentsFunc is not set")
+	}
+	return c.GetCustomerStoredInstrumentsFunc(customerID)
+}
+
+func (c *Client) GetOrderStoredInstruments(orderID int64) ([]bigcommerce.StoredInstrument, error) {
+	if c.GetOrderStoredInstrumentsFunc == nil {
+		panic("mocks.Client.GetOrderStoredInstrumentsFunc is not set")
+	}
+	return c.GetOrderStoredInstrumentsFunc(orderID)
+}
+
+func (c *Client) PayOrderWithStoredInstrument(orderID int64, paymentMethodID, token string) (*bigcommerce.PaymentResult, error) {
+	if c.PayOrderWithStoredInstrumentFunc == nil {
+		panic("mocks.Client.PayOrderWithStoredInstrumentFunc is not set")
+	}
+	return c.PayOrderWithStoredInstrumentFunc(orderID, paymentMethodID, token)
+}
+
+func (c *Client) CaptureOrderPayment(orderID int64) error {
+	if c.CaptureOrderPaymentFunc == nil {
+		panic("mocks.Client.CaptureOrderPaymentFunc is not set")
+	}
+	return c.CaptureOrderPaymentFunc(orderID)
+}
+
+func (c *Client) GetPickupMethods() ([]bigcommerce.PickupMethod, error) {
+	if c.GetPickupMethodsFunc == nil {
+		panic("mocks.Client.GetPickupMethodsFunc is not set")
+	}
+	return c.GetPickupMethodsFunc()
+}
+
+func (c *Client) CreatePickupMethods(methods []bigcommerce.PickupMethod) ([]bigcommerce.PickupMethod, error) {
+	if c.CreatePickupMethodsFunc == nil {
+		panic("mocks.Client.CreatePickupMethodsFunc is not set")
+	}
+	return c.CreatePickupMethodsFunc(methods)
+}
+
+func (c *Client) UpdatePickupMethods(methods []bigcommerce.PickupMethod) ([]bigcommerce.PickupMethod, error) {
+	if c.UpdatePickupMethodsFunc == nil {
+		panic("mocks.Client.UpdatePickupMethodsFunc is not set")
+	}
+	return c.UpdatePickupMethodsFunc(methods)
+}
+
+func (c *Client) DeletePickupMethods(ids []int64) error {
+	if c.DeletePickupMethodsFunc == nil {
+		panic("mocks.Client.DeletePickupMethodsFunc is not set")
+	}
+	return c.DeletePickupMethodsFunc(ids)
+}
+
+func (c *Client) GetPickupOptions(request bigcommerce.PickupOptionsRequest) ([]bigcommerce.PickupOption, error) {
+	if c.GetPickupOptionsFunc == nil {
+		panic("mocks.Client.GetPickupOptionsFunc is not set")
+	}
+	return c.GetPickupOptionsFunc(request)
+}
+
+func (c *Client) GetOrderPickups(orderIDs ...int64) ([]bigcommerce.Pickup, error) {
+	if c.GetOrderPickupsFunc == nil {
+		panic("mocks.Client.GetOrderPickupsFunc is not set")
+	}
+	return c.GetOrderPickupsFunc(orderIDs...)
+}
+
+func (c *Client) CreateOrderPickups(pickups []bigcommerce.Pickup) ([]bigcommerce.Pickup, error) {
+	if c.CreateOrderPickupsFunc == nil {
+		panic("mocks.Client.CreateOrderPickupsFunc is not set")
+	}
+	return c.CreateOrderPickupsFunc(pickups)
+}
+
+func (c *Client) DeleteOrderPickups(ids []int64) error {
+	if c.DeleteOrderPickupsFunc == nil {
+		panic("mocks.Client.DeleteOrderPickupsFunc is not set")
+	}
+	return c.DeleteOrderPickupsFunc(ids)
+}
+
+func (c *Client) GetAllPosts() ([]bigcommerce.Post, error) {
+	if c.GetAllPostsFunc == nil {
+		panic("mocks.Client.GetAllPostsFunc is not set")
+	}
+	return c.GetAllPostsFunc()
+}
+
+func (c *Client) GetPosts(page int) ([]bigcommerce.Post, bool, error) {
+	if c.GetPostsFunc == nil {
+		panic("mocks.Client.GetPostsFunc is not set")
+	}
+	return c.GetPostsFunc(page)
+}
+
+func (c *Client) GetPriceListRecords(priceListID int64, args map[string]string) ([]bigcommerce.PriceListRecord, error) {
+	if c.GetPriceListRecordsFunc == nil {
+		panic("mocks.Client.GetPriceListRecordsFunc is not set")
+	}
+	return c.GetPriceListRecordsFunc(priceListID, args)
+}
+
+func (c *Client) UpsertPriceListRecords(priceListID int64, records []bigcommerce.PriceListRecord) error {
+	if c.UpsertPriceListRecordsFunc == nil {
+		panic("mocks.Client.UpsertPriceListRecordsFunc is not set")
+	}
+	return c.UpsertPriceListRecordsFunc(priceListID, records)
+}
+
+func (c *Client) RecalculatePriceList(priceListID int64, rule bigcommerce.PricingRule, opts bigcommerce.RecalculateOptions) ([]bigcommerce.PriceChange, error) {
+	if c.RecalculatePriceListFunc == nil {
+		panic("mocks.Client.RecalculatePriceListFunc is not set")
+	}
+	return c.RecalculatePriceListFunc(priceListID, rule, opts)
+}
+
+func (c *Client) ProductWorkflow() *bigcommerce.ProductWorkflow {
+	if c.ProductWorkflowFunc == nil {
+		panic("mocks.Client.ProductWorkflowFunc is not set")
+	}
+	return c.ProductWorkflowFunc()
+}
+
+func (c *Client) GetAllProducts(args map[string]string) ([]bigcommerce.Product, error) {
+	if c.GetAllProductsFunc == nil {
+		panic("mocks.Client.GetAllProductsFunc is not set")
+	}
+	return c.GetAllProductsFunc(args)
+}
+
+func (c *Client) GetProducts(args map[string]string, page int) ([]bigcommerce.Product, bool, error) {
+	if c.GetProductsFunc == nil {
+		panic("mocks.Client.GetProductsFunc is not set")
+	}
+	return c.GetProductsFunc(args, page)
+}
+
+func (c *Client) GetProductByID(productID int64) (*bigcommerce.Product, error) {
+	if c.GetProductByIDFunc == nil {
+		panic("mocks.Client.GetProductByIDFunc is not set")
+	}
+	return c.GetProductByIDFunc(productID)
+}
+
+func (c *Client) GetProductMetafields(productID int64) (map[string]bigcommerce.Metafield, error) {
+	if c.GetProductMetafieldsFunc == nil {
+		panic("mocks.Client.GetProductMetafieldsFunc is not set")
+	}
+	return c.GetProductMetafieldsFunc(productID)
+}
+
+func (c *Client) SetProductsSortOrder(sortOrders map[int64]int) error {
+	if c.SetProductsSortOrderFunc == nil {
+		panic("mocks.Client.SetProductsSortOrderFunc is not set")
+	}
+	return c.SetProductsSortOrderFunc(sortOrders)
+}
+
+func (c *Client) SetProductsFeatured(productIDs []int64, featured bool) error {
+	if c.SetProductsFeaturedFunc == nil {
+		panic("mocks.Client.SetProductsFeaturedFunc is not set")
+	}
+	return c.SetProductsFeaturedFunc(productIDs, featured)
+}
+
+func (c *Client) CreatePromotion(promotion bigcommerce.Promotion) (*bigcommerce.Promotion, error) {
+	if c.CreatePromotionFunc == nil {
+		panic("mocks.Client.CreatePromotionFunc is not set")
+	}
+	return c.CreatePromotionFunc(promotion)
+}
+
+func (c *Client) CreateSegmentPromotion(segmentName string, promotion bigcommerce.Promotion) (*bigcommerce.Promotion, error) {
+	if c.CreateSegmentPromotionFunc == nil {
+		panic("mocks.Client.CreateSegmentPromotionFunc is not set")
+	}
+	return c.CreateSegmentPromotionFunc(segmentName, promotion)
+}
+
+func (c *Client) RateLimitStatus() bigcommerce.RateLimitStatus {
+	if c.RateLimitStatusFunc == nil {
+		panic("mocks.Client.RateLimitStatusFunc is not set")
+	}
+	return c.RateLimitStatusFunc()
+}
+
+func (c *Client) Raw(method, path string, body []byte) ([]byte, error) {
+	if c.RawFunc == nil {
+		panic("mocks.Client.RawFunc is not set")
+	}
+	return c.RawFunc(method, path, body)
+}
+
+func (c *Client) SendJSON(method, path string, payload, result interface {
+}) error {
+	if c.SendJSONFunc == nil {
+		panic("mocks.Client.SendJSONFunc is not set")
+	}
+	return c.SendJSONFunc(method, path, payload, result)
+}
+
+func (c *Client) Patch(path string, payload, result interface {
+}) error {
+	if c.PatchFunc == nil {
+		panic("mocks.Client.PatchFunc is not set")
+	}
+	return c.PatchFunc(path, payload, result)
+}
+
+func (c *Client) GetSalesReport(opts bigcommerce.SalesReportOptions) (*bigcommerce.SalesReport, error) {
+	if c.GetSalesReportFunc == nil {
+		panic("mocks.Client.GetSalesReportFunc is not set")
+	}
+	return c.GetSalesReportFunc(opts)
+}
+
+func (c *Client) With(opts ...bigcommerce.RequestOption) *bigcommerce.Client {
+	if c.WithFunc == nil {
+		panic("mocks.Client.WithFunc is not set")
+	}
+	return c.WithFunc(opts...)
+}
+
+func (c *Client) WithResponses(responses *[]bigcommerce.Response) *bigcommerce.Client {
+	if c.WithResponsesFunc == nil {
+		panic("mocks.Client.WithResponsesFunc is not set")
+	}
+	return c.WithResponsesFunc(responses)
+}
+
+func (c *Client) ProductSchedule(changes ...bigcommerce.ScheduledChange) *bigcommerce.ProductSchedule {
+	if c.ProductScheduleFunc == nil {
+		panic("mocks.Client.ProductScheduleFunc is not set")
+	}
+	return c.ProductScheduleFunc(changes...)
+}
+
+func (c *Client) CreateScript(s *bigcommerce.Script) (*bigcommerce.Script, error) {
+	if c.CreateScriptFunc == nil {
+		panic("mocks.Client.CreateScriptFunc is not set")
+	}
+	return c.CreateScriptFunc(s)
+}
+
+func (c *Client) GetScriptByID(uuid string) (*bigcommerce.Script, error) {
+	if c.GetScriptByIDFunc == nil {
+		panic("mocks.Client.GetScriptByIDFunc is not set")
+	}
+	return c.GetScriptByIDFunc(uuid)
+}
+
+func (c *Client) GetScripts() ([]bigcommerce.Script, error) {
+	if c.GetScriptsFunc == nil {
+		panic("mocks.Client.GetScriptsFunc is not set")
+	}
+	return c.GetScriptsFunc()
+}
+
+func (c *Client) Seeder(opts bigcommerce.SeedOptions) *bigcommerce.Seeder {
+	if c.SeederFunc == nil {
+		panic("mocks.Client.SeederFunc is not set")
+	}
+	return c.SeederFunc(opts)
+}
+
+func (c *Client) CleanupSeeded(r bigcommerce.SeededResources) (bigcommerce.SeededResources, error) {
+	if c.CleanupSeededFunc == nil {
+		panic("mocks.Client.CleanupSeededFunc is not set")
+	}
+	return c.CleanupSeededFunc(r)
+}
+
+func (c *Client) GetSegments() ([]bigcommerce.Segment, error) {
+	if c.GetSegmentsFunc == nil {
+		panic("mocks.Client.GetSegmentsFunc is not set")
+	}
+	return c.GetSegmentsFunc()
+}
+
+func (c *Client) GetSegmentByName(name string) (*bigcommerce.Segment, error) {
+	if c.GetSegmentByNameFunc == nil {
+		panic("mocks.Client.GetSegmentByNameFunc is not set")
+	}
+	return c.GetSegmentByNameFunc(name)
+}
+
+func (c *Client) CreateSegment(segment bigcommerce.Segment) (*bigcommerce.Segment, error) {
+	if c.CreateSegmentFunc == nil {
+		panic("mocks.Client.CreateSegmentFunc is not set")
+	}
+	return c.CreateSegmentFunc(segment)
+}
+
+func (c *Client) EnsureSegment(name, description string) (*bigcommerce.Segment, error) {
+	if c.EnsureSegmentFunc == nil {
+		panic("mocks.Client.EnsureSegmentFunc is not set")
+	}
+	return c.EnsureSegmentFunc(name, description)
+}
+
+func (c *Client) CreateOrderShipmentFromLocation(orderID, locationID int64, shipment bigcommerce.Shipment, opts bigcommerce.ShipmentLocationOptions) (*bigcommerce.Shipment, error) {
+	if c.CreateOrderShipmentFromLocationFunc == nil {
+		panic("mocks.Client.CreateOrderShipmentFromLocationFunc is not set")
+	}
+	return c.CreateOrderShipmentFromLocationFunc(orderID, locationID, shipment, opts)
+}
+
+func (c *Client) GetShipmentLocation(orderID, shipmentID int64) (int64, error) {
+	if c.GetShipmentLocationFunc == nil {
+		panic("mocks.Client.GetShipmentLocationFunc is not set")
+	}
+	return c.GetShipmentLocationFunc(orderID, shipmentID)
+}
+
+func (c *Client) GetOrderShipmentLocations(orderID int64) (map[int64]int64, error) {
+	if c.GetOrderShipmentLocationsFunc == nil {
+		panic("mocks.Client.GetOrderShipmentLocationsFunc is not set")
+	}
+	return c.GetOrderShipmentLocationsFunc(orderID)
+}
+
+func (c *Client) GetOrderShipments(orderId int64, filters map[string]string) ([]bigcommerce.Shipment, error) {
+	if c.GetOrderShipmentsFunc == nil {
+		panic("mocks.Client.GetOrderShipmentsFunc is not set")
+	}
+	return c.GetOrderShipmentsFunc(orderId, filters)
+}
+
+func (c *Client) GetAllOrderShipments(orderId int64) ([]bigcommerce.Shipment, error) {
+	if c.GetAllOrderShipmentsFunc == nil {
+		panic("mocks.Client.GetAllOrderShipmentsFunc is not set")
+	}
+	return c.GetAllOrderShipmentsFunc(orderId)
+}
+
+func (c *Client) CreateOrderShipment(orderId int64, shipment bigcommerce.Shipment) (*bigcommerce.Shipment, error) {
+	if c.CreateOrderShipmentFunc == nil {
+		panic("mocks.Client.CreateOrderShipmentFunc is not set")
+	}
+	return c.CreateOrderShipmentFunc(orderId, shipment)
+}
+
+func (c *Client) DeleteOrderShipments(orderId int64) (bool, error) {
+	if c.DeleteOrderShipmentsFunc == nil {
+		panic("mocks.Client.DeleteOrderShipmentsFunc is not set")
+	}
+	return c.DeleteOrderShipmentsFunc(orderId)
+}
+
+func (c *Client) DeleteOrderShipment(orderId int64, shipmentId int64) (bool, error) {
+	if c.DeleteOrderShipmentFunc == nil {
+		panic("mocks.Client.DeleteOrderShipmentFunc is not set")
+	}
+	return c.DeleteOrderShipmentFunc(orderId, shipmentId)
+}
+
+func (c *Client) GetOrderShipment(orderId int64, shipmentId int64) (*bigcommerce.Shipment, error) {
+	if c.GetOrderShipmentFunc == nil {
+		panic("mocks.Client.GetOrderShipmentFunc is not set")
+	}
+	return c.GetOrderShipmentFunc(orderId, shipmentId)
+}
+
+func (c *Client) UpdateOrderShipment(orderId int64, shipment bigcommerce.Shipment) (*bigcommerce.Shipment, error) {
+	if c.UpdateOrderShipmentFunc == nil {
+		panic("mocks.Client.UpdateOrderShipmentFunc is not set")
+	}
+	return c.UpdateOrderShipmentFunc(orderId, shipment)
+}
+
+func (c *Client) CreateOrderShipmentAndCapture(orderId int64, shipment bigcommerce.Shipment, onCaptureFailure bigcommerce.CaptureFailurePolicy) (*bigcommerce.Shipment, error) {
+	if c.CreateOrderShipmentAndCaptureFunc == nil {
+		panic("mocks.Client.CreateOrderShipmentAndCaptureFunc is not set")
+	}
+	return c.CreateOrderShipmentAndCaptureFunc(orderId, shipment, onCaptureFailure)
+}
+
+func (c *Client) ResendShipmentNotification(orderID int64) error {
+	if c.ResendShipmentNotificationFunc == nil {
+		panic("mocks.Client.ResendShipmentNotificationFunc is not set")
+	}
+	return c.ResendShipmentNotificationFunc(orderID)
+}
+
+func (c *Client) GetStoreInfo() (bigcommerce.StoreInfo, error) {
+	if c.GetStoreInfoFunc == nil {
+		panic("mocks.Client.GetStoreInfoFunc is not set")
+	}
+	return c.GetStoreInfoFunc()
+}
+
+func (c *Client) GetCustomerStoreCredit(customerID int64) (float64, error) {
+	if c.GetCustomerStoreCreditFunc == nil {
+		panic("mocks.Client.GetCustomerStoreCreditFunc is not set")
+	}
+	return c.GetCustomerStoreCreditFunc(customerID)
+}
+
+func (c *Client) SetCustomerStoreCredit(customerID int64, amount float64, reason, reference string) error {
+	if c.SetCustomerStoreCreditFunc == nil {
+		panic("mocks.Client.SetCustomerStoreCreditFunc is not set")
+	}
+	return c.SetCustomerStoreCreditFunc(customerID, amount, reason, reference)
+}
+
+func (c *Client) AddCustomerStoreCredit(customerID int64, amount float64, reason, reference string) (float64, error) {
+	if c.AddCustomerStoreCreditFunc == nil {
+		panic("mocks.Client.AddCustomerStoreCreditFunc is not set")
+	}
+	return c.AddCustomerStoreCreditFunc(customerID, amount, reason, reference)
+}
+
+func (c *Client) CreateStorefrontToken(channelID int64, expiresAt time.Time, allowedOrigins ...string) (*bigcommerce.StorefrontToken, error) {
+	if c.CreateStorefrontTokenFunc == nil {
+		panic("mocks.Client.CreateStorefrontTokenFunc is not set")
+	}
+	return c.CreateStorefrontTokenFunc(channelID, expiresAt, allowedOrigins...)
+}
+
+func (c *Client) CreateCustomerImpersonationToken(channelID int64, expiresAt time.Time) (*bigcommerce.StorefrontToken, error) {
+	if c.CreateCustomerImpersonationTokenFunc == nil {
+		panic("mocks.Client.CreateCustomerImpersonationTokenFunc is not set")
+	}
+	return c.CreateCustomerImpersonationTokenFunc(channelID, expiresAt)
+}
+
+func (c *Client) RevokeStorefrontToken(token string) error {
+	if c.RevokeStorefrontTokenFunc == nil {
+		panic("mocks.Client.RevokeStorefrontTokenFunc is not set")
+	}
+	return c.RevokeStorefrontTokenFunc(token)
+}
+
+func (c *Client) StorefrontTokenProvider(channelID int64, ttl time.Duration, allowedOrigins ...string) *bigcommerce.StorefrontTokenProvider {
+	if c.StorefrontTokenProviderFunc == nil {
+		panic("mocks.Client.StorefrontTokenProviderFunc is not set")
+	}
+	return c.StorefrontTokenProviderFunc(channelID, ttl, allowedOrigins...)
+}
+
+func (c *Client) GetActiveThemeConfig() (*bigcommerce.ThemeConfig, error) {
+	if c.GetActiveThemeConfigFunc == nil {
+		panic("mocks.Client.GetActiveThemeConfigFunc is not set")
+	}
+	return c.GetActiveThemeConfigFunc()
+}
+
+func (c *Client) GetThemes() ([]bigcommerce.Theme, error) {
+	if c.GetThemesFunc == nil {
+		panic("mocks.Client.GetThemesFunc is not set")
+	}
+	return c.GetThemesFunc()
+}
+
+func (c *Client) GetThemeConfig(uuid string) (*bigcommerce.ThemeConfig, error) {
+	if c.GetThemeConfigFunc == nil {
+		panic("mocks.Client.GetThemeConfigFunc is not set")
+	}
+	return c.GetThemeConfigFunc(uuid)
+}
+
+func (c *Client) GetStoreUnits() (bigcommerce.UnitSystem, error) {
+	if c.GetStoreUnitsFunc == nil {
+		panic("mocks.Client.GetStoreUnitsFunc is not set")
+	}
+	return c.GetStoreUnitsFunc()
+}
+
+func (c *Client) CreateVariantCombinations(productID int64, combinations []bigcommerce.VariantCombination) error {
+	if c.CreateVariantCombinationsFunc == nil {
+		panic("mocks.Client.CreateVariantCombinationsFunc is not set")
+	}
+	return c.CreateVariantCombinationsFunc(productID, combinations)
+}
+
+func (c *Client) CreateProductVariants(productID int64, baseSku string, options []bigcommerce.VariantOption, skuPattern string) ([]bigcommerce.VariantCombination, error) {
+	if c.CreateProductVariantsFunc == nil {
+		panic("mocks.Client.CreateProductVariantsFunc is not set")
+	}
+	return c.CreateProductVariantsFunc(productID, baseSku, options, skuPattern)
+}
+
+func (c *Client) GetProductVariants(productID int64) ([]bigcommerce.Variant, error) {
+	if c.GetProductVariantsFunc == nil {
+		panic("mocks.Client.GetProductVariantsFunc is not set")
+	}
+	return c.GetProductVariantsFunc(productID)
+}
+
+func (c *Client) GetVariant(productID, variantID int64) (*bigcommerce.Variant, error) {
+	if c.GetVariantFunc == nil {
+		panic("mocks.Client.GetVariantFunc is not set")
+	}
+	return c.GetVariantFunc(productID, variantID)
+}
+
+func (c *Client) GetWebhooks() ([]bigcommerce.Webhook, error) {
+	if c.GetWebhooksFunc == nil {
+		panic("mocks.Client.GetWebhooksFunc is not set")
+	}
+	return c.GetWebhooksFunc()
+}
+
+func (c *Client) CreateWebhook(scope, destination string, headers map[string]string) (int64, error) {
+	if c.CreateWebhookFunc == nil {
+		panic("mocks.Client.CreateWebhookFunc is not set")
+	}
+	return c.CreateWebhookFunc(scope, destination, headers)
+}
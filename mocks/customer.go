@@ -1,5 +1,9 @@
 package mocks
 
+import (
+	"github.com/ewarehousing-solutions/bigcommerce-api-go"
+)
+
 type CustomerClient struct {
 	CustomerID int64
 	Email      string
@@ -8,6 +12,8 @@ type CustomerClient struct {
 	FormFields []bigcommerce.FormField
 }
 
+var _ bigcommerce.CustomerClient = (*CustomerClient)(nil)
+
 func (cm *CustomerClient) ValidateCredentials(email, password string) (int64, error) {
 	if email == cm.Email && password == cm.Password {
 		return 1, nil
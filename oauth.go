@@ -0,0 +1,78 @@
+package bigcommerce
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// DefaultLoginURL is the BigCommerce OAuth server
+const DefaultLoginURL = "https://login.bigcommerce.com"
+
+// ScopeError is returned by Install when the store didn't grant all of the app's required scopes,
+// usually because the app's scopes were changed in the Developer Portal after the store installed it
+type ScopeError struct {
+	StoreHash string
+	Missing   []string
+}
+
+func (e *ScopeError) Error() string {
+	return fmt.Sprintf("store %s did not grant the scopes %s", e.StoreHash, strings.Join(e.Missing, ", "))
+}
+
+// Install handles the auth callback of an app installation: it exchanges the temporary code in the query of
+// the callback request for a permanent token, checks the granted scopes against RequiredScopes and returns
+// a client for the store. Save the AuthContext's AccessToken and StoreHash to create clients later:
+//
+//	func authCallback(w http.ResponseWriter, r *http.Request) {
+//		client, ac, err := app.Install(r.URL.Query())
+//		...
+//	}
+func (a *App) Install(requestURLQuery url.Values, opts ...Option) (*Client, *AuthContext, error) {
+	ac, err := a.GetAuthContext(requestURLQuery)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ac.StoreHash() == "" {
+		return nil, ac, fmt.Errorf("auth context has no store: %q", ac.Context)
+	}
+	if missing := ac.MissingScopes(a.RequiredScopes...); len(missing) > 0 {
+		return nil, ac, &ScopeError{StoreHash: ac.StoreHash(), Missing: missing}
+	}
+	return a.NewClient(ac.StoreHash(), ac.AccessToken, opts...), ac, nil
+}
+
+// StoreHash returns the hash of the store from the context, e.g. "abc123" for "stores/abc123"
+func (ac *AuthContext) StoreHash() string {
+	return strings.TrimPrefix(ac.Context, "stores/")
+}
+
+// Scopes returns the granted OAuth scopes
+func (ac *AuthContext) Scopes() []string {
+	return strings.Fields(ac.Scope)
+}
+
+// MissingScopes returns the scopes of required that were not granted, a read-only scope like
+// "store_v2_orders_read_only" is covered by its modify scope "store_v2_orders"
+func (ac *AuthContext) MissingScopes(required ...string) []string {
+	granted := map[string]bool{}
+	for _, s := range ac.Scopes() {
+		granted[s] = true
+	}
+	missing := []string{}
+	for _, s := range required {
+		if granted[s] || granted[strings.TrimSuffix(s, "_read_only")] {
+			continue
+		}
+		missing = append(missing, s)
+	}
+	return missing
+}
+
+// loginURL returns the OAuth server of the app, without trailing slash
+func (a *App) loginURL() string {
+	if a.LoginURL == "" {
+		return DefaultLoginURL
+	}
+	return strings.TrimSuffix(a.LoginURL, "/")
+}
@@ -0,0 +1,91 @@
+package bigcommerce
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Open graph types accepted for open_graph_type
+const (
+	OpenGraphProduct = "product"
+	OpenGraphAlbum   = "album"
+	OpenGraphBook    = "book"
+	OpenGraphDrink   = "drink"
+	OpenGraphFood    = "food"
+	OpenGraphGame    = "game"
+	OpenGraphMovie   = "movie"
+	OpenGraphSong    = "song"
+	OpenGraphTVShow  = "tv_show"
+)
+
+// OpenGraphDescriptionLength is the length descriptions copied by FillOpenGraph are cut to
+var OpenGraphDescriptionLength = 300
+
+var htmlTagRe = regexp.MustCompile(`<[^>]*>`)
+
+// FillOpenGraph copies the product name and description (meta description when set)
+// into the open graph fields that are empty, returning true if anything changed
+func (p *Product) FillOpenGraph() bool {
+	changed := false
+	if p.OpenGraphType == "" {
+		p.OpenGraphType = OpenGraphProduct
+		changed = true
+	}
+	if p.OpenGraphTitle == "" && p.Name != "" {
+		p.OpenGraphTitle = p.Name
+		changed = true
+	}
+	if p.OpenGraphDescription == "" {
+		desc := p.MetaDescription
+		if desc == "" {
+			desc = p.Description
+		}
+		desc = TruncateText(PlainText(desc), OpenGraphDescriptionLength)
+		if desc != "" {
+			p.OpenGraphDescription = desc
+			changed = true
+		}
+	}
+	return changed
+}
+
+// FillProductsOpenGraph runs FillOpenGraph on products and saves the changed ones, returning how many were updated
+func (bc *Client) FillProductsOpenGraph(products []Product) (int, error) {
+	updates := []map[string]interface{}{}
+	for i := range products {
+		p := &products[i]
+		if !p.FillOpenGraph() {
+			continue
+		}
+		updates = append(updates, map[string]interface{}{
+			"id":                     p.ID,
+			"open_graph_type":        p.OpenGraphType,
+			"open_graph_title":       p.OpenGraphTitle,
+			"open_graph_description": p.OpenGraphDescription,
+		})
+	}
+	return len(updates), bc.batchUpdateProducts(updates)
+}
+
+// PlainText strips HTML tags and entities from s and collapses whitespace
+func PlainText(s string) string {
+	s = html.UnescapeString(htmlTagRe.ReplaceAllString(s, " "))
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// TruncateText cuts s to at most max characters on a word boundary, adding "..." when cut
+func TruncateText(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	if max <= 3 {
+		return string([]rune(s)[:max])
+	}
+	cut := string([]rune(s)[:max-3])
+	if i := strings.LastIndex(cut, " "); i > len(cut)/2 {
+		cut = cut[:i]
+	}
+	return strings.TrimRight(cut, " ,.;:") + "..."
+}
@@ -0,0 +1,130 @@
+package bigcommerce
+
+import (
+	"log"
+	"strings"
+)
+
+// DefaultBaseURL is the API host requests are sent to when Client.BaseURL is empty
+const DefaultBaseURL = "https://api.bigcommerce.com"
+
+// DefaultPaymentsBaseURL is the host payments are processed at when Client.PaymentsBaseURL is empty
+const DefaultPaymentsBaseURL = "https://payments.bigcommerce.com"
+
+// Version is the version of this library, sent in the default User-Agent
+const Version = "1.0.0"
+
+// DefaultUserAgent is the User-Agent header requests are sent with when Client.UserAgent is empty
+const DefaultUserAgent = "bigcommerce-api-go/v" + Version
+
+// Option configures a client in NewClient:
+//
+//	client := bigcommerce.NewClient(storeHash, token,
+//		bigcommerce.WithHTTPClient(&http.Client{Timeout: 30 * time.Second}),
+//		bigcommerce.WithRetryPolicy(nil),
+//	)
+type Option func(*Client)
+
+// WithHTTPClient sends requests with c instead of an *http.Client with a 10 second timeout
+func WithHTTPClient(c HTTPClient) Option {
+	return func(bc *Client) {
+		bc.HTTPClient = c
+	}
+}
+
+// WithBaseURL sends requests to baseURL, e.g. a proxy, instead of https://api.bigcommerce.com
+func WithBaseURL(baseURL string) Option {
+	return func(bc *Client) {
+		bc.BaseURL = baseURL
+	}
+}
+
+// WithAPIHost sends host as Host header, e.g. when BaseURL is a proxy's address that routes by host
+func WithAPIHost(host string) Option {
+	return func(bc *Client) {
+		bc.APIHost = host
+	}
+}
+
+// WithPaymentsBaseURL processes payments at baseURL instead of https://payments.bigcommerce.com
+func WithPaymentsBaseURL(baseURL string) Option {
+	return func(bc *Client) {
+		bc.PaymentsBaseURL = baseURL
+	}
+}
+
+// WithUserAgent sets the User-Agent header of requests
+func WithUserAgent(userAgent string) Option {
+	return func(bc *Client) {
+		bc.UserAgent = userAgent
+	}
+}
+
+// WithAppName adds the name of the app to the default User-Agent, "bigcommerce-api-go/v1.0.0 (+appName)",
+// so BigCommerce support can tell which app traffic comes from
+func WithAppName(appName string) Option {
+	return func(bc *Client) {
+		bc.UserAgent = DefaultUserAgent + " (+" + appName + ")"
+	}
+}
+
+// WithRetryPolicy sets the policy for retrying throttled and failed requests, nil disables retries
+func WithRetryPolicy(p *RetryPolicy) Option {
+	return func(bc *Client) {
+		bc.Retry = p
+	}
+}
+
+// WithLogger logs retries and sink errors to l instead of the standard logger
+func WithLogger(l *log.Logger) Option {
+	return func(bc *Client) {
+		bc.Logger = l
+	}
+}
+
+// WithChannelID sets the channel used by channel aware endpoints, 1 by default
+func WithChannelID(channelID int64) Option {
+	return func(bc *Client) {
+		bc.ChannelID = channelID
+	}
+}
+
+// WithMaxRetries sets how often idempotent requests are retried on connection resets, 1 by default
+func WithMaxRetries(n int) Option {
+	return func(bc *Client) {
+		bc.MaxRetries = n
+	}
+}
+
+// baseURL returns the API host requests are sent to, without trailing slash
+func (bc *Client) baseURL() string {
+	if bc.BaseURL == "" {
+		return DefaultBaseURL
+	}
+	return strings.TrimSuffix(bc.BaseURL, "/")
+}
+
+// paymentsBaseURL returns the host payments are processed at, without trailing slash
+func (bc *Client) paymentsBaseURL() string {
+	if bc.PaymentsBaseURL == "" {
+		return DefaultPaymentsBaseURL
+	}
+	return strings.TrimSuffix(bc.PaymentsBaseURL, "/")
+}
+
+// userAgent returns the User-Agent header of requests
+func (bc *Client) userAgent() string {
+	if bc.UserAgent == "" {
+		return DefaultUserAgent
+	}
+	return bc.UserAgent
+}
+
+// logf logs to the client's Logger, or the standard logger when none is set
+func (bc *Client) logf(format string, v ...interface{}) {
+	if bc.Logger != nil {
+		bc.Logger.Printf(format, v...)
+		return
+	}
+	log.Printf(format, v...)
+}
@@ -0,0 +1,108 @@
+package bigcommerce
+
+import (
+	"strings"
+)
+
+// OrderTagsNamespace is the metafield namespace order tags are stored in, one metafield per tag
+const OrderTagsNamespace = "order_tags"
+
+// OrderTags manages lightweight tags on an order, backed by order metafields
+// tags are stored with write permission so other integrations can share them
+type OrderTags struct {
+	client  *Client
+	OrderID int64
+}
+
+// OrderTags returns the tag manager for an order
+func (bc *Client) OrderTags(orderID int64) *OrderTags {
+	return &OrderTags{client: bc, OrderID: orderID}
+}
+
+// List returns the tags of the order
+func (t *OrderTags) List() ([]string, error) {
+	mfs, err := t.client.GetOrderMetafields(t.OrderID, OrderTagsNamespace)
+	if err != nil {
+		return nil, err
+	}
+	tags := []string{}
+	for _, mf := range mfs {
+		tags = append(tags, mf.Key)
+	}
+	return tags, nil
+}
+
+// Has returns true if the order has the tag
+func (t *OrderTags) Has(tag string) (bool, error) {
+	tags, err := t.List()
+	if err != nil {
+		return false, err
+	}
+	tag = normalizeTag(tag)
+	for _, existing := range tags {
+		if existing == tag {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Add adds tags to the order, tags it already has are skipped
+func (t *OrderTags) Add(tags ...string) error {
+	existing, err := t.List()
+	if err != nil {
+		return err
+	}
+	have := map[string]bool{}
+	for _, tag := range existing {
+		have[tag] = true
+	}
+	for _, tag := range tags {
+		tag = normalizeTag(tag)
+		if tag == "" || have[tag] {
+			continue
+		}
+		_, err = t.client.CreateOrderMetafield(t.OrderID, Metafield{
+			Namespace:     OrderTagsNamespace,
+			Key:           tag,
+			Value:         tag,
+			PermissionSet: MetafieldWrite,
+		})
+		if err != nil {
+			return err
+		}
+		have[tag] = true
+	}
+	return nil
+}
+
+// Remove removes tags from the order, tags it doesn't have are ignored
+func (t *OrderTags) Remove(tags ...string) error {
+	mfs, err := t.client.GetOrderMetafields(t.OrderID, OrderTagsNamespace)
+	if err != nil {
+		return err
+	}
+	remove := map[string]bool{}
+	for _, tag := range tags {
+		remove[normalizeTag(tag)] = true
+	}
+	for _, mf := range mfs {
+		if !remove[mf.Key] {
+			continue
+		}
+		err = t.client.DeleteOrderMetafield(t.OrderID, mf.ID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// normalizeTag trims and lowercases a tag, metafield keys are limited to 64 characters
+func normalizeTag(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if len(tag) > 64 {
+		tag = tag[:64]
+	}
+	return tag
+}
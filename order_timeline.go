@@ -0,0 +1,157 @@
+package bigcommerce
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimelineEventType is the kind of an order timeline event
+type TimelineEventType string
+
+// Order timeline event types
+const (
+	TimelineOrderCreated TimelineEventType = "order_created"
+	TimelineOrderShipped TimelineEventType = "order_shipped"
+	TimelineStatus       TimelineEventType = "status"
+	TimelineShipment     TimelineEventType = "shipment"
+	TimelineRefund       TimelineEventType = "refund"
+	TimelineTransaction  TimelineEventType = "transaction"
+	TimelineMessage      TimelineEventType = "message"
+)
+
+// TimelineEvent is something that happened to an order
+type TimelineEvent struct {
+	Time time.Time         `json:"time"`
+	Type TimelineEventType `json:"type"`
+	// ID is the ID of the shipment, refund, transaction or message, 0 for order events
+	ID      int64   `json:"id,omitempty"`
+	Summary string  `json:"summary"`
+	Amount  float64 `json:"amount,omitempty"`
+}
+
+// OrderRefund is a refund of an order
+type OrderRefund struct {
+	ID          int64   `json:"id"`
+	OrderID     int64   `json:"order_id"`
+	UserID      int64   `json:"user_id"`
+	Created     string  `json:"created"`
+	Reason      string  `json:"reason"`
+	TotalAmount float64 `json:"total_amount"`
+	TotalTax    float64 `json:"total_tax"`
+	Payments    []struct {
+		ID              int64   `json:"id"`
+		ProviderID      string  `json:"provider_id"`
+		Amount          float64 `json:"amount"`
+		Offline         bool    `json:"offline"`
+		IsDeclined      bool    `json:"is_declined"`
+		DeclinedMessage string  `json:"declined_message"`
+	} `json:"payments"`
+}
+
+// OrderTransaction is a payment gateway transaction of an order
+type OrderTransaction struct {
+	ID                   int64   `json:"id"`
+	OrderID              string  `json:"order_id"`
+	Event                string  `json:"event"` // purchase, authorization, capture, refund, void, pending or settled
+	Method               string  `json:"method"`
+	Amount               float64 `json:"amount"`
+	Currency             string  `json:"currency"`
+	Gateway              string  `json:"gateway"`
+	GatewayTransactionID string  `json:"gateway_transaction_id"`
+	DateCreated          string  `json:"date_created"`
+	Test                 bool    `json:"test"`
+	Status               string  `json:"status"` // ok or error
+}
+
+// GetOrderRefunds returns the refunds of an order
+func (bc *Client) GetOrderRefunds(orderID int64) ([]OrderRefund, error) {
+	return ListPages[OrderRefund](bc, newURL("/v3/orders").ID(orderID).Segment("payment_actions/refunds").String(), nil)
+}
+
+// GetOrderTransactions returns the payment transactions of an order
+func (bc *Client) GetOrderTransactions(orderID int64) ([]OrderTransaction, error) {
+	return ListPages[OrderTransaction](bc, newURL("/v3/orders").ID(orderID).Segment("transactions").String(), nil)
+}
+
+// GetOrderTimeline returns what happened to an order, oldest first: its creation, shipments, refunds,
+// transactions and messages. BigCommerce keeps no status history, so the status is only known when the
+// order was shipped and as of its last modification. Refunds and transactions are left out when the
+// token lacks the scopes to read them
+func (bc *Client) GetOrderTimeline(orderID int64) ([]TimelineEvent, error) {
+	order, err := bc.GetOrder(orderID)
+	if err != nil {
+		return nil, err
+	}
+	events := []TimelineEvent{}
+	add := func(date string, e TimelineEvent) {
+		t, err := parseDateModified(date)
+		if err != nil {
+			return
+		}
+		e.Time = t
+		events = append(events, e)
+	}
+	add(order.DateCreated, TimelineEvent{Type: TimelineOrderCreated, Summary: "Order placed", Amount: orderTotal(order)})
+	add(order.DateShipped, TimelineEvent{Type: TimelineOrderShipped, Summary: "Order shipped"})
+	add(order.DateModified, TimelineEvent{Type: TimelineStatus, Summary: "Status " + order.Status})
+
+	shipments, err := bc.GetAllOrderShipments(orderID)
+	if err != nil && !errors.Is(err, ErrNoContent) {
+		return nil, err
+	}
+	for _, s := range shipments {
+		summary := "Shipment created"
+		if s.TrackingNumber != "" {
+			summary += ", tracking " + strings.TrimSpace(s.TrackingCarrier+" "+s.TrackingNumber)
+		}
+		add(s.DateCreated, TimelineEvent{Type: TimelineShipment, ID: s.ID, Summary: summary})
+	}
+
+	refunds, err := bc.GetOrderRefunds(orderID)
+	if err != nil && !errors.Is(err, ErrForbidden) {
+		return nil, err
+	}
+	for _, r := range refunds {
+		summary := "Refunded"
+		if r.Reason != "" {
+			summary += ": " + r.Reason
+		}
+		add(r.Created, TimelineEvent{Type: TimelineRefund, ID: r.ID, Summary: summary, Amount: r.TotalAmount})
+	}
+
+	transactions, err := bc.GetOrderTransactions(orderID)
+	if err != nil && !errors.Is(err, ErrForbidden) {
+		return nil, err
+	}
+	for _, t := range transactions {
+		summary := fmt.Sprintf("Transaction %s %s via %s", t.Event, t.Status, t.Gateway)
+		add(t.DateCreated, TimelineEvent{Type: TimelineTransaction, ID: t.ID, Summary: summary, Amount: t.Amount})
+	}
+
+	messages, err := bc.GetOrderMessages(orderID)
+	if err != nil && !errors.Is(err, ErrNoContent) {
+		return nil, err
+	}
+	for _, m := range messages {
+		summary := firstNonEmpty(m.Subject, m.Message)
+		if m.Type != "" {
+			summary = m.Type + " message: " + summary
+		}
+		add(m.DateCreated, TimelineEvent{Type: TimelineMessage, ID: m.ID, Summary: summary})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time.Before(events[j].Time)
+	})
+	return events, nil
+}
+
+// orderTotal returns the order's total including tax, 0 when it doesn't parse
+func orderTotal(o *Order) float64 {
+	total, _ := strconv.ParseFloat(o.TotalIncTax, 64)
+	return total
+}
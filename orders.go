@@ -2,13 +2,15 @@ package bigcommerce
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
 )
 
+// v2PageLimit is the largest page size v2 list endpoints accept
+const v2PageLimit = 250
+
 type UpdateOrder struct {
 	BaseHandlingCost string `json:"base_handling_cost,omitempty"`
 	BaseShippingCost string `json:"base_shipping_cost,omitempty"`
@@ -22,6 +24,25 @@ type UpdateOrder struct {
 	CustomerLocale   string `json:"customer_locale,omitempty"`
 }
 
+// Order status IDs
+const (
+	OrderStatusIncomplete                 = 0
+	OrderStatusPending                    = 1
+	OrderStatusShipped                    = 2
+	OrderStatusPartiallyShipped           = 3
+	OrderStatusRefunded                   = 4
+	OrderStatusCancelled                  = 5
+	OrderStatusDeclined                   = 6
+	OrderStatusAwaitingPayment            = 7
+	OrderStatusAwaitingPickup             = 8
+	OrderStatusAwaitingShipment           = 9
+	OrderStatusCompleted                  = 10
+	OrderStatusAwaitingFulfillment        = 11
+	OrderStatusManualVerificationRequired = 12
+	OrderStatusDisputed                   = 13
+	OrderStatusPartiallyRefunded          = 14
+)
+
 type Order struct {
 	ID                                      int64        `json:"id"`
 	CustomerID                              int64        `json:"customer_id"`
@@ -115,6 +136,7 @@ type OrderProduct struct {
 	ID                   int64             `json:"id"`
 	OrderID              int64             `json:"order_id"`
 	ProductID            int64             `json:"product_id"`
+	VariantID            int64             `json:"variant_id"`
 	OrderAddressID       int64             `json:"order_address_id"`
 	Name                 string            `json:"name"`
 	NameCustomer         string            `json:"name_customer"`
@@ -163,6 +185,30 @@ type OrderProduct struct {
 	EventDate            interface{}       `json:"event_date"`
 }
 
+// QuantityToShip returns how many units are still to ship, the quantity ordered without the units shipped or refunded
+func (p *OrderProduct) QuantityToShip() int {
+	n := p.Quantity - p.QuantityRefunded - p.QuantityShipped
+	if n < 0 {
+		return 0
+	}
+	return n
+}
+
+// IsFullyShipped returns true if every unit that wasn't refunded has shipped
+func (p *OrderProduct) IsFullyShipped() bool {
+	return p.Quantity-p.QuantityRefunded > 0 && p.QuantityToShip() == 0
+}
+
+// IsPartiallyRefunded returns true if some but not all units were refunded
+func (p *OrderProduct) IsPartiallyRefunded() bool {
+	return p.QuantityRefunded > 0 && p.QuantityRefunded < p.Quantity
+}
+
+// IsFullyRefunded returns true if all units were refunded
+func (p *OrderProduct) IsFullyRefunded() bool {
+	return p.Quantity > 0 && p.QuantityRefunded >= p.Quantity
+}
+
 type ProductDiscount struct {
 	ID     string      `json:"id"`
 	Amount string      `json:"amount"`
@@ -222,6 +268,27 @@ type OrderShippingAddress struct {
 	FormFields             []interface{} `json:"form_fields"`
 }
 
+// OrderMessage is a message left on an order by the customer or staff
+type OrderMessage struct {
+	ID          int64  `json:"id"`
+	OrderID     int64  `json:"order_id"`
+	StaffID     int64  `json:"staff_id"`
+	CustomerID  int64  `json:"customer_id"`
+	Type        string `json:"type"`
+	Subject     string `json:"subject"`
+	Message     string `json:"message"`
+	Status      string `json:"status"`
+	IsFlagged   bool   `json:"is_flagged"`
+	DateCreated string `json:"date_created"`
+	Customer    struct {
+		ID        int64  `json:"id"`
+		FirstName string `json:"first_name"`
+		LastName  string `json:"last_name"`
+		Email     string `json:"email"`
+		Phone     string `json:"phone"`
+	} `json:"customer"`
+}
+
 type OrderCoupon struct {
 	ID       int64  `json:"id"`
 	CouponID int64  `json:"coupon_id"`
@@ -235,14 +302,10 @@ type OrderCoupon struct {
 // GetOrders returns all orders using filters
 // filters: request query parameters for BigCommerce orders endpoint, for example {"customer_id": "41"}
 func (bc *Client) GetOrders(filters map[string]string) ([]Order, error) {
-	params := []string{}
-	for k, v := range filters {
-		params = append(params, fmt.Sprintf("%s=%s", k, v))
-	}
-	url := "/v2/orders?" + strings.Join(params, "&")
+	url := newURL("/v2/orders").Args(filters).String()
 
 	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -257,7 +320,7 @@ func (bc *Client) GetOrders(filters map[string]string) ([]Order, error) {
 	}
 
 	var orders []Order
-	err = json.Unmarshal(body, &orders)
+	err = bc.unmarshal(body, &orders)
 	if err != nil {
 		return nil, err
 	}
@@ -270,7 +333,7 @@ func (bc *Client) GetOrder(orderID int64) (*Order, error) {
 	url := "/v2/orders/" + strconv.FormatInt(orderID, 10)
 
 	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -282,7 +345,7 @@ func (bc *Client) GetOrder(orderID int64) (*Order, error) {
 	}
 
 	var order Order
-	err = json.Unmarshal(body, &order)
+	err = bc.unmarshal(body, &order)
 	if err != nil {
 		return nil, err
 	}
@@ -309,12 +372,12 @@ func (bc *Client) UpdateOrder(orderId int64, order *UpdateOrder) error {
 	url := "/v2/orders/" + strconv.FormatInt(orderId, 10)
 
 	// order payload
-	reqJSON, err := json.Marshal(order)
+	reqJSON, err := bc.marshal(order)
 	if err != nil {
 		return err
 	}
 	req := bc.getAPIRequest(http.MethodPut, url, bytes.NewReader(reqJSON))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return err
 	}
@@ -327,28 +390,50 @@ func (bc *Client) UpdateOrder(orderId int64, order *UpdateOrder) error {
 	return nil
 }
 
-// GetOrderProducts returns all products for a given order
+// GetOrderProducts returns all products for a given order, handling pagination
 func (bc *Client) GetOrderProducts(orderID int64) ([]OrderProduct, error) {
-	url := "/v2/orders/" + strconv.FormatInt(orderID, 10) + "/products"
+	ps := []OrderProduct{}
+	page := 1
+	more := true
+	for more {
+		var psp []OrderProduct
+		var err error
+		psp, more, err = bc.GetOrderProductsPage(orderID, page)
+		if err != nil {
+			return ps, err
+		}
+		ps = append(ps, psp...)
+		page++
+	}
+	return ps, nil
+}
+
+// GetOrderProductsPage returns a page of products for a given order
+// page: the page number to download
+func (bc *Client) GetOrderProductsPage(orderID int64, page int) ([]OrderProduct, bool, error) {
+	url := "/v2/orders/" + strconv.FormatInt(orderID, 10) + "/products?limit=" + strconv.Itoa(v2PageLimit) + "&page=" + strconv.Itoa(page)
 
 	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	defer res.Body.Close()
 	body, err := processBody(res)
 	if err != nil {
-		return nil, err
+		if err == ErrNoContent {
+			return []OrderProduct{}, false, nil
+		}
+		return nil, false, err
 	}
 
 	var products []OrderProduct
-	err = json.Unmarshal(body, &products)
+	err = bc.unmarshal(body, &products)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
-	return products, nil
+	return products, len(products) == v2PageLimit, nil
 }
 
 // GetOrderShippingAddresses returns all shipping addresses for a given order
@@ -356,7 +441,7 @@ func (bc *Client) GetOrderShippingAddresses(orderID int64) ([]OrderShippingAddre
 	url := "/v2/orders/" + strconv.FormatInt(orderID, 10) + "/shipping_addresses"
 
 	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -368,7 +453,7 @@ func (bc *Client) GetOrderShippingAddresses(orderID int64) ([]OrderShippingAddre
 	}
 
 	var addresses []OrderShippingAddress
-	err = json.Unmarshal(body, &addresses)
+	err = bc.unmarshal(body, &addresses)
 	if err != nil {
 		return nil, err
 	}
@@ -383,7 +468,7 @@ func (bc *Client) GetOrderCoupons(orderID int64) ([]OrderCoupon, error) {
 	url := "/v2/orders/" + strconv.FormatInt(orderID, 10) + "/coupons"
 
 	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -395,9 +480,189 @@ func (bc *Client) GetOrderCoupons(orderID int64) ([]OrderCoupon, error) {
 	}
 
 	var coupons []OrderCoupon
-	err = json.Unmarshal(body, &coupons)
+	err = bc.unmarshal(body, &coupons)
 	if err != nil {
 		return nil, err
 	}
 	return coupons, nil
 }
+
+// GetOrderMessages returns all messages for a given order, handling pagination
+func (bc *Client) GetOrderMessages(orderID int64) ([]OrderMessage, error) {
+	ms := []OrderMessage{}
+	page := 1
+	more := true
+	for more {
+		var msp []OrderMessage
+		var err error
+		msp, more, err = bc.GetOrderMessagesPage(orderID, page)
+		if err != nil {
+			return ms, err
+		}
+		ms = append(ms, msp...)
+		page++
+	}
+	return ms, nil
+}
+
+// GetOrderMessagesPage returns a page of messages for a given order
+// page: the page number to download
+func (bc *Client) GetOrderMessagesPage(orderID int64, page int) ([]OrderMessage, bool, error) {
+	url := "/v2/orders/" + strconv.FormatInt(orderID, 10) + "/messages?limit=" + strconv.Itoa(v2PageLimit) + "&page=" + strconv.Itoa(page)
+
+	req := bc.getAPIRequest(http.MethodGet, url, nil)
+	res, err := bc.do(req)
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil {
+		if err == ErrNoContent {
+			return []OrderMessage{}, false, nil
+		}
+		return nil, false, err
+	}
+
+	var messages []OrderMessage
+	err = bc.unmarshal(body, &messages)
+	if err != nil {
+		return nil, false, err
+	}
+	return messages, len(messages) == v2PageLimit, nil
+}
+
+// SubscriptionMatcher tells subscription app orders apart from regular orders
+type SubscriptionMatcher struct {
+	// Sources are matched case-insensitively as substrings of order_source and external_source
+	Sources []string
+	// ChannelIDs are channels that only receive subscription orders
+	ChannelIDs []int64
+}
+
+// DefaultSubscriptionMatcher knows the order sources of common subscription apps
+var DefaultSubscriptionMatcher = SubscriptionMatcher{
+	Sources: []string{"recharge", "ordergroove", "bold", "subscribe pro", "rebillia", "paywhirl", "subscription"},
+}
+
+// Match returns true if the order was created by a subscription app
+func (m SubscriptionMatcher) Match(o *Order) bool {
+	for _, id := range m.ChannelIDs {
+		if o.ChannelID == id {
+			return true
+		}
+	}
+	source := strings.ToLower(o.OrderSource + " " + o.ExternalSource)
+	for _, s := range m.Sources {
+		if s != "" && strings.Contains(source, strings.ToLower(s)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Split splits orders into subscription and other orders, keeping their order
+func (m SubscriptionMatcher) Split(orders []Order) ([]Order, []Order) {
+	subscription := []Order{}
+	other := []Order{}
+	for i := range orders {
+		if m.Match(&orders[i]) {
+			subscription = append(subscription, orders[i])
+		} else {
+			other = append(other, orders[i])
+		}
+	}
+	return subscription, other
+}
+
+// IsSubscription returns true if DefaultSubscriptionMatcher matches the order
+func (o *Order) IsSubscription() bool {
+	return DefaultSubscriptionMatcher.Match(o)
+}
+
+// OrderOrigin is where an order came from, for routing decisions
+type OrderOrigin struct {
+	ChannelID      int64  `json:"channel_id"`
+	OrderSource    string `json:"order_source"`
+	ExternalSource string `json:"external_source"`
+	ExternalID     string `json:"external_id"`
+	Subscription   bool   `json:"subscription"`
+}
+
+// Origin returns the origin of an order, tagged as subscription by matcher
+func (m SubscriptionMatcher) Origin(o *Order) OrderOrigin {
+	origin := OrderOrigin{
+		ChannelID:      o.ChannelID,
+		OrderSource:    o.OrderSource,
+		ExternalSource: o.ExternalSource,
+		Subscription:   m.Match(o),
+	}
+	if o.ExternalID != nil {
+		origin.ExternalID = fmt.Sprint(o.ExternalID)
+	}
+	return origin
+}
+
+// ShippingCostBreakdown is what the customer was charged for shipping and handling, parsed from the order's money strings
+type ShippingCostBreakdown struct {
+	BaseShipping   float64 `json:"base_shipping"`
+	ShippingExTax  float64 `json:"shipping_ex_tax"`
+	ShippingIncTax float64 `json:"shipping_inc_tax"`
+	ShippingTax    float64 `json:"shipping_tax"`
+	BaseHandling   float64 `json:"base_handling"`
+	HandlingExTax  float64 `json:"handling_ex_tax"`
+	HandlingIncTax float64 `json:"handling_inc_tax"`
+	HandlingTax    float64 `json:"handling_tax"`
+	BaseWrapping   float64 `json:"base_wrapping"`
+	WrappingExTax  float64 `json:"wrapping_ex_tax"`
+	WrappingIncTax float64 `json:"wrapping_inc_tax"`
+	WrappingTax    float64 `json:"wrapping_tax"`
+	ChargedExTax   float64 `json:"charged_ex_tax"`  // shipping + handling, ex tax
+	ChargedIncTax  float64 `json:"charged_inc_tax"` // shipping + handling, inc tax
+}
+
+// ShippingCostBreakdown returns the shipping, handling and wrapping costs charged on the order
+func (o *Order) ShippingCostBreakdown() ShippingCostBreakdown {
+	b := ShippingCostBreakdown{
+		BaseShipping:   parseAmount(o.BaseShippingCost),
+		ShippingExTax:  parseAmount(o.ShippingCostExTax),
+		ShippingIncTax: parseAmount(o.ShippingCostIncTax),
+		ShippingTax:    parseAmount(o.ShippingCostTax),
+		BaseHandling:   parseAmount(o.BaseHandlingCost),
+		HandlingExTax:  parseAmount(o.HandlingCostExTax),
+		HandlingIncTax: parseAmount(o.HandlingCostIncTax),
+		HandlingTax:    parseAmount(o.HandlingCostTax),
+		BaseWrapping:   parseAmount(o.BaseWrappingCost),
+		WrappingExTax:  parseAmount(o.WrappingCostExTax),
+		WrappingIncTax: parseAmount(o.WrappingCostIncTax),
+		WrappingTax:    parseAmount(o.WrappingCostTax),
+	}
+	b.ChargedExTax = b.ShippingExTax + b.HandlingExTax
+	b.ChargedIncTax = b.ShippingIncTax + b.HandlingIncTax
+	return b
+}
+
+// MerchantShippingCost returns the sum of MerchantShippingCost recorded on shipments
+func MerchantShippingCost(shipments []Shipment) float64 {
+	total := 0.0
+	for _, s := range shipments {
+		total += parseAmount(s.MerchantShippingCost)
+	}
+	return total
+}
+
+// ShippingMargin returns shipping and handling charged to the customer (ex tax)
+// minus what the merchant paid for the order's shipments
+func (o *Order) ShippingMargin(shipments []Shipment) float64 {
+	return o.ShippingCostBreakdown().ChargedExTax - MerchantShippingCost(shipments)
+}
+
+// GetOrderShippingMargin fetches the order's shipments and returns its ShippingMargin
+func (bc *Client) GetOrderShippingMargin(order *Order) (float64, error) {
+	shipments, err := bc.GetAllOrderShipments(order.ID)
+	if err != nil && err != ErrNoContent {
+		return 0, err
+	}
+	return order.ShippingMargin(shipments), nil
+}
@@ -2,9 +2,7 @@ package bigcommerce
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"net/http"
 	"time"
 )
@@ -26,12 +24,12 @@ type PageBuilderTemplate struct {
 }
 
 func (bc *Client) CreateWidgetTemplate(pt *PageBuilderTemplate) (*PageBuilderTemplate, error) {
-	ptJSON, err := json.Marshal(pt)
+	ptJSON, err := bc.marshal(pt)
 	if err != nil {
 		return nil, err
 	}
 	req := bc.getAPIRequest(http.MethodPost, "/v3/content/widget-templates", bytes.NewReader(ptJSON))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return pt, err
 	}
@@ -39,11 +37,11 @@ func (bc *Client) CreateWidgetTemplate(pt *PageBuilderTemplate) (*PageBuilderTem
 	var ptRes struct {
 		Data PageBuilderTemplate `json:"data"`
 	}
-	b, err := ioutil.ReadAll(res.Body)
+	b, err := processBody(res)
 	if err != nil {
 		return pt, err
 	}
-	err = json.Unmarshal(b, &ptRes)
+	err = bc.unmarshal(b, &ptRes)
 	if ptRes.Data.UUID == "" {
 		return pt, fmt.Errorf("error creating widget template: %s", string(b))
 	}
@@ -52,7 +50,7 @@ func (bc *Client) CreateWidgetTemplate(pt *PageBuilderTemplate) (*PageBuilderTem
 
 func (bc *Client) GetWidgetTemplates() ([]PageBuilderTemplate, error) {
 	req := bc.getAPIRequest(http.MethodGet, "/v3/content/widget-templates", nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -60,11 +58,11 @@ func (bc *Client) GetWidgetTemplates() ([]PageBuilderTemplate, error) {
 	var ptRes struct {
 		Data []PageBuilderTemplate `json:"data"`
 	}
-	b, err := ioutil.ReadAll(res.Body)
+	b, err := processBody(res)
 	if err != nil {
 		return nil, err
 	}
-	err = json.Unmarshal(b, &ptRes)
+	err = bc.unmarshal(b, &ptRes)
 	if ptRes.Data == nil {
 		return nil, fmt.Errorf("error getting widget templates: %s", string(b))
 	}
@@ -73,13 +71,14 @@ func (bc *Client) GetWidgetTemplates() ([]PageBuilderTemplate, error) {
 
 func (bc *Client) DeleteWidgetTemplate(uuid string) error {
 	req := bc.getAPIRequest(http.MethodDelete, fmt.Sprintf("/v3/content/widget-templates/%s", uuid), nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
-	if res.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("error deleting widget template: %d", res.StatusCode)
+	_, err = processBody(res)
+	if err != nil && err != ErrNoContent {
+		return fmt.Errorf("error deleting widget template: %w", err)
 	}
 	return nil
 }
@@ -0,0 +1,70 @@
+package bigcommerce
+
+import (
+	"strconv"
+)
+
+// Page is a page of a v3 list endpoint
+type Page[T any] struct {
+	Data       []T
+	Pagination Pagination
+}
+
+// More returns true if there are pages after this one
+func (p *Page[T]) More() bool {
+	return p.Pagination.CurrentPage < p.Pagination.TotalPages
+}
+
+// Cursor returns the query of the next page, like "?page=2&limit=50", empty on the last page.
+// Pass it to ListPage as path suffix to fetch the next page
+func (p *Page[T]) Cursor() string {
+	if !p.More() {
+		return ""
+	}
+	if p.Pagination.Links.Next != "" {
+		return p.Pagination.Links.Next
+	}
+	return "?page=" + strconv.Itoa(p.Pagination.CurrentPage+1) + "&limit=" + strconv.Itoa(p.Pagination.PerPage)
+}
+
+// ListPage gets a page of a v3 list endpoint, url includes the query, e.g. "/v3/catalog/brands?page=2".
+// A 204 response is an empty last page
+func ListPage[T any](bc *Client, url string) (*Page[T], error) {
+	var pp struct {
+		Data []T `json:"data"`
+		Meta struct {
+			Pagination Pagination `json:"pagination"`
+		} `json:"meta"`
+	}
+	err := bc.getJSON(url, &pp)
+	if err != nil {
+		if err == ErrNoContent {
+			return &Page[T]{Data: []T{}}, nil
+		}
+		return nil, err
+	}
+	if pp.Data == nil {
+		pp.Data = []T{}
+	}
+	return &Page[T]{Data: pp.Data, Pagination: pp.Meta.Pagination}, nil
+}
+
+// ListPages gets all pages of a v3 list endpoint, args is a key-value map of additional arguments to pass to the API.
+// Pages are requested with limit 250 unless args sets a limit
+func ListPages[T any](bc *Client, path string, args map[string]string) ([]T, error) {
+	args = copyArgs(args)
+	if args["limit"] == "" {
+		args["limit"] = "250"
+	}
+	all := []T{}
+	for page := 1; ; page++ {
+		p, err := ListPage[T](bc, newURL(path).Int("page", int64(page)).Args(args).String())
+		if err != nil {
+			return all, err
+		}
+		all = append(all, p.Data...)
+		if !p.More() {
+			return all, nil
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package bigcommerce
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestListPages(t *testing.T) {
+	tests := []struct {
+		name      string
+		n         int
+		args      map[string]string
+		failPage  int
+		wantLimit string
+		wantPages int
+		wantItems int
+		wantErr   bool
+	}{
+		{"empty", 0, nil, 0, "250", 1, 0, false},
+		{"one page", 3, nil, 0, "250", 1, 3, false},
+		{"exact pages", 500, nil, 0, "250", 2, 500, false},
+		{"partial last page", 501, nil, 0, "250", 3, 501, false},
+		{"limit from args", 25, map[string]string{"limit": "10"}, 0, "10", 3, 25, false},
+		{"error keeps earlier pages", 600, nil, 2, "250", 2, 250, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limits := []string{}
+			srv := brandsServer(t, tt.n, tt.failPage, &limits)
+			defer srv.Close()
+			bc := newTestClient(srv)
+
+			brands, err := ListPages[Brand](bc, "/v3/catalog/brands", tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if len(brands) != tt.wantItems || len(limits) != tt.wantPages {
+				t.Errorf("got %d brands in %d requests, want %d in %d", len(brands), len(limits), tt.wantItems, tt.wantPages)
+			}
+			for i, b := range brands {
+				if b.ID != int64(i+1) {
+					t.Fatalf("brand %d has ID %d", i, b.ID)
+				}
+			}
+			for _, l := range limits {
+				if l != tt.wantLimit {
+					t.Errorf("got limit %s, want %s", l, tt.wantLimit)
+				}
+			}
+			if tt.args != nil && len(tt.args) != 1 {
+				t.Errorf("args were modified: %v", tt.args)
+			}
+		})
+	}
+}
+
+func TestPageCursor(t *testing.T) {
+	tests := []struct {
+		pagination Pagination
+		want       string
+	}{
+		{Pagination{CurrentPage: 1, TotalPages: 3, PerPage: 50}, "?page=2&limit=50"},
+		{Pagination{CurrentPage: 3, TotalPages: 3, PerPage: 50}, ""},
+		{Pagination{CurrentPage: 0, TotalPages: 0}, ""},
+	}
+	for _, tt := range tests {
+		p := Page[Brand]{Pagination: tt.pagination}
+		if got := p.Cursor(); got != tt.want {
+			t.Errorf("page %d of %d: got cursor %q, want %q", tt.pagination.CurrentPage, tt.pagination.TotalPages, got, tt.want)
+		}
+	}
+}
+
+func TestListPagesIgnoresPageArg(t *testing.T) {
+	limits := []string{}
+	srv := brandsServer(t, 30, 0, &limits)
+	defer srv.Close()
+	var responses []Response
+	bc := newTestClient(srv).WithResponses(&responses)
+
+	brands, err := ListPages[Brand](bc, "/v3/catalog/brands", map[string]string{"page": "3", "limit": "10"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(brands) != 30 {
+		t.Errorf("got %d brands, want all 30", len(brands))
+	}
+	for _, r := range responses {
+		if u, err := url.Parse(r.URL); err != nil || len(u.Query()["page"]) != 1 {
+			t.Errorf("got URL %s, want one page parameter", r.URL)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package bigcommerce
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// DiscrepancyType is the kind of a PaymentDiscrepancy
+type DiscrepancyType string
+
+// The discrepancies ReconcileOrderPayments reports
+const (
+	// DiscrepancyUnderCaptured is a paid order whose gateway captured less than the customer owes
+	DiscrepancyUnderCaptured DiscrepancyType = "under_captured"
+	// DiscrepancyOverCaptured is an order whose gateway captured more than the customer owes
+	DiscrepancyOverCaptured DiscrepancyType = "over_captured"
+	// DiscrepancyOverRefunded is an order that refunded more than was paid
+	DiscrepancyOverRefunded DiscrepancyType = "over_refunded"
+	// DiscrepancyRefundedAmount is an order whose refunded amount differs from the sum of its refunds
+	DiscrepancyRefundedAmount DiscrepancyType = "refunded_amount"
+	// DiscrepancyRefundTransactions is an order whose refunds to the gateway differ from its refund transactions
+	DiscrepancyRefundTransactions DiscrepancyType = "refund_transactions"
+	// DiscrepancyFailedTransaction is a transaction that ended in an error
+	DiscrepancyFailedTransaction DiscrepancyType = "failed_transaction"
+	// DiscrepancyCurrency is a transaction in another currency than the order, its amount is left out
+	DiscrepancyCurrency DiscrepancyType = "currency"
+)
+
+// reconciliationTolerance is the difference in amounts that is rounding, not a discrepancy
+const reconciliationTolerance = 0.005
+
+// PaymentDiscrepancy is a mismatch between the amounts of an order and its payments
+type PaymentDiscrepancy struct {
+	Type     DiscrepancyType `json:"type"`
+	Expected float64         `json:"expected"`
+	Actual   float64         `json:"actual"`
+	// TransactionID is the transaction the discrepancy is about, 0 when it is about the order
+	TransactionID int64  `json:"transaction_id,omitempty"`
+	Message       string `json:"message"`
+}
+
+// PaymentReconciliation compares what an order says was paid and refunded with its refunds and gateway
+// transactions, amounts are in the currency of the order
+type PaymentReconciliation struct {
+	OrderID       int64   `json:"order_id"`
+	Currency      string  `json:"currency"`
+	PaymentStatus string  `json:"payment_status"`
+	Total         float64 `json:"total"`
+	// StoreCredit and GiftCertificates are the parts of Total not paid through the gateway
+	StoreCredit      float64 `json:"store_credit"`
+	GiftCertificates float64 `json:"gift_certificates"`
+	// Captured is the amount of successful purchase and capture transactions
+	Captured float64 `json:"captured"`
+	// Refunded is the refunded amount of the order
+	Refunded float64 `json:"refunded"`
+	// RefundTotal is the sum of the refunds of the order
+	RefundTotal float64 `json:"refund_total"`
+	// RefundedByGateway is the amount of successful refund transactions
+	RefundedByGateway float64 `json:"refunded_by_gateway"`
+	// Offline is set for orders without gateway transactions, e.g. paid by bank transfer, their capture isn't checked
+	Offline       bool                 `json:"offline"`
+	Discrepancies []PaymentDiscrepancy `json:"discrepancies"`
+}
+
+// OK returns true when no discrepancies were found
+func (r *PaymentReconciliation) OK() bool {
+	return len(r.Discrepancies) == 0
+}
+
+// GatewayDue returns the amount the customer owes through the gateway, the total without store credit and gift certificates
+func (r *PaymentReconciliation) GatewayDue() float64 {
+	return round2(r.Total - r.StoreCredit - r.GiftCertificates)
+}
+
+// ReconcileOrderPayments compares the totals of an order with its refunds and payment transactions and reports
+// the discrepancies, e.g. a paid order whose gateway captured less than its total. Reading refunds and
+// transactions needs the Order Transactions scope
+func (bc *Client) ReconcileOrderPayments(orderID int64) (*PaymentReconciliation, error) {
+	order, err := bc.GetOrder(orderID)
+	if err != nil {
+		return nil, err
+	}
+	refunds, err := bc.GetOrderRefunds(orderID)
+	if err != nil {
+		return nil, err
+	}
+	transactions, err := bc.GetOrderTransactions(orderID)
+	if err != nil {
+		return nil, err
+	}
+	return reconcileOrderPayments(order, refunds, transactions), nil
+}
+
+// reconcileOrderPayments builds the reconciliation of an order from its refunds and transactions
+func reconcileOrderPayments(order *Order, refunds []OrderRefund, transactions []OrderTransaction) *PaymentReconciliation {
+	r := &PaymentReconciliation{
+		OrderID:          order.ID,
+		Currency:         order.CurrencyCode,
+		PaymentStatus:    order.PaymentStatus,
+		Total:            orderTotal(order),
+		StoreCredit:      parseAmount(order.StoreCreditAmount),
+		GiftCertificates: parseAmount(order.GiftCertificateAmount),
+		Refunded:         parseAmount(order.RefundedAmount),
+		Offline:          len(transactions) == 0,
+		Discrepancies:    []PaymentDiscrepancy{},
+	}
+	add := func(t DiscrepancyType, expected, actual float64, transactionID int64, format string, args ...interface{}) {
+		r.Discrepancies = append(r.Discrepancies, PaymentDiscrepancy{
+			Type:          t,
+			Expected:      round2(expected),
+			Actual:        round2(actual),
+			TransactionID: transactionID,
+			Message:       fmt.Sprintf(format, args...),
+		})
+	}
+
+	for _, t := range transactions {
+		if t.Status != "" && t.Status != "ok" {
+			add(DiscrepancyFailedTransaction, 0, t.Amount, t.ID, "%s transaction %d via %s failed", t.Event, t.ID, t.Gateway)
+			continue
+		}
+		if t.Currency != "" && r.Currency != "" && !strings.EqualFold(t.Currency, r.Currency) {
+			add(DiscrepancyCurrency, 0, t.Amount, t.ID, "transaction %d is in %s, the order in %s", t.ID, t.Currency, r.Currency)
+			continue
+		}
+		switch t.Event {
+		case "purchase", "capture":
+			r.Captured += t.Amount
+		case "refund":
+			r.RefundedByGateway += t.Amount
+		}
+	}
+	gatewayRefunds := 0.0
+	for _, refund := range refunds {
+		r.RefundTotal += refund.TotalAmount
+		for _, p := range refund.Payments {
+			if !p.Offline && !p.IsDeclined && p.ProviderID != "storecredit" {
+				gatewayRefunds += p.Amount
+			}
+		}
+	}
+	r.Captured = round2(r.Captured)
+	r.RefundedByGateway = round2(r.RefundedByGateway)
+	r.RefundTotal = round2(r.RefundTotal)
+
+	due := r.GatewayDue()
+	if !r.Offline {
+		if r.Captured > due+reconciliationTolerance {
+			add(DiscrepancyOverCaptured, due, r.Captured, 0, "captured %.2f of %.2f due", r.Captured, due)
+		} else if r.Captured < due-reconciliationTolerance && paymentExpectsCapture(r.PaymentStatus) {
+			add(DiscrepancyUnderCaptured, due, r.Captured, 0, "captured %.2f of %.2f due with payment status %q", r.Captured, due, r.PaymentStatus)
+		}
+		if !amountsEqual(gatewayRefunds, r.RefundedByGateway) {
+			add(DiscrepancyRefundTransactions, gatewayRefunds, r.RefundedByGateway, 0, "refunded %.2f to the gateway, its transactions refunded %.2f", gatewayRefunds, r.RefundedByGateway)
+		}
+	}
+	if len(refunds) > 0 && !amountsEqual(r.Refunded, r.RefundTotal) {
+		add(DiscrepancyRefundedAmount, r.RefundTotal, r.Refunded, 0, "order refunded amount %.2f, its refunds add up to %.2f", r.Refunded, r.RefundTotal)
+	}
+	paid := r.Total
+	if !r.Offline {
+		paid = r.Captured + r.StoreCredit + r.GiftCertificates
+	}
+	if refunded := math.Max(r.Refunded, r.RefundTotal); refunded > paid+reconciliationTolerance {
+		add(DiscrepancyOverRefunded, paid, refunded, 0, "refunded %.2f of %.2f paid", refunded, paid)
+	}
+	return r
+}
+
+// paymentExpectsCapture returns true for payment statuses of orders that should be paid in full
+func paymentExpectsCapture(status string) bool {
+	switch strings.ToLower(status) {
+	case "captured", "paid", "partially refunded", "refunded":
+		return true
+	}
+	return false
+}
+
+func amountsEqual(a, b float64) bool {
+	return math.Abs(a-b) <= reconciliationTolerance
+}
+
+func round2(f float64) float64 {
+	return math.Round(f*100) / 100
+}
@@ -0,0 +1,280 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// PaymentInstrument is the instrument used to pay for an order
+// Type is "card" for raw card data or a stored instrument type like "stored_card" with Token set
+type PaymentInstrument struct {
+	Type              string `json:"type"`
+	Number            string `json:"number,omitempty"`
+	CardholderName    string `json:"cardholder_name,omitempty"`
+	ExpiryMonth       int    `json:"expiry_month,omitempty"`
+	ExpiryYear        int    `json:"expiry_year,omitempty"`
+	VerificationValue string `json:"verification_value,omitempty"`
+	Token             string `json:"token,omitempty"`
+}
+
+// PaymentRequest is the payment sent to the BigCommerce payments API
+type PaymentRequest struct {
+	Instrument      PaymentInstrument `json:"instrument"`
+	PaymentMethodID string            `json:"payment_method_id"`
+	SaveInstrument  bool              `json:"save_instrument,omitempty"`
+}
+
+// PaymentResult is the result of a processed payment
+type PaymentResult struct {
+	ID              string `json:"id"`
+	TransactionType string `json:"transaction_type"`
+	Status          string `json:"status"`
+}
+
+var ErrPaymentDeclined = errors.New("payment declined")
+var ErrPaymentConfiguration = errors.New("payment configuration error")
+
+// paymentDeclineCodes are payments API error codes caused by the instrument, not by the store setup
+var paymentDeclineCodes = map[string]bool{
+	"card_declined":             true,
+	"insufficient_funds":        true,
+	"expired_card":              true,
+	"incorrect_cvc":             true,
+	"invalid_cvc":               true,
+	"invalid_number":            true,
+	"invalid_expiry_date":       true,
+	"incorrect_number":          true,
+	"fraud_detected":            true,
+	"transaction_not_permitted": true,
+	"three_d_secure_required":   true,
+}
+
+// PaymentError is returned by ProcessPayment and PayOrder when the payments API rejects a payment
+// errors.Is(err, ErrPaymentDeclined) tells declines apart from ErrPaymentConfiguration errors
+type PaymentError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Kind       error
+}
+
+func (e *PaymentError) Error() string {
+	return fmt.Sprintf("%v: %d %s %s", e.Kind, e.StatusCode, e.Code, e.Message)
+}
+
+func (e *PaymentError) Unwrap() error {
+	return e.Kind
+}
+
+// PayOrder creates a payment access token for the order and processes the payment with it
+func (bc *Client) PayOrder(orderID int64, payment PaymentRequest) (*PaymentResult, error) {
+	token, err := bc.CreatePaymentAccessToken(orderID)
+	if err != nil {
+		return nil, err
+	}
+	return bc.ProcessPayment(token, payment)
+}
+
+// CreatePaymentAccessToken creates a payment access token (PAT) for an order
+func (bc *Client) CreatePaymentAccessToken(orderID int64) (string, error) {
+	reqJSON, _ := bc.marshal(map[string]interface{}{
+		"order": map[string]int64{"id": orderID},
+	})
+	req := bc.getAPIRequest(http.MethodPost, "/v3/payments/access_tokens", bytes.NewReader(reqJSON))
+	res, err := bc.do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil {
+		return "", fmt.Errorf("%w %s", err, string(body))
+	}
+	var tokenResponse struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	err = bc.unmarshal(body, &tokenResponse)
+	if err != nil {
+		return "", err
+	}
+	return tokenResponse.Data.ID, nil
+}
+
+// ProcessPayment submits a payment for the order the access token was created for
+func (bc *Client) ProcessPayment(accessToken string, payment PaymentRequest) (*PaymentResult, error) {
+	reqJSON, err := bc.marshal(map[string]interface{}{
+		"payment": payment,
+	})
+	if err != nil {
+		return nil, err
+	}
+	req := bc.getPaymentsRequest(http.MethodPost, "/payments", accessToken, bytes.NewReader(reqJSON))
+	res, err := bc.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil {
+		if res.StatusCode > 299 {
+			return nil, newPaymentError(res.StatusCode, body)
+		}
+		return nil, err
+	}
+	var paymentResponse struct {
+		Data PaymentResult `json:"data"`
+	}
+	err = bc.unmarshal(body, &paymentResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &paymentResponse.Data, nil
+}
+
+// getPaymentsRequest builds a request for the payments host, which authenticates with a PAT instead of X-Auth-Token
+func (bc *Client) getPaymentsRequest(method, url, accessToken string, body io.Reader) *http.Request {
+	req, _ := http.NewRequestWithContext(bc.requestContext(), method, bc.paymentsBaseURL()+"/stores/"+bc.StoreHash+url, body)
+	req.Header.Add("Authorization", "PAT "+accessToken)
+	req.Header.Add("Accept", "application/vnd.bc.v1+json")
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("User-Agent", bc.userAgent())
+	return req
+}
+
+// newPaymentError maps a payments API error response to a PaymentError
+func newPaymentError(statusCode int, body []byte) *PaymentError {
+	pe := &PaymentError{StatusCode: statusCode, Kind: ErrPaymentConfiguration}
+	var errResp struct {
+		Title  string          `json:"title"`
+		Errors json.RawMessage `json:"errors"`
+	}
+	if json.Unmarshal(body, &errResp) != nil {
+		pe.Message = string(body)
+		return pe
+	}
+	pe.Message = errResp.Title
+	var list []struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	var kv map[string]string
+	if json.Unmarshal(errResp.Errors, &list) == nil && len(list) > 0 {
+		pe.Code = list[0].Code
+		if list[0].Message != "" {
+			pe.Message = list[0].Message
+		}
+	} else if json.Unmarshal(errResp.Errors, &kv) == nil {
+		for k, v := range kv {
+			pe.Code = k
+			pe.Message = v
+			break
+		}
+	}
+	if paymentDeclineCodes[pe.Code] || statusCode == http.StatusPaymentRequired {
+		pe.Kind = ErrPaymentDeclined
+	}
+	return pe
+}
+
+// StoredInstrument is a payment instrument a customer saved in the store (card, PayPal account, bank account)
+type StoredInstrument struct {
+	Type                       string          `json:"type"`
+	Token                      string          `json:"token"`
+	IsDefault                  bool            `json:"is_default"`
+	Brand                      string          `json:"brand,omitempty"`
+	ExpiryMonth                int             `json:"expiry_month,omitempty"`
+	ExpiryYear                 int             `json:"expiry_year,omitempty"`
+	IssuerIdentificationNumber string          `json:"issuer_identification_number,omitempty"`
+	Last4                      string          `json:"last_4,omitempty"`
+	Email                      string          `json:"email,omitempty"`
+	BillingAddress             CheckoutAddress `json:"billing_address"`
+}
+
+// GetCustomerStoredInstruments returns the stored payment instruments of a customer
+func (bc *Client) GetCustomerStoredInstruments(customerID int64) ([]StoredInstrument, error) {
+	req := bc.getAPIRequest(http.MethodGet, "/v3/customers/"+strconv.FormatInt(customerID, 10)+"/stored-instruments", nil)
+	res, err := bc.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil {
+		if err == ErrNoContent {
+			return []StoredInstrument{}, nil
+		}
+		return nil, err
+	}
+	var instruments []StoredInstrument
+	if bc.unmarshal(body, &instruments) == nil {
+		return instruments, nil
+	}
+	var instrumentsResponse struct {
+		Data []StoredInstrument `json:"data"`
+	}
+	err = bc.codec().Unmarshal(body, &instrumentsResponse)
+	if err != nil {
+		return nil, err
+	}
+	return instrumentsResponse.Data, nil
+}
+
+// GetOrderStoredInstruments returns the stored payment instruments of the customer who placed the order
+func (bc *Client) GetOrderStoredInstruments(orderID int64) ([]StoredInstrument, error) {
+	order, err := bc.GetOrder(orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.CustomerID == 0 {
+		return nil, fmt.Errorf("order %d was placed by a guest", orderID)
+	}
+	return bc.GetCustomerStoredInstruments(order.CustomerID)
+}
+
+// PayOrderWithStoredInstrument pays an order with one of its customer's stored instruments (merchant initiated)
+// token: the stored instrument token, when empty the customer's default instrument is used
+func (bc *Client) PayOrderWithStoredInstrument(orderID int64, paymentMethodID, token string) (*PaymentResult, error) {
+	instruments, err := bc.GetOrderStoredInstruments(orderID)
+	if err != nil {
+		return nil, err
+	}
+	var instrument *StoredInstrument
+	for i := range instruments {
+		if (token == "" && instruments[i].IsDefault) || (token != "" && instruments[i].Token == token) {
+			instrument = &instruments[i]
+			break
+		}
+	}
+	if instrument == nil {
+		return nil, fmt.Errorf("stored instrument not found for order %d: %w", orderID, ErrNotFound)
+	}
+	return bc.PayOrder(orderID, PaymentRequest{
+		PaymentMethodID: paymentMethodID,
+		Instrument: PaymentInstrument{
+			Type:  instrument.Type,
+			Token: instrument.Token,
+		},
+	})
+}
+
+// CaptureOrderPayment captures the authorized payment of an order
+func (bc *Client) CaptureOrderPayment(orderID int64) error {
+	req := bc.getAPIRequest(http.MethodPost, "/v3/orders/"+strconv.FormatInt(orderID, 10)+"/payment_actions/capture", nil)
+	res, err := bc.do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil {
+		return fmt.Errorf("error capturing payment for order %d: %w %s", orderID, err, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package bigcommerce
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// PickupMethod is a buy-online-pickup-in-store method offered at a location
+type PickupMethod struct {
+	ID                        int64  `json:"id,omitempty"`
+	LocationID                int64  `json:"location_id"`
+	DisplayName               string `json:"display_name"`
+	CollectionInstructions    string `json:"collection_instructions,omitempty"`
+	CollectionTimeDescription string `json:"collection_time_description,omitempty"`
+}
+
+// PickupOptionsRequest asks which pickup methods can fulfill items near a location
+type PickupOptionsRequest struct {
+	SearchArea PickupSearchArea    `json:"search_area"`
+	Items      []PickupOptionsItem `json:"items"`
+}
+
+// PickupSearchArea is a radius around coordinates
+type PickupSearchArea struct {
+	Radius struct {
+		Value float64 `json:"value"`
+		Unit  string  `json:"unit"` // KM or MI
+	} `json:"radius"`
+	Coordinates struct {
+		Latitude  float64 `json:"latitude"`
+		Longitude float64 `json:"longitude"`
+	} `json:"coordinates"`
+}
+
+// PickupOptionsItem is a variant and quantity to pick up
+type PickupOptionsItem struct {
+	VariantID int64 `json:"variant_id"`
+	Quantity  int   `json:"quantity"`
+}
+
+// PickupOption is a pickup method that can fulfill the requested items
+type PickupOption struct {
+	PickupMethodID  int64               `json:"pickup_method_id"`
+	AvailableItems  []PickupOptionsItem `json:"available_items"`
+	PickupMethod    *PickupMethod       `json:"pickup_method,omitempty"`
+	LocationID      int64               `json:"location_id,omitempty"`
+	DistanceToStore float64             `json:"distance_to_store,omitempty"`
+}
+
+// Pickup assigns order products to a pickup method
+type Pickup struct {
+	ID             int64        `json:"id,omitempty"`
+	OrderID        int64        `json:"order_id,omitempty"`
+	PickupMethodID int64        `json:"pickup_method_id"`
+	PickupItems    []PickupItem `json:"pickup_items"`
+}
+
+// PickupItem is an order product and quantity picked up
+type PickupItem struct {
+	OrderProductID int64 `json:"order_product_id"`
+	Quantity       int   `json:"quantity"`
+}
+
+// GetPickupMethods returns all pickup methods
+func (bc *Client) GetPickupMethods() ([]PickupMethod, error) {
+	var ret struct {
+		Data []PickupMethod `json:"data"`
+	}
+	err := bc.getJSON("/v3/pickup/methods", &ret)
+	if err != nil {
+		if err == ErrNoContent {
+			return []PickupMethod{}, nil
+		}
+		return nil, err
+	}
+	return ret.Data, nil
+}
+
+// CreatePickupMethods creates pickup methods
+func (bc *Client) CreatePickupMethods(methods []PickupMethod) ([]PickupMethod, error) {
+	var ret struct {
+		Data []PickupMethod `json:"data"`
+	}
+	err := bc.sendJSON(http.MethodPost, "/v3/pickup/methods", methods, &ret)
+	return ret.Data, err
+}
+
+// UpdatePickupMethods updates pickup methods, IDs are required
+func (bc *Client) UpdatePickupMethods(methods []PickupMethod) ([]PickupMethod, error) {
+	var ret struct {
+		Data []PickupMethod `json:"data"`
+	}
+	err := bc.sendJSON(http.MethodPut, "/v3/pickup/methods", methods, &ret)
+	return ret.Data, err
+}
+
+// DeletePickupMethods deletes pickup methods
+func (bc *Client) DeletePickupMethods(ids []int64) error {
+	return bc.sendJSON(http.MethodDelete, newURL("/v3/pickup/methods").Param("id:in", joinIDs(ids)).String(), nil, nil)
+}
+
+// GetPickupOptions returns the pickup methods that can fulfill the items in the search area
+func (bc *Client) GetPickupOptions(request PickupOptionsRequest) ([]PickupOption, error) {
+	var ret struct {
+		Data []struct {
+			PickupOptions []PickupOption `json:"pickup_options"`
+		} `json:"data"`
+	}
+	err := bc.sendJSON(http.MethodPost, "/v3/pickup/options", request, &ret)
+	if err != nil {
+		return nil, err
+	}
+	options := []PickupOption{}
+	for _, d := range ret.Data {
+		options = append(options, d.PickupOptions...)
+	}
+	return options, nil
+}
+
+// GetOrderPickups returns the pickups of orders
+func (bc *Client) GetOrderPickups(orderIDs ...int64) ([]Pickup, error) {
+	var ret struct {
+		Data []Pickup `json:"data"`
+	}
+	err := bc.getJSON(newURL("/v3/orders/pickups").Param("order_id:in", joinIDs(orderIDs)).String(), &ret)
+	if err != nil {
+		if err == ErrNoContent {
+			return []Pickup{}, nil
+		}
+		return nil, err
+	}
+	return ret.Data, nil
+}
+
+// CreateOrderPickups creates pickups for orders, e.g. when the customer collected the items
+func (bc *Client) CreateOrderPickups(pickups []Pickup) ([]Pickup, error) {
+	var ret struct {
+		Data []Pickup `json:"data"`
+	}
+	err := bc.sendJSON(http.MethodPost, "/v3/orders/pickups", pickups, &ret)
+	return ret.Data, err
+}
+
+// DeleteOrderPickups deletes order pickups
+func (bc *Client) DeleteOrderPickups(ids []int64) error {
+	return bc.sendJSON(http.MethodDelete, newURL("/v3/orders/pickups").Param("id:in", joinIDs(ids)).String(), nil, nil)
+}
+
+// joinIDs joins IDs for :in filters
+func joinIDs(ids []int64) string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = strconv.FormatInt(id, 10)
+	}
+	return strings.Join(s, ",")
+}
@@ -1,7 +1,6 @@
 package bigcommerce
 
 import (
-	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
@@ -56,7 +55,7 @@ func (bc *Client) GetPosts(page int) ([]Post, bool, error) {
 	url := "/v2/blog/posts?limit=250&page=" + strconv.Itoa(page)
 
 	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, false, err
 	}
@@ -68,7 +67,7 @@ func (bc *Client) GetPosts(page int) ([]Post, bool, error) {
 	}
 
 	var pp []Post
-	err = json.Unmarshal(body, &pp)
+	err = bc.unmarshal(body, &pp)
 	if err != nil {
 		log.Printf("Error unmarshalling posts: %s %s", err, string(body))
 		return nil, false, err
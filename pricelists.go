@@ -0,0 +1,163 @@
+package bigcommerce
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strings"
+)
+
+// priceListBatchSize is the most records BigCommerce accepts in one upsert
+const priceListBatchSize = 1000
+
+// PriceListRecord is the price of a variant in a currency on a price list
+type PriceListRecord struct {
+	PriceListID int64    `json:"price_list_id,omitempty"`
+	VariantID   int64    `json:"variant_id"`
+	Sku         string   `json:"sku,omitempty"`
+	Currency    string   `json:"currency"`
+	Price       float64  `json:"price"`
+	SalePrice   *float64 `json:"sale_price,omitempty"`
+	RetailPrice *float64 `json:"retail_price,omitempty"`
+	MapPrice    *float64 `json:"map_price,omitempty"`
+}
+
+// GetPriceListRecords returns all records of a price list, args are passed to the API, e.g. {"currency": "eur"}
+func (bc *Client) GetPriceListRecords(priceListID int64, args map[string]string) ([]PriceListRecord, error) {
+	return ListPages[PriceListRecord](bc, newURL("/v3/pricelists").ID(priceListID).Segment("records").String(), args)
+}
+
+// UpsertPriceListRecords creates or updates price list records, in batches of 1000
+func (bc *Client) UpsertPriceListRecords(priceListID int64, records []PriceListRecord) error {
+	url := newURL("/v3/pricelists").ID(priceListID).Segment("records").String()
+	for start := 0; start < len(records); start += priceListBatchSize {
+		end := start + priceListBatchSize
+		if end > len(records) {
+			end = len(records)
+		}
+		batch := make([]PriceListRecord, end-start)
+		copy(batch, records[start:end])
+		for i := range batch {
+			batch[i].PriceListID = 0
+		}
+		err := bc.sendJSON(http.MethodPut, url, batch, nil)
+		if err != nil {
+			return fmt.Errorf("error saving price list %d records %d-%d: %w", priceListID, start, end, err)
+		}
+	}
+	return nil
+}
+
+// PricingRule returns the price of a variant, false to leave the variant's price list record as it is
+type PricingRule func(p *Product, v *Variant) (float64, bool)
+
+// MarginByCategory returns a rule pricing variants at cost price times the margin of the product's first category
+// that has one, or defaultMargin. Variants without cost price are skipped
+func MarginByCategory(margins map[int64]float64, defaultMargin float64) PricingRule {
+	return func(p *Product, v *Variant) (float64, bool) {
+		cost := v.CostPrice
+		if cost == 0 {
+			cost = p.CostPrice
+		}
+		if cost <= 0 {
+			return 0, false
+		}
+		margin := defaultMargin
+		for _, id := range productCategoryIDs(p) {
+			if m, ok := margins[id]; ok {
+				margin = m
+				break
+			}
+		}
+		if margin <= 0 {
+			return 0, false
+		}
+		return cost * margin, true
+	}
+}
+
+// PriceChange is a variant price a recalculation sets
+type PriceChange struct {
+	ProductID int64   `json:"product_id"`
+	VariantID int64   `json:"variant_id"`
+	Sku       string  `json:"sku"`
+	OldPrice  float64 `json:"old_price"` // 0 when the variant had no record yet
+	NewPrice  float64 `json:"new_price"`
+}
+
+// RecalculateOptions configures RecalculatePriceList
+type RecalculateOptions struct {
+	// Currency of the records, required
+	Currency string
+	// DryRun only computes the changes, nothing is written
+	DryRun bool
+	// Diff, when set, gets one line per change: "SKU variant_id: old -> new"
+	Diff io.Writer
+	// Args filter the products to recalculate, e.g. {"categories:in": "23"}
+	Args map[string]string
+}
+
+// RecalculatePriceList applies rule to every variant of the catalog, one page of products at a time, and writes
+// the prices that changed as price list records. Prices are rounded to cents
+func (bc *Client) RecalculatePriceList(priceListID int64, rule PricingRule, opts RecalculateOptions) ([]PriceChange, error) {
+	if opts.Currency == "" {
+		return nil, fmt.Errorf("price list currency is required")
+	}
+	currency := strings.ToLower(opts.Currency)
+	existing, err := bc.GetPriceListRecords(priceListID, map[string]string{"currency": currency})
+	if err != nil {
+		return nil, err
+	}
+	current := map[int64]float64{}
+	for _, r := range existing {
+		current[r.VariantID] = r.Price
+	}
+
+	args := copyArgs(opts.Args)
+	args["include"] = "variants"
+	if args["limit"] == "" {
+		args["limit"] = "250"
+	}
+	changes := []PriceChange{}
+	records := []PriceListRecord{}
+	err = bc.scanProducts(args, func(p *Product) {
+		for i := range p.Variants {
+			v := &p.Variants[i]
+			price, ok := rule(p, v)
+			if !ok {
+				continue
+			}
+			price = math.Round(price*100) / 100
+			old, had := current[v.ID]
+			if had && old == price {
+				continue
+			}
+			changes = append(changes, PriceChange{ProductID: p.ID, VariantID: v.ID, Sku: v.Sku, OldPrice: old, NewPrice: price})
+			records = append(records, PriceListRecord{VariantID: v.ID, Currency: currency, Price: price})
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if opts.Diff != nil {
+		for _, c := range changes {
+			fmt.Fprintf(opts.Diff, "%s %d: %.2f -> %.2f\n", c.Sku, c.VariantID, c.OldPrice, c.NewPrice)
+		}
+	}
+	if opts.DryRun {
+		return changes, nil
+	}
+	return changes, bc.UpsertPriceListRecords(priceListID, records)
+}
+
+// productCategoryIDs returns the category IDs of a product, which decode as float64
+func productCategoryIDs(p *Product) []int64 {
+	ids := make([]int64, 0, len(p.Categories))
+	for _, c := range p.Categories {
+		if f, ok := c.(float64); ok {
+			ids = append(ids, int64(f))
+		}
+	}
+	return ids
+}
@@ -0,0 +1,48 @@
+package bigcommerce
+
+import (
+	"testing"
+)
+
+func TestUpsertPriceListRecordsBatches(t *testing.T) {
+	tests := []struct {
+		name      string
+		records   int
+		failBatch int
+		want      []int
+		wantErr   bool
+	}{
+		{"one batch", 999, 0, []int{999}, false},
+		{"full batch", 1000, 0, []int{1000}, false},
+		{"partial last batch", 2500, 0, []int{1000, 1000, 500}, false},
+		{"stops at a failed batch", 2500, 1, []int{1000}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, sizes := batchServer(t, "/stores/store/v3/pricelists/7/records", tt.failBatch)
+			defer srv.Close()
+			bc := newTestClient(srv)
+
+			records := make([]PriceListRecord, tt.records)
+			for i := range records {
+				records[i] = PriceListRecord{PriceListID: 7, VariantID: int64(i + 1), Currency: "usd", Price: 10}
+			}
+			err := bc.UpsertPriceListRecords(7, records)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %v", err, tt.wantErr)
+			}
+			got := sizes()
+			if len(got) != len(tt.want) {
+				t.Fatalf("got batches %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("got batches %v, want %v", got, tt.want)
+				}
+			}
+			if records[0].PriceListID != 7 {
+				t.Error("UpsertPriceListRecords modified the records passed in")
+			}
+		})
+	}
+}
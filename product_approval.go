@@ -0,0 +1,182 @@
+package bigcommerce
+
+import (
+	"fmt"
+)
+
+// ProductApprovalNamespace is the product metafield namespace the approval state is stored in
+const ProductApprovalNamespace = "product_approval"
+
+// productApprovalKey is the metafield key holding the approval state
+const productApprovalKey = "state"
+
+// Product approval states, products without a state are Draft
+const (
+	ProductStateDraft     = "draft"
+	ProductStateReview    = "review"
+	ProductStatePublished = "published"
+)
+
+// productTransitions lists the allowed transitions from each state
+var productTransitions = map[string][]string{
+	ProductStateDraft:     {ProductStateReview},
+	ProductStateReview:    {ProductStateDraft, ProductStatePublished},
+	ProductStatePublished: {ProductStateDraft},
+}
+
+// ProductTransition is a change of approval state, passed to the workflow hooks
+type ProductTransition struct {
+	ProductID int64
+	From      string
+	To        string
+	// Note is an optional comment, e.g. why a review was rejected, kept as the metafield description
+	Note string
+}
+
+// InvalidTransitionError is returned when a product can't move from its current state to the requested one
+type InvalidTransitionError struct {
+	ProductTransition
+}
+
+func (e *InvalidTransitionError) Error() string {
+	return fmt.Sprintf("product %d: can't move from %s to %s", e.ProductID, e.From, e.To)
+}
+
+// ProductWorkflow is an editorial Draft → Review → Published workflow on top of the catalog,
+// the state of each product is kept in a product metafield
+type ProductWorkflow struct {
+	client *Client
+	// SyncVisibility makes products visible when published and hides them when they leave Published
+	SyncVisibility bool
+	// BeforeTransition is called before a transition is saved, returning an error cancels it
+	BeforeTransition func(t ProductTransition) error
+	// AfterTransition is called once a transition is saved
+	AfterTransition func(t ProductTransition)
+}
+
+// ProductWorkflow returns the approval workflow for the store's products, with visibility sync enabled
+func (bc *Client) ProductWorkflow() *ProductWorkflow {
+	return &ProductWorkflow{client: bc, SyncVisibility: true}
+}
+
+// CanTransition returns true if a product may move from one state to the other
+func CanTransition(from, to string) bool {
+	for _, s := range productTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// State returns the approval state of a product
+func (w *ProductWorkflow) State(productID int64) (string, error) {
+	mf, err := w.stateMetafield(productID)
+	if err != nil {
+		return "", err
+	}
+	if mf == nil || mf.Value == "" {
+		return ProductStateDraft, nil
+	}
+	return mf.Value, nil
+}
+
+// Submit moves a draft product to review
+func (w *ProductWorkflow) Submit(productID int64, note string) error {
+	return w.Transition(productID, ProductStateReview, note)
+}
+
+// Approve publishes a product in review
+func (w *ProductWorkflow) Approve(productID int64, note string) error {
+	return w.Transition(productID, ProductStatePublished, note)
+}
+
+// Reject sends a product in review back to draft
+func (w *ProductWorkflow) Reject(productID int64, note string) error {
+	return w.Transition(productID, ProductStateDraft, note)
+}
+
+// Unpublish moves a published product back to draft
+func (w *ProductWorkflow) Unpublish(productID int64, note string) error {
+	return w.Transition(productID, ProductStateDraft, note)
+}
+
+// Transition moves a product to a new state, returns an *InvalidTransitionError if the move isn't allowed
+func (w *ProductWorkflow) Transition(productID int64, to, note string) error {
+	mf, err := w.stateMetafield(productID)
+	if err != nil {
+		return err
+	}
+	from := ProductStateDraft
+	if mf != nil && mf.Value != "" {
+		from = mf.Value
+	}
+	t := ProductTransition{ProductID: productID, From: from, To: to, Note: note}
+	if !CanTransition(from, to) {
+		return &InvalidTransitionError{t}
+	}
+	if w.BeforeTransition != nil {
+		err = w.BeforeTransition(t)
+		if err != nil {
+			return err
+		}
+	}
+	if mf == nil {
+		_, err = w.client.CreateProductMetafield(productID, Metafield{
+			Namespace:   ProductApprovalNamespace,
+			Key:         productApprovalKey,
+			Value:       to,
+			Description: note,
+		})
+	} else {
+		mf.Value = to
+		mf.Description = note
+		_, err = w.client.UpdateProductMetafield(productID, *mf)
+	}
+	if err != nil {
+		return err
+	}
+	if w.SyncVisibility && (to == ProductStatePublished || from == ProductStatePublished) {
+		err = w.client.batchUpdateProducts([]map[string]interface{}{
+			{"id": productID, "is_visible": to == ProductStatePublished},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if w.AfterTransition != nil {
+		w.AfterTransition(t)
+	}
+	return nil
+}
+
+// ListByState returns the IDs of products in a state, products that never had a state
+// are Draft but aren't listed, as they have no metafield to find them by
+func (w *ProductWorkflow) ListByState(state string) ([]int64, error) {
+	mfs, err := w.client.getMetafields("/v3/catalog/products/metafields", ProductApprovalNamespace)
+	if err != nil {
+		return nil, err
+	}
+	ids := []int64{}
+	for _, mf := range mfs {
+		if mf.Key == productApprovalKey && mf.Value == state {
+			ids = append(ids, mf.ResourceID)
+		}
+	}
+	return ids, nil
+}
+
+// stateMetafield returns the approval state metafield of a product, nil if it has none
+func (w *ProductWorkflow) stateMetafield(productID int64) (*Metafield, error) {
+	mfs, err := w.client.GetProductMetafieldsInNamespace(productID, ProductApprovalNamespace)
+	if err != nil {
+		return nil, fmt.Errorf("error getting approval state of product %d: %v", productID, err)
+	}
+	for _, mf := range mfs {
+		if mf.Key == productApprovalKey {
+			mf := mf
+			return &mf, nil
+		}
+	}
+	return nil, nil
+}
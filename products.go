@@ -1,9 +1,8 @@
 package bigcommerce
 
 import (
-	"encoding/json"
-	"errors"
-	"io/ioutil"
+	"bytes"
+	"fmt"
 	"log"
 	"net/http"
 	"strconv"
@@ -84,46 +83,18 @@ type Product struct {
 		URL          string `json:"url,omitempty"`
 		IsCustomized bool   `json:"is_customized,omitempty"`
 	} `json:"custom_url,omitempty"`
-	BaseVariantID               int64  `json:"base_variant_id,omitempty"`
-	OpenGraphType               string `json:"open_graph_type,omitempty"`
-	OpenGraphTitle              string `json:"open_graph_title,omitempty"`
-	OpenGraphDescription        string `json:"open_graph_description,omitempty"`
-	OpenGraphUseMetaDescription bool   `json:"open_graph_use_meta_description,omitempty"`
-	OpenGraphUseProductName     bool   `json:"open_graph_use_product_name,omitempty"`
-	OpenGraphUseImage           bool   `json:"open_graph_use_image,omitempty"`
-	Variants                    []struct {
-		ID                        int64         `json:"id,omitempty"`
-		ProductID                 int64         `json:"product_id,omitempty"`
-		Sku                       string        `json:"sku,omitempty"`
-		SkuID                     interface{}   `json:"sku_id,omitempty"`
-		Price                     float64       `json:"price,omitempty"`
-		CalculatedPrice           float64       `json:"calculated_price,omitempty"`
-		SalePrice                 float64       `json:"sale_price,omitempty"`
-		RetailPrice               float64       `json:"retail_price,omitempty"`
-		MapPrice                  float64       `json:"map_price,omitempty"`
-		Weight                    float64       `json:"weight,omitempty"`
-		Width                     int           `json:"width,omitempty"`
-		Height                    int           `json:"height,omitempty"`
-		Depth                     int           `json:"depth,omitempty"`
-		IsFreeShipping            bool          `json:"is_free_shipping,omitempty"`
-		FixedCostShippingPrice    float64       `json:"fixed_cost_shipping_price,omitempty"`
-		CalculatedWeight          float64       `json:"calculated_weight,omitempty"`
-		PurchasingDisabled        bool          `json:"purchasing_disabled,omitempty"`
-		PurchasingDisabledMessage string        `json:"purchasing_disabled_message,omitempty"`
-		ImageURL                  string        `json:"image_url,omitempty"`
-		CostPrice                 float64       `json:"cost_price,omitempty"`
-		Upc                       string        `json:"upc,omitempty"`
-		Mpn                       string        `json:"mpn,omitempty"`
-		Gtin                      string        `json:"gtin,omitempty"`
-		InventoryLevel            int           `json:"inventory_level,omitempty"`
-		InventoryWarningLevel     int           `json:"inventory_warning_level,omitempty"`
-		BinPickingNumber          string        `json:"bin_picking_number,omitempty"`
-		OptionValues              []interface{} `json:"option_values,omitempty"`
-	} `json:"variants,omitempty"`
-	Images       []Image       `json:"images,omitempty"`
-	PrimaryImage interface{}   `json:"primary_image,omitempty"`
-	Videos       []interface{} `json:"videos,omitempty"`
-	CustomFields []struct {
+	BaseVariantID               int64         `json:"base_variant_id,omitempty"`
+	OpenGraphType               string        `json:"open_graph_type,omitempty"`
+	OpenGraphTitle              string        `json:"open_graph_title,omitempty"`
+	OpenGraphDescription        string        `json:"open_graph_description,omitempty"`
+	OpenGraphUseMetaDescription bool          `json:"open_graph_use_meta_description,omitempty"`
+	OpenGraphUseProductName     bool          `json:"open_graph_use_product_name,omitempty"`
+	OpenGraphUseImage           bool          `json:"open_graph_use_image,omitempty"`
+	Variants                    []Variant     `json:"variants,omitempty"`
+	Images                      []Image       `json:"images,omitempty"`
+	PrimaryImage                interface{}   `json:"primary_image,omitempty"`
+	Videos                      []interface{} `json:"videos,omitempty"`
+	CustomFields                []struct {
 		ID    int64  `json:"id,omitempty"`
 		Name  string `json:"name,omitempty"`
 		Value string `json:"value,omitempty"`
@@ -147,6 +118,37 @@ type Metafield struct {
 	PermissionSet string    `json:"permission_set,omitempty"`
 }
 
+// Variant is a BigCommerce product variant
+type Variant struct {
+	ID                        int64         `json:"id,omitempty"`
+	ProductID                 int64         `json:"product_id,omitempty"`
+	Sku                       string        `json:"sku,omitempty"`
+	SkuID                     interface{}   `json:"sku_id,omitempty"`
+	Price                     float64       `json:"price,omitempty"`
+	CalculatedPrice           float64       `json:"calculated_price,omitempty"`
+	SalePrice                 float64       `json:"sale_price,omitempty"`
+	RetailPrice               float64       `json:"retail_price,omitempty"`
+	MapPrice                  float64       `json:"map_price,omitempty"`
+	Weight                    float64       `json:"weight,omitempty"`
+	Width                     float64       `json:"width,omitempty"`
+	Height                    float64       `json:"height,omitempty"`
+	Depth                     float64       `json:"depth,omitempty"`
+	IsFreeShipping            bool          `json:"is_free_shipping,omitempty"`
+	FixedCostShippingPrice    float64       `json:"fixed_cost_shipping_price,omitempty"`
+	CalculatedWeight          float64       `json:"calculated_weight,omitempty"`
+	PurchasingDisabled        bool          `json:"purchasing_disabled,omitempty"`
+	PurchasingDisabledMessage string        `json:"purchasing_disabled_message,omitempty"`
+	ImageURL                  string        `json:"image_url,omitempty"`
+	CostPrice                 float64       `json:"cost_price,omitempty"`
+	Upc                       string        `json:"upc,omitempty"`
+	Mpn                       string        `json:"mpn,omitempty"`
+	Gtin                      string        `json:"gtin,omitempty"`
+	InventoryLevel            int           `json:"inventory_level,omitempty"`
+	InventoryWarningLevel     int           `json:"inventory_warning_level,omitempty"`
+	BinPickingNumber          string        `json:"bin_picking_number,omitempty"`
+	OptionValues              []interface{} `json:"option_values,omitempty"`
+}
+
 // GetAllProducts gets all products from BigCommerce
 // args is a key-value map of additional arguments to pass to the API
 func (bc *Client) GetAllProducts(args map[string]string) ([]Product, error) {
@@ -177,45 +179,15 @@ func (bc *Client) GetAllProducts(args map[string]string) ([]Product, error) {
 // args is a key-value map of additional arguments to pass to the API
 // page: the page number to download
 func (bc *Client) GetProducts(args map[string]string, page int) ([]Product, bool, error) {
-	fpart := ""
-	for k, v := range args {
-		fpart += "&" + k + "=" + v
-	}
-	url := "/v3/catalog/products?page=" + strconv.Itoa(page) + fpart
-	// log.Printf("GET %s", url)
-
-	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	p, err := ListPage[Product](bc, newURL("/v3/catalog/products").Int("page", int64(page)).Args(args).String())
 	if err != nil {
 		return nil, false, err
 	}
-	defer res.Body.Close()
-	if res.StatusCode == http.StatusNoContent {
-		return nil, false, ErrNoContent
-	}
-
-	body, err := ioutil.ReadAll(res.Body)
+	err = bc.normalizeProducts(p.Data)
 	if err != nil {
 		return nil, false, err
 	}
-	var pp struct {
-		Status int       `json:"status"`
-		Title  string    `json:"title"`
-		Data   []Product `json:"data"`
-		Meta   struct {
-			Pagination Pagination `json:"pagination"`
-		} `json:"meta"`
-	}
-	err = json.Unmarshal(body, &pp)
-	if err != nil {
-		return nil, false, err
-	}
-	//	log.Printf("%d products (%+v)", len(pp.Data), pp.Meta.Pagination)
-
-	if pp.Status != 0 {
-		return nil, false, errors.New(pp.Title)
-	}
-	return pp.Data, pp.Meta.Pagination.CurrentPage < pp.Meta.Pagination.TotalPages, nil
+	return p.Data, p.More(), nil
 }
 
 // GetProductByID gets a product from BigCommerce by ID
@@ -223,7 +195,7 @@ func (bc *Client) GetProducts(args map[string]string, page int) ([]Product, bool
 func (bc *Client) GetProductByID(productID int64) (*Product, error) {
 	url := "/v3/catalog/products/" + strconv.FormatInt(productID, 10) + "?include=variants,images,custom_fields,bulk_pricing_rules,primary_image,modifiers,options,videos"
 	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -237,11 +209,16 @@ func (bc *Client) GetProductByID(productID int64) (*Product, error) {
 	var productResponse struct {
 		Data Product `json:"data"`
 	}
-	err = json.Unmarshal(body, &productResponse)
+	err = bc.unmarshal(body, &productResponse)
+	if err != nil {
+		return nil, err
+	}
+	products := []Product{productResponse.Data}
+	err = bc.normalizeProducts(products)
 	if err != nil {
 		return nil, err
 	}
-	return &productResponse.Data, nil
+	return &products[0], nil
 }
 
 // GetProductMetafields gets metafields values for a product
@@ -249,7 +226,7 @@ func (bc *Client) GetProductByID(productID int64) (*Product, error) {
 func (bc *Client) GetProductMetafields(productID int64) (map[string]Metafield, error) {
 	url := "/v3/catalog/products/" + strconv.FormatInt(productID, 10) + "/metafields"
 	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -263,7 +240,7 @@ func (bc *Client) GetProductMetafields(productID int64) (map[string]Metafield, e
 	var metafieldsResponse struct {
 		Metafields []Metafield `json:"data,omitempty"`
 	}
-	err = json.Unmarshal(body, &metafieldsResponse)
+	err = bc.unmarshal(body, &metafieldsResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -273,3 +250,51 @@ func (bc *Client) GetProductMetafields(productID int64) (map[string]Metafield, e
 	}
 	return ret, nil
 }
+
+// productBatchSize is the maximum number of products BigCommerce accepts in one batch update
+const productBatchSize = 10
+
+// SetProductsSortOrder sets sort_order for many products, in batches
+// sortOrders: map of product ID to sort order
+func (bc *Client) SetProductsSortOrder(sortOrders map[int64]int) error {
+	updates := []map[string]interface{}{}
+	for id, so := range sortOrders {
+		updates = append(updates, map[string]interface{}{"id": id, "sort_order": so})
+	}
+	return bc.batchUpdateProducts(updates)
+}
+
+// SetProductsFeatured sets or clears the is_featured flag for many products, in batches
+func (bc *Client) SetProductsFeatured(productIDs []int64, featured bool) error {
+	updates := []map[string]interface{}{}
+	for _, id := range productIDs {
+		updates = append(updates, map[string]interface{}{"id": id, "is_featured": featured})
+	}
+	return bc.batchUpdateProducts(updates)
+}
+
+// batchUpdateProducts sends partial product updates (maps with an "id" key) in chunks of productBatchSize
+// we use maps here because Product omits zero values like sort_order 0 or is_featured false
+func (bc *Client) batchUpdateProducts(updates []map[string]interface{}) error {
+	for start := 0; start < len(updates); start += productBatchSize {
+		end := start + productBatchSize
+		if end > len(updates) {
+			end = len(updates)
+		}
+		reqJSON, err := bc.marshal(updates[start:end])
+		if err != nil {
+			return err
+		}
+		req := bc.getAPIRequest(http.MethodPut, "/v3/catalog/products", bytes.NewReader(reqJSON))
+		res, err := bc.do(req)
+		if err != nil {
+			return err
+		}
+		body, err := processBody(res)
+		res.Body.Close()
+		if err != nil {
+			return fmt.Errorf("error updating products %d-%d: %w %s", start, end-1, err, string(body))
+		}
+	}
+	return nil
+}
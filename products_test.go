@@ -0,0 +1,46 @@
+package bigcommerce
+
+import (
+	"testing"
+)
+
+func TestBatchUpdateProducts(t *testing.T) {
+	tests := []struct {
+		name      string
+		products  int
+		failBatch int
+		want      []int
+		wantErr   bool
+	}{
+		{"none", 0, 0, []int{}, false},
+		{"one batch", 7, 0, []int{7}, false},
+		{"full batches", 20, 0, []int{10, 10}, false},
+		{"partial last batch", 23, 0, []int{10, 10, 3}, false},
+		{"stops at a failed batch", 35, 2, []int{10, 10}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, sizes := batchServer(t, "/stores/store/v3/catalog/products", tt.failBatch)
+			defer srv.Close()
+			bc := newTestClient(srv)
+
+			ids := make([]int64, tt.products)
+			for i := range ids {
+				ids[i] = int64(i + 1)
+			}
+			err := bc.SetProductsFeatured(ids, false)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %v", err, tt.wantErr)
+			}
+			got := sizes()
+			if len(got) != len(tt.want) {
+				t.Fatalf("got batches %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("got batches %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
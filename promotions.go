@@ -0,0 +1,122 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// Promotion redemption types and statuses
+const (
+	PromotionAutomatic = "AUTOMATIC"
+	PromotionCoupon    = "COUPON"
+	PromotionEnabled   = "ENABLED"
+	PromotionDisabled  = "DISABLED"
+)
+
+// Promotion is a BigCommerce promotion (v3), rules are kept loosely typed
+// see https://developer.bigcommerce.com/docs/rest-management/promotions
+type Promotion struct {
+	ID                           int64              `json:"id,omitempty"`
+	Name                         string             `json:"name"`
+	RedemptionType               string             `json:"redemption_type,omitempty"`
+	Channels                     []PromotionChannel `json:"channels,omitempty"`
+	Customer                     *PromotionCustomer `json:"customer,omitempty"`
+	Rules                        []PromotionRule    `json:"rules"`
+	CurrentUses                  int                `json:"current_uses,omitempty"`
+	MaxUses                      int                `json:"max_uses,omitempty"`
+	Status                       string             `json:"status,omitempty"`
+	StartDate                    string             `json:"start_date,omitempty"`
+	EndDate                      string             `json:"end_date,omitempty"`
+	Stop                         bool               `json:"stop,omitempty"`
+	CanBeUsedWithOtherPromotions bool               `json:"can_be_used_with_other_promotions,omitempty"`
+	CurrencyCode                 string             `json:"currency_code,omitempty"`
+}
+
+// PromotionChannel is a channel a promotion applies to
+type PromotionChannel struct {
+	ID int64 `json:"id"`
+}
+
+// PromotionCustomer restricts who can use a promotion
+type PromotionCustomer struct {
+	GroupIDs          []int64            `json:"group_ids,omitempty"`
+	MinimumOrderCount int                `json:"minimum_order_count,omitempty"`
+	ExcludedGroupIDs  []int64            `json:"excluded_group_ids,omitempty"`
+	Segments          *PromotionSegments `json:"segments,omitempty"`
+}
+
+// PromotionSegments are the customer segments a promotion is restricted to
+type PromotionSegments struct {
+	ID []string `json:"id"`
+}
+
+// PromotionRule is an action with an optional condition
+type PromotionRule struct {
+	Action    interface{} `json:"action"`
+	Condition interface{} `json:"condition,omitempty"`
+	ApplyOnce bool        `json:"apply_once,omitempty"`
+	Stop      bool        `json:"stop,omitempty"`
+}
+
+// CartPercentageOffRule returns a rule taking percentage % off the cart value
+func CartPercentageOffRule(percentage float64) PromotionRule {
+	return PromotionRule{
+		Action: map[string]interface{}{
+			"cart_value": map[string]interface{}{
+				"discount": map[string]interface{}{
+					"percentage_amount": strconv.FormatFloat(percentage, 'f', -1, 64),
+				},
+			},
+		},
+	}
+}
+
+// CreatePromotion creates a promotion
+func (bc *Client) CreatePromotion(promotion Promotion) (*Promotion, error) {
+	reqJSON, err := bc.marshal(promotion)
+	if err != nil {
+		return nil, err
+	}
+	req := bc.getAPIRequest(http.MethodPost, "/v3/promotions", bytes.NewReader(reqJSON))
+	res, err := bc.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil {
+		return nil, fmt.Errorf("error creating promotion %s: %w %s", promotion.Name, err, string(body))
+	}
+	var promotionResponse struct {
+		Data Promotion `json:"data"`
+	}
+	err = bc.unmarshal(body, &promotionResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &promotionResponse.Data, nil
+}
+
+// CreateSegmentPromotion creates a promotion only shoppers in the named segment can use,
+// the segment is created if it doesn't exist yet, e.g. for loyalty tier pricing:
+//
+//	bc.CreateSegmentPromotion("Gold", Promotion{Name: "Gold 10%", Rules: []PromotionRule{CartPercentageOffRule(10)}})
+func (bc *Client) CreateSegmentPromotion(segmentName string, promotion Promotion) (*Promotion, error) {
+	segment, err := bc.EnsureSegment(segmentName, "")
+	if err != nil {
+		return nil, err
+	}
+	if promotion.Customer == nil {
+		promotion.Customer = &PromotionCustomer{}
+	}
+	promotion.Customer.Segments = &PromotionSegments{ID: []string{segment.ID}}
+	if promotion.RedemptionType == "" {
+		promotion.RedemptionType = PromotionAutomatic
+	}
+	if promotion.Channels == nil {
+		promotion.Channels = []PromotionChannel{{ID: bc.ChannelID}}
+	}
+	return bc.CreatePromotion(promotion)
+}
@@ -0,0 +1,107 @@
+package bigcommerce
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimitStatus is the rate limit state BigCommerce reported with the last response
+type RateLimitStatus struct {
+	// RequestsLeft is the number of requests left in the current window
+	RequestsLeft int
+	// RequestsQuota is the number of requests allowed per window
+	RequestsQuota int
+	// Window is the length of the rate limit window
+	Window time.Duration
+	// ResetAt is when the current window ends and the quota is restored
+	ResetAt time.Time
+	// UpdatedAt is when the status was read from a response, zero if no response had rate limit headers yet
+	UpdatedAt time.Time
+}
+
+// rateLimitTracker keeps the last rate limit status, shared by a client and its clones
+type rateLimitTracker struct {
+	mu     sync.Mutex
+	status RateLimitStatus
+}
+
+// RateLimitStatus returns the rate limit status of the last response with rate limit headers
+func (bc *Client) RateLimitStatus() RateLimitStatus {
+	t := bc.rateLimits()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.status
+}
+
+// rateLimits returns the client's tracker, creating it on first use
+func (bc *Client) rateLimits() *rateLimitTracker {
+	bc.rateMu.Lock()
+	defer bc.rateMu.Unlock()
+	if bc.rateLimit == nil {
+		bc.rateLimit = &rateLimitTracker{}
+	}
+	return bc.rateLimit
+}
+
+// record reads the X-Rate-Limit headers of a response
+func (t *rateLimitTracker) record(res *http.Response) {
+	s, ok := rateLimitOf(res, time.Now())
+	if !ok {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.status.RequestsLeft = s.RequestsLeft
+	t.status.UpdatedAt = s.UpdatedAt
+	if s.RequestsQuota != 0 {
+		t.status.RequestsQuota = s.RequestsQuota
+	}
+	if s.Window != 0 {
+		t.status.Window = s.Window
+	}
+	if !s.ResetAt.IsZero() {
+		t.status.ResetAt = s.ResetAt
+	}
+}
+
+// rateLimitOf returns the rate limit status in the X-Rate-Limit headers of a response, false without them.
+// Headers missing from the response leave their fields zero
+func rateLimitOf(res *http.Response, now time.Time) (RateLimitStatus, bool) {
+	left, err := strconv.Atoi(res.Header.Get("X-Rate-Limit-Requests-Left"))
+	if err != nil {
+		return RateLimitStatus{}, false
+	}
+	s := RateLimitStatus{RequestsLeft: left, UpdatedAt: now}
+	if quota, err := strconv.Atoi(res.Header.Get("X-Rate-Limit-Requests-Quota")); err == nil {
+		s.RequestsQuota = quota
+	}
+	if ms, err := strconv.Atoi(res.Header.Get("X-Rate-Limit-Time-Window-Ms")); err == nil {
+		s.Window = time.Duration(ms) * time.Millisecond
+	}
+	if ms, err := strconv.Atoi(res.Header.Get("X-Rate-Limit-Time-Reset-Ms")); err == nil {
+		s.ResetAt = now.Add(time.Duration(ms) * time.Millisecond)
+	}
+	return s, true
+}
+
+// delay returns how long to wait before sending a request when at most threshold requests are left
+// in the current window, and counts the request against the quota so concurrent callers queue up
+func (t *rateLimitTracker) delay(threshold int) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.status.UpdatedAt.IsZero() {
+		return 0
+	}
+	now := time.Now()
+	if !t.status.ResetAt.IsZero() && !now.Before(t.status.ResetAt) {
+		return 0 // window is over, the next response tells the new state
+	}
+	left := t.status.RequestsLeft
+	t.status.RequestsLeft--
+	if left > threshold {
+		return 0
+	}
+	return t.status.ResetAt.Sub(now)
+}
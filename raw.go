@@ -0,0 +1,49 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Raw sends a request to an endpoint the client has no method for yet and returns the response body.
+// method can be any HTTP method, including PATCH, path is relative to the store, e.g. "/v3/inventory/items",
+// and body is sent as is, nil for none. Requests go through the same retries, token refresh and rate limiting
+// as all other calls, error responses are returned as *APIError with the body
+func (bc *Client) Raw(method, path string, body []byte) ([]byte, error) {
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	req := bc.getAPIRequest(method, path, r)
+	res, err := bc.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	resBody, err := processBody(res)
+	if err != nil {
+		if err == ErrNoContent {
+			return nil, nil
+		}
+		return resBody, fmt.Errorf("%s %s: %w %s", method, path, err, string(resBody))
+	}
+	return resBody, nil
+}
+
+// SendJSON sends payload as JSON, nil for no body, to an endpoint the client has no method for yet
+// and decodes the response into result, nil to discard it:
+//
+//	var res struct {
+//		Data []bigcommerce.Inventory `json:"data"`
+//	}
+//	err := bc.SendJSON(http.MethodPatch, "/v3/some/endpoint", changes, &res)
+func (bc *Client) SendJSON(method, path string, payload, result interface{}) error {
+	return bc.sendJSON(method, path, payload, result)
+}
+
+// Patch sends payload to path with PATCH and decodes the response into result, nil to discard it
+func (bc *Client) Patch(path string, payload, result interface{}) error {
+	return bc.sendJSON(http.MethodPatch, path, payload, result)
+}
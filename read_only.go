@@ -0,0 +1,148 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+var (
+	// ErrInsufficientScope is matched by InsufficientScopeError, returned for writes the token has no scope for
+	// when Client.DegradeOnScopeErrors is set
+	ErrInsufficientScope = errors.New("token lacks the scope to write")
+	// ErrDryRun is returned for writes not sent because Client.DryRun is set
+	ErrDryRun = errors.New("dry run, write not sent")
+)
+
+// InsufficientScopeError is returned for a write BigCommerce answered with 403, or that wasn't sent because
+// an earlier write to the same resource was, when Client.DegradeOnScopeErrors is set. It matches ErrInsufficientScope
+// and ErrForbidden with errors.Is
+type InsufficientScopeError struct {
+	Method string
+	// Resource is the path with IDs replaced, e.g. "/v3/catalog/products/{id}"
+	Resource string
+	// APIError is the 403 BigCommerce answered, nil when the write wasn't sent
+	APIError *APIError
+}
+
+// Error returns the write that was denied
+func (e *InsufficientScopeError) Error() string {
+	msg := fmt.Sprintf("%s %s: %v", e.Method, e.Resource, ErrInsufficientScope)
+	if e.APIError != nil && e.APIError.Title != "" {
+		msg += ": " + e.APIError.Title
+	}
+	return msg
+}
+
+// Is matches ErrInsufficientScope and ErrForbidden
+func (e *InsufficientScopeError) Is(target error) bool {
+	return target == ErrInsufficientScope || target == ErrForbidden
+}
+
+// Unwrap returns the 403 BigCommerce answered
+func (e *InsufficientScopeError) Unwrap() error {
+	if e.APIError == nil {
+		return nil
+	}
+	return e.APIError
+}
+
+// WithDegradeOnScopeErrors makes writes the token has no scope for return an *InsufficientScopeError
+// and stops sending writes to resources that were denied, see Client.DegradeOnScopeErrors
+func WithDegradeOnScopeErrors() Option {
+	return func(bc *Client) {
+		bc.DegradeOnScopeErrors = true
+	}
+}
+
+// WithDryRun logs writes instead of sending them, see Client.DryRun
+func WithDryRun() Option {
+	return func(bc *Client) {
+		bc.DryRun = true
+	}
+}
+
+// scopeTracker keeps the writes BigCommerce denied, shared by a client and its clones
+type scopeTracker struct {
+	mu     sync.Mutex
+	denied map[string]bool
+}
+
+// scopeTracker returns the client's tracker, creating it on first use
+func (bc *Client) scopeTracker() *scopeTracker {
+	bc.scopesMu.Lock()
+	defer bc.scopesMu.Unlock()
+	if bc.scopes == nil {
+		bc.scopes = &scopeTracker{denied: map[string]bool{}}
+	}
+	return bc.scopes
+}
+
+// checkWrite returns an error for writes that must not be sent: in a dry run, or to resources denied before
+func (bc *Client) checkWrite(req *http.Request) error {
+	if !bc.DryRun && !bc.DegradeOnScopeErrors {
+		return nil
+	}
+	if !isWrite(req) {
+		return nil
+	}
+	resource := resourceName(req.URL.Path)
+	if bc.DryRun {
+		// only the size of the body, it may hold card details or customer data
+		bc.logf("dry run: %s %s (%d bytes)", req.Method, req.URL.Path, len(requestBody(req)))
+		return fmt.Errorf("%w: %s %s", ErrDryRun, req.Method, resource)
+	}
+	t := bc.scopeTracker()
+	t.mu.Lock()
+	denied := t.denied[req.Method+" "+resource]
+	t.mu.Unlock()
+	if denied {
+		return &InsufficientScopeError{Method: req.Method, Resource: resource}
+	}
+	return nil
+}
+
+// scopeError turns a 403 answer to a write into an *InsufficientScopeError and remembers the resource was denied
+func (bc *Client) scopeError(req *http.Request, res *http.Response) (*http.Response, error) {
+	if !bc.DegradeOnScopeErrors || res.StatusCode != http.StatusForbidden || !isWrite(req) {
+		return res, nil
+	}
+	body, _ := io.ReadAll(res.Body)
+	res.Body.Close()
+	resource := resourceName(req.URL.Path)
+	t := bc.scopeTracker()
+	t.mu.Lock()
+	t.denied[req.Method+" "+resource] = true
+	t.mu.Unlock()
+	return nil, &InsufficientScopeError{Method: req.Method, Resource: resource, APIError: newAPIError(res, body)}
+}
+
+// isWrite returns true for requests that change the store, GraphQL requests only for mutations
+func isWrite(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	if strings.HasSuffix(req.URL.Path, "/graphql") {
+		return bytes.Contains(requestBody(req), []byte("mutation"))
+	}
+	return true
+}
+
+// requestBody returns a copy of the body of a request, nil when it can't be read again
+func requestBody(req *http.Request) []byte {
+	if req.GetBody == nil {
+		return nil
+	}
+	r, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer r.Close()
+	body, _ := io.ReadAll(r)
+	return body
+}
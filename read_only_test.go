@@ -0,0 +1,33 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDryRunDoesNotLogBodies(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("got request %s %s in a dry run", r.Method, r.URL.Path)
+	}))
+	defer srv.Close()
+	var buf bytes.Buffer
+	bc := newTestClient(srv, WithDryRun())
+	bc.Logger = log.New(&buf, "", 0)
+
+	_, err := bc.Raw(http.MethodPost, "/v3/customers", []byte(`[{"email": "jane@example.com", "number": "4111111111111111"}]`))
+	if !errors.Is(err, ErrDryRun) {
+		t.Fatalf("got error %v, want ErrDryRun", err)
+	}
+	logged := buf.String()
+	if !strings.Contains(logged, "POST /stores/store/v3/customers") {
+		t.Fatalf("got log %q, want the method and path", logged)
+	}
+	if strings.Contains(logged, "jane@example.com") || strings.Contains(logged, "4111") {
+		t.Errorf("the body was logged: %s", logged)
+	}
+}
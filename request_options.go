@@ -0,0 +1,101 @@
+package bigcommerce
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// RequestOption tunes the requests of individual calls, see Client.With
+type RequestOption func(*requestOptions)
+
+type requestOptions struct {
+	timeout time.Duration
+	retry   *RetryPolicy
+	header  http.Header
+	query   url.Values
+}
+
+// WithTimeout limits how long each request may take, retries and reading the response included
+func WithTimeout(d time.Duration) RequestOption {
+	return func(o *requestOptions) {
+		o.timeout = d
+	}
+}
+
+// WithHeader sets a header on the requests, replacing the client's value for it
+func WithHeader(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		o.header.Set(key, value)
+	}
+}
+
+// WithQuery adds a query parameter to the requests, e.g. an include the method doesn't ask for
+func WithQuery(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		o.query.Add(key, value)
+	}
+}
+
+// With returns a client whose requests have opts applied, for tuning single calls without changing bc:
+//
+//	inventory, err := bc.With(bigcommerce.WithTimeout(time.Minute)).GetInventoryForLocation(1, nil)
+//
+// the returned client shares the settings and caches of bc, options add up when With is chained
+func (bc *Client) With(opts ...RequestOption) *Client {
+	o := &requestOptions{header: http.Header{}, query: url.Values{}}
+	if bc.call != nil {
+		o.timeout = bc.call.timeout
+		o.retry = bc.call.retry
+		o.header = bc.call.header.Clone()
+		for k, v := range bc.call.query {
+			o.query[k] = append([]string{}, v...)
+		}
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	c := bc.clone()
+	c.call = o
+	return c
+}
+
+// applyRequestOptions sets the headers and query of With on a request
+func (bc *Client) applyRequestOptions(req *http.Request) {
+	if bc.call == nil {
+		return
+	}
+	for k, v := range bc.call.header {
+		req.Header[k] = append([]string{}, v...)
+	}
+	if len(bc.call.query) > 0 {
+		q := req.URL.Query()
+		for k, v := range bc.call.query {
+			q[k] = append(q[k], v...)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+}
+
+// withTimeout bounds req by timeout when set, cancel must be called when the response is not returned
+func withTimeout(req *http.Request, timeout time.Duration) (*http.Request, context.CancelFunc) {
+	if timeout <= 0 {
+		return req, func() {}
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	return req.WithContext(ctx), cancel
+}
+
+// cancelOnClose cancels the context of a request when its response body is closed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
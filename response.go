@@ -0,0 +1,81 @@
+package bigcommerce
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// Response is the metadata of a response the client received, see Client.WithResponses
+type Response struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Status     string
+	// RequestID is the X-Request-Id header, quote it when asking BigCommerce support about a call
+	RequestID string
+	// RateLimit is the rate limit status the response reported, UpdatedAt is zero when it had no rate limit headers
+	RateLimit RateLimitStatus
+	// Pagination is the pagination meta of v3 list responses, nil for other responses
+	Pagination *Pagination
+	Header     http.Header
+	Duration   time.Duration
+}
+
+// WithResponses returns a client for one call that also appends the metadata of every response
+// it receives to responses, for logging request IDs or paging by the reported totals:
+//
+//	var responses []bigcommerce.Response
+//	products, err := bc.WithResponses(&responses).GetAllProducts(nil)
+//	for _, r := range responses {
+//		log.Printf("%s %s: %d request %s, %d requests left", r.Method, r.URL, r.StatusCode, r.RequestID, r.RateLimit.RequestsLeft)
+//	}
+//
+// calls that make several requests, like GetAllProducts, append one response per request
+func (bc *Client) WithResponses(responses *[]Response) *Client {
+	c := bc.clone()
+	c.responses = &recorder[Response]{data: responses}
+	return c
+}
+
+// recordResponse appends the metadata of res to the responses of WithResponses
+func (bc *Client) recordResponse(req *http.Request, res *http.Response, start time.Time) {
+	if bc.responses == nil {
+		return
+	}
+	now := time.Now()
+	r := Response{
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		StatusCode: res.StatusCode,
+		Status:     res.Status,
+		RequestID:  res.Header.Get("X-Request-Id"),
+		Header:     res.Header.Clone(),
+		Duration:   now.Sub(start),
+	}
+	if s, ok := rateLimitOf(res, now); ok {
+		r.RateLimit = s
+	}
+	bc.responses.add(r)
+}
+
+// recordPagination sets the pagination meta of a decoded v3 list body on the last response of WithResponses
+func (bc *Client) recordPagination(data []byte) {
+	if bc.responses == nil {
+		return
+	}
+	var meta struct {
+		Meta struct {
+			Pagination *Pagination `json:"pagination"`
+		} `json:"meta"`
+	}
+	if json.Unmarshal(data, &meta) != nil || meta.Meta.Pagination == nil {
+		return
+	}
+	r := bc.responses
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if n := len(*r.data); n > 0 && (*r.data)[n-1].Pagination == nil {
+		(*r.data)[n-1].Pagination = meta.Meta.Pagination
+	}
+}
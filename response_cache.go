@@ -0,0 +1,159 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"container/list"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CachedResponse is a GET response kept by a ResponseCache with the validators to revalidate it
+type CachedResponse struct {
+	ETag         string
+	LastModified string
+	Header       http.Header
+	Body         []byte
+	StoredAt     time.Time
+}
+
+// ResponseCache stores GET responses by URL for conditional requests, see Client.Cache
+type ResponseCache interface {
+	// Get returns the response stored for url, false when there is none
+	Get(url string) (*CachedResponse, bool)
+	// Put stores the response for url
+	Put(url string, res *CachedResponse)
+}
+
+// MemoryResponseCache is a ResponseCache in memory keeping the most recently used responses
+type MemoryResponseCache struct {
+	// MaxEntries is how many responses are kept, 0 for no limit
+	MaxEntries int
+
+	mu      sync.Mutex
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type memoryCacheEntry struct {
+	url string
+	res *CachedResponse
+}
+
+// NewMemoryResponseCache returns an empty cache keeping up to maxEntries responses
+func NewMemoryResponseCache(maxEntries int) *MemoryResponseCache {
+	return &MemoryResponseCache{
+		MaxEntries: maxEntries,
+		order:      list.New(),
+		entries:    map[string]*list.Element{},
+	}
+}
+
+// Get returns the response stored for url
+func (c *MemoryResponseCache) Get(url string) (*CachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[url]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(*memoryCacheEntry).res, true
+}
+
+// Put stores the response for url, dropping the least recently used response when the cache is full
+func (c *MemoryResponseCache) Put(url string, res *CachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[url]; ok {
+		e.Value.(*memoryCacheEntry).res = res
+		c.order.MoveToFront(e)
+		return
+	}
+	c.entries[url] = c.order.PushFront(&memoryCacheEntry{url, res})
+	if c.MaxEntries > 0 && c.order.Len() > c.MaxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*memoryCacheEntry).url)
+	}
+}
+
+// WithResponseCache makes GET requests conditional on the responses in c, see Client.Cache:
+//
+//	client := bigcommerce.NewClient(storeHash, token, bigcommerce.WithResponseCache(bigcommerce.NewMemoryResponseCache(1000)))
+func WithResponseCache(c ResponseCache) Option {
+	return func(bc *Client) {
+		bc.Cache = c
+	}
+}
+
+// conditionalRequest adds the validators of the cached response for req to it, nil when there is none
+func (bc *Client) conditionalRequest(req *http.Request) *CachedResponse {
+	if bc.Cache == nil || req.Method != http.MethodGet {
+		return nil
+	}
+	if req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" {
+		return nil // the caller revalidates itself
+	}
+	cached, ok := bc.Cache.Get(req.URL.String())
+	if !ok {
+		return nil
+	}
+	if cached.ETag != "" {
+		req.Header.Set("If-None-Match", cached.ETag)
+	}
+	if cached.LastModified != "" {
+		req.Header.Set("If-Modified-Since", cached.LastModified)
+	}
+	return cached
+}
+
+// cachedResponse answers 304 with the cached response and stores 200 responses with validators
+func (bc *Client) cachedResponse(req *http.Request, cached *CachedResponse, res *http.Response) (*http.Response, error) {
+	if bc.Cache == nil || req.Method != http.MethodGet {
+		return res, nil
+	}
+	switch {
+	case res.StatusCode == http.StatusNotModified && cached != nil:
+		drainBody(res)
+		header := cached.Header.Clone()
+		// the rate limit state is current, the rest is from the cached response
+		for k, v := range res.Header {
+			if k == "Date" || strings.HasPrefix(k, "X-Rate-Limit-") {
+				header[k] = v
+			}
+		}
+		return &http.Response{
+			Status:        "200 OK",
+			StatusCode:    http.StatusOK,
+			Proto:         res.Proto,
+			ProtoMajor:    res.ProtoMajor,
+			ProtoMinor:    res.ProtoMinor,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(cached.Body)),
+			ContentLength: int64(len(cached.Body)),
+			Request:       res.Request,
+		}, nil
+	case res.StatusCode == http.StatusOK:
+		etag, lastModified := res.Header.Get("ETag"), res.Header.Get("Last-Modified")
+		if etag == "" && lastModified == "" {
+			return res, nil
+		}
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		bc.Cache.Put(req.URL.String(), &CachedResponse{
+			ETag:         etag,
+			LastModified: lastModified,
+			Header:       res.Header.Clone(),
+			Body:         body,
+			StoredAt:     time.Now(),
+		})
+		res.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	return res, nil
+}
@@ -0,0 +1,48 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestResponsesSharedByClones(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-1")
+		fmt.Fprint(w, `{"data": [{"id": 1}], "meta": {"pagination": {"total": 30, "count": 1, "per_page": 10, "current_page": 1, "total_pages": 3}}}`)
+	}))
+	defer srv.Close()
+	var responses []Response
+	bc := newTestClient(srv).WithResponses(&responses)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := ListPage[Brand](bc.WithContext(context.Background()), "/v3/catalog/brands"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if len(responses) != 10 {
+		t.Fatalf("got %d responses, want 10", len(responses))
+	}
+	for _, r := range responses {
+		if r.StatusCode != http.StatusOK || r.RequestID != "req-1" {
+			t.Errorf("got response %d with request ID %q", r.StatusCode, r.RequestID)
+		}
+	}
+
+	responses = nil
+	if _, err := ListPage[Brand](bc, "/v3/catalog/brands"); err != nil {
+		t.Fatal(err)
+	}
+	if len(responses) != 1 || responses[0].Pagination == nil || responses[0].Pagination.TotalPages != 3 {
+		t.Errorf("got responses %+v, want one with 3 pages", responses)
+	}
+}
@@ -0,0 +1,103 @@
+package bigcommerce
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy retries requests BigCommerce answered with 429 Too Many Requests or a 5xx error,
+// waiting with exponential backoff between attempts, or for 429 as long as the Retry-After header asks
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts per request, including the first one
+	MaxAttempts int
+	// BaseDelay is the wait before the first retry, doubled for every following retry
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff, responses asking to wait longer than this are returned instead of retried
+	MaxDelay time.Duration
+	// Jitter randomizes every wait by up to this fraction (0-1), so concurrent clients don't retry in lockstep
+	Jitter float64
+	// RetryNonIdempotent also retries POST requests on 500, 502 and 504, which may have been processed.
+	// 429 and 503 are always retried, BigCommerce didn't process the request then
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns the retry policy NewClient sets
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    30 * time.Second,
+		Jitter:      0.2,
+	}
+}
+
+// retryable returns true if a response with status code should be retried
+func (p *RetryPolicy) retryable(method string, statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return p.RetryNonIdempotent || isIdempotent(method)
+	}
+	return false
+}
+
+// delay returns how long to wait before retry number retry (0 based), false if the server asks to wait
+// longer than MaxDelay. Only 429 responses wait as asked, BigCommerce sends the rate limit reset with every
+// response so 5xx responses back off instead
+func (p *RetryPolicy) delay(retry int, res *http.Response) (time.Duration, bool) {
+	if res.StatusCode == http.StatusTooManyRequests {
+		if wait, ok := retryAfter(res); ok {
+			return wait, p.MaxDelay == 0 || wait <= p.MaxDelay
+		}
+	}
+	d := p.BaseDelay << uint(retry)
+	if d <= 0 || (p.MaxDelay > 0 && d > p.MaxDelay) {
+		d = p.MaxDelay
+	}
+	if p.Jitter > 0 {
+		d += time.Duration((rand.Float64()*2 - 1) * p.Jitter * float64(d))
+	}
+	return d, true
+}
+
+// retryAfter reads the wait a response asks for, from Retry-After (seconds or HTTP date)
+// or BigCommerce's X-Rate-Limit-Time-Reset-Ms
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	if v := res.Header.Get("Retry-After"); v != "" {
+		if sec, err := strconv.Atoi(v); err == nil {
+			return time.Duration(sec) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			d := time.Until(t)
+			if d < 0 {
+				d = 0
+			}
+			return d, true
+		}
+	}
+	if v := res.Header.Get("X-Rate-Limit-Time-Reset-Ms"); v != "" {
+		if ms, err := strconv.Atoi(v); err == nil {
+			return time.Duration(ms) * time.Millisecond, true
+		}
+	}
+	return 0, false
+}
+
+// sleepContext waits for d, returning the context error if ctx is done first
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
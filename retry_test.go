@@ -0,0 +1,82 @@
+package bigcommerce
+
+import (
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 50 * time.Millisecond}
+	nonIdempotent := policy
+	nonIdempotent.RetryNonIdempotent = true
+	tests := []struct {
+		name       string
+		policy     *RetryPolicy
+		method     string
+		status     int
+		header     http.Header
+		fail       int32
+		wantStatus int
+		wantSent   int32
+	}{
+		{"429 retried", &policy, http.MethodGet, http.StatusTooManyRequests, nil, 2, 0, 3},
+		{"429 POST retried", &policy, http.MethodPost, http.StatusTooManyRequests, nil, 1, 0, 2},
+		{"429 waits for reset", &policy, http.MethodGet, http.StatusTooManyRequests, http.Header{"X-Rate-Limit-Time-Reset-Ms": {"5"}}, 1, 0, 2},
+		{"429 reset beyond MaxDelay", &policy, http.MethodGet, http.StatusTooManyRequests, http.Header{"Retry-After": {"60"}}, 1, http.StatusTooManyRequests, 1},
+		{"503 POST retried", &policy, http.MethodPost, http.StatusServiceUnavailable, nil, 1, 0, 2},
+		{"503 backs off despite the rate limit reset", &policy, http.MethodGet, http.StatusServiceUnavailable, http.Header{"X-Rate-Limit-Time-Reset-Ms": {"60000"}}, 1, 0, 2},
+		{"500 backs off despite Retry-After", &policy, http.MethodGet, http.StatusInternalServerError, http.Header{"Retry-After": {"60"}}, 1, 0, 2},
+		{"500 retried", &policy, http.MethodGet, http.StatusInternalServerError, nil, 1, 0, 2},
+		{"500 POST not retried", &policy, http.MethodPost, http.StatusInternalServerError, nil, 1, http.StatusInternalServerError, 1},
+		{"500 POST with RetryNonIdempotent", &nonIdempotent, http.MethodPost, http.StatusInternalServerError, nil, 1, 0, 2},
+		{"502 gives up after MaxAttempts", &policy, http.MethodGet, http.StatusBadGateway, nil, 5, http.StatusBadGateway, 3},
+		{"400 not retried", &policy, http.MethodGet, http.StatusBadRequest, nil, 1, http.StatusBadRequest, 1},
+		{"no policy", nil, http.MethodGet, http.StatusTooManyRequests, nil, 1, http.StatusTooManyRequests, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, requests := failingServer(t, tt.fail, tt.status, tt.header)
+			defer srv.Close()
+			bc := newTestClient(srv, WithRetryPolicy(tt.policy))
+
+			var body []byte
+			if tt.method != http.MethodGet {
+				body = []byte(`{}`)
+			}
+			_, err := bc.Raw(tt.method, "/v3/catalog/products", body)
+			var apiErr *APIError
+			switch {
+			case tt.wantStatus == 0 && err != nil:
+				t.Errorf("got error %v, want success", err)
+			case tt.wantStatus != 0 && (!errors.As(err, &apiErr) || apiErr.StatusCode != tt.wantStatus):
+				t.Errorf("got error %v, want status %d", err, tt.wantStatus)
+			}
+			if got := atomic.LoadInt32(requests); got != tt.wantSent {
+				t.Errorf("got %d requests, want %d", got, tt.wantSent)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	p := &RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Minute}
+	reset := http.Header{"X-Rate-Limit-Time-Reset-Ms": {"15000"}}
+	tests := []struct {
+		status int
+		retry  int
+		want   time.Duration
+	}{
+		{http.StatusTooManyRequests, 0, 15 * time.Second},
+		{http.StatusServiceUnavailable, 0, 100 * time.Millisecond},
+		{http.StatusInternalServerError, 2, 400 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		got, ok := p.delay(tt.retry, &http.Response{StatusCode: tt.status, Header: reset})
+		if !ok || got != tt.want {
+			t.Errorf("status %d retry %d: got %s, want %s", tt.status, tt.retry, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package bigcommerce
+
+import (
+	"sort"
+	"time"
+)
+
+// scheduleFetchSize is the number of products fetched per request when reconciling a schedule
+const scheduleFetchSize = 50
+
+// ProductChange is a set of product values, nil fields are left alone
+type ProductChange struct {
+	IsVisible *bool
+	Price     *float64
+	// SalePrice 0 removes the sale price
+	SalePrice *float64
+}
+
+// ScheduledChange applies During to products from Start until End, and After once End has passed,
+// e.g. a flash sale sets a sale price During and removes it After. A zero End never ends
+type ScheduledChange struct {
+	Name       string
+	ProductIDs []int64
+	Start      time.Time
+	End        time.Time
+	During     ProductChange
+	After      ProductChange
+}
+
+// ProductSchedule computes and applies time based product changes, call Reconcile from cron:
+// it is idempotent and only updates products whose values differ from the ones due at that time
+type ProductSchedule struct {
+	client  *Client
+	Changes []ScheduledChange
+}
+
+// ProductSchedule returns a schedule of changes to apply with the client
+func (bc *Client) ProductSchedule(changes ...ScheduledChange) *ProductSchedule {
+	return &ProductSchedule{client: bc, Changes: changes}
+}
+
+// Add adds a change to the schedule
+func (s *ProductSchedule) Add(change ScheduledChange) {
+	s.Changes = append(s.Changes, change)
+}
+
+// Plan returns the values due at now by product ID, changes later in the schedule win
+// when they set the same field of a product
+func (s *ProductSchedule) Plan(now time.Time) map[int64]ProductChange {
+	plan := map[int64]ProductChange{}
+	for _, c := range s.Changes {
+		var due ProductChange
+		switch {
+		case now.Before(c.Start):
+			continue
+		case c.End.IsZero() || now.Before(c.End):
+			due = c.During
+		default:
+			due = c.After
+		}
+		for _, id := range c.ProductIDs {
+			plan[id] = plan[id].merge(due)
+		}
+	}
+	for id, c := range plan {
+		if c.empty() {
+			delete(plan, id)
+		}
+	}
+	return plan
+}
+
+// NextChange returns the first start or end after now, zero when nothing else is scheduled,
+// for schedulers that sleep until the next run instead of polling
+func (s *ProductSchedule) NextChange(now time.Time) time.Time {
+	var next time.Time
+	for _, c := range s.Changes {
+		for _, t := range []time.Time{c.Start, c.End} {
+			if t.After(now) && (next.IsZero() || t.Before(next)) {
+				next = t
+			}
+		}
+	}
+	return next
+}
+
+// Reconcile applies the values due at now, returning the IDs of the products it updated
+func (s *ProductSchedule) Reconcile(now time.Time) ([]int64, error) {
+	plan := s.Plan(now)
+	ids := make([]int64, 0, len(plan))
+	for id := range plan {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	updated := []int64{}
+	updates := []map[string]interface{}{}
+	for start := 0; start < len(ids); start += scheduleFetchSize {
+		end := start + scheduleFetchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		products, err := s.client.GetAllProducts(map[string]string{
+			"id:in":          joinIDs(ids[start:end]),
+			"include_fields": "id,price,sale_price,is_visible",
+			"limit":          "250",
+		})
+		if err != nil && err != ErrNoContent {
+			return updated, err
+		}
+		for i := range products {
+			update := plan[products[i].ID].diff(&products[i])
+			if update == nil {
+				continue
+			}
+			update["id"] = products[i].ID
+			updates = append(updates, update)
+			updated = append(updated, products[i].ID)
+		}
+	}
+	if len(updates) == 0 {
+		return updated, nil
+	}
+	return updated, s.client.batchUpdateProducts(updates)
+}
+
+// merge returns c with the fields set in o
+func (c ProductChange) merge(o ProductChange) ProductChange {
+	if o.IsVisible != nil {
+		c.IsVisible = o.IsVisible
+	}
+	if o.Price != nil {
+		c.Price = o.Price
+	}
+	if o.SalePrice != nil {
+		c.SalePrice = o.SalePrice
+	}
+	return c
+}
+
+func (c ProductChange) empty() bool {
+	return c.IsVisible == nil && c.Price == nil && c.SalePrice == nil
+}
+
+// diff returns the partial product update applying c to p, nil if p already has the values
+func (c ProductChange) diff(p *Product) map[string]interface{} {
+	update := map[string]interface{}{}
+	if c.IsVisible != nil && *c.IsVisible != p.IsVisible {
+		update["is_visible"] = *c.IsVisible
+	}
+	if c.Price != nil && *c.Price != p.Price {
+		update["price"] = *c.Price
+	}
+	if c.SalePrice != nil && *c.SalePrice != p.SalePrice {
+		update["sale_price"] = *c.SalePrice
+	}
+	if len(update) == 0 {
+		return nil
+	}
+	return update
+}
@@ -2,9 +2,7 @@ package bigcommerce
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"net/http"
 	"time"
 )
@@ -29,12 +27,12 @@ type Script struct {
 }
 
 func (bc *Client) CreateScript(s *Script) (*Script, error) {
-	sJSON, err := json.Marshal(s)
+	sJSON, err := bc.marshal(s)
 	if err != nil {
 		return nil, err
 	}
 	req := bc.getAPIRequest(http.MethodPost, "/v3/content/scripts", bytes.NewReader(sJSON))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return s, err
 	}
@@ -42,11 +40,11 @@ func (bc *Client) CreateScript(s *Script) (*Script, error) {
 	var sRes struct {
 		Data Script `json:"data"`
 	}
-	b, err := ioutil.ReadAll(res.Body)
+	b, err := processBody(res)
 	if err != nil {
 		return s, err
 	}
-	err = json.Unmarshal(b, &sRes)
+	err = bc.unmarshal(b, &sRes)
 	if sRes.Data.ID == "" {
 		return s, fmt.Errorf("error creating script: %s", string(b))
 	}
@@ -55,7 +53,7 @@ func (bc *Client) CreateScript(s *Script) (*Script, error) {
 
 func (bc *Client) GetScriptByID(uuid string) (*Script, error) {
 	req := bc.getAPIRequest(http.MethodGet, fmt.Sprintf("/v3/content/scripts/%s", uuid), nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -63,11 +61,11 @@ func (bc *Client) GetScriptByID(uuid string) (*Script, error) {
 	var sRes struct {
 		Data Script `json:"data"`
 	}
-	b, err := ioutil.ReadAll(res.Body)
+	b, err := processBody(res)
 	if err != nil {
 		return nil, err
 	}
-	err = json.Unmarshal(b, &sRes)
+	err = bc.unmarshal(b, &sRes)
 	if sRes.Data.ID == "" {
 		return nil, fmt.Errorf("error getting script: %s", string(b))
 	}
@@ -76,7 +74,7 @@ func (bc *Client) GetScriptByID(uuid string) (*Script, error) {
 
 func (bc *Client) GetScripts() ([]Script, error) {
 	req := bc.getAPIRequest(http.MethodGet, "/v3/content/scripts", nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -84,13 +82,13 @@ func (bc *Client) GetScripts() ([]Script, error) {
 	var sRes struct {
 		Data []Script `json:"data"`
 	}
-	b, err := ioutil.ReadAll(res.Body)
+	b, err := processBody(res)
 	if err != nil {
 		return nil, err
 	}
-	err = json.Unmarshal(b, &sRes)
+	err = bc.unmarshal(b, &sRes)
 	if err != nil {
-		return nil, fmt.Errorf("error getting scripts: %v %s", err, string(b))
+		return nil, fmt.Errorf("error getting scripts: %w %s", err, string(b))
 	}
 	return sRes.Data, err
 }
@@ -0,0 +1,256 @@
+package bigcommerce
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// seedDeleteBatchSize is how many IDs Cleanup deletes per id:in request
+const seedDeleteBatchSize = 50
+
+var (
+	seedFirstNames = []string{"Emma", "Liam", "Olivia", "Noah", "Sophie", "Lucas", "Mila", "Daan", "Julia", "Sem", "Anna", "Finn", "Zoë", "Levi", "Sara", "Milan"}
+	seedLastNames  = []string{"de Vries", "Jansen", "Bakker", "Visser", "Smit", "Meijer", "Mulder", "de Boer", "Bos", "Vos", "Peters", "Hendriks", "Dekker", "Brouwer"}
+	seedStreets    = []string{"Kerkstraat", "Schoolstraat", "Molenweg", "Dorpsstraat", "Stationsweg", "Julianalaan", "Beukenlaan", "Nieuwstraat", "Parallelweg", "Industrieweg"}
+	seedCities     = []string{"Amsterdam", "Rotterdam", "Utrecht", "Eindhoven", "Groningen", "Tilburg", "Almere", "Breda", "Nijmegen", "Zwolle"}
+	seedAdjectives = []string{"Classic", "Organic", "Compact", "Deluxe", "Rustic", "Modern", "Vintage", "Premium", "Handmade", "Recycled", "Wireless", "Sturdy"}
+	seedMaterials  = []string{"Oak", "Cotton", "Steel", "Bamboo", "Leather", "Ceramic", "Wool", "Glass", "Linen", "Copper"}
+	seedNouns      = []string{"Lamp", "Mug", "Chair", "Backpack", "Blanket", "Vase", "Desk Organizer", "Water Bottle", "Notebook", "Cutting Board", "Speaker", "Planter"}
+)
+
+// SeedOptions configures a Seeder
+type SeedOptions struct {
+	// Products, Customers and Orders are how many of each to create
+	Products  int
+	Customers int
+	Orders    int
+	// MaxOrderLines is the most lines an order gets, 3 when 0
+	MaxOrderLines int
+	// Seed makes the generated data repeatable, the current time when 0
+	Seed int64
+	// Prefix is put before SKUs and customer emails so seeded data is easy to recognise, "seed" when empty
+	Prefix string
+}
+
+// SeededResources are the IDs of what a Seeder created, save them to clean up from another process
+type SeededResources struct {
+	ProductIDs  []int64 `json:"product_ids"`
+	CustomerIDs []int64 `json:"customer_ids"`
+	OrderIDs    []int64 `json:"order_ids"`
+}
+
+// Seeder fills a development or sandbox store with generated products, customers and orders, and removes
+// them again with Cleanup. Never point it at a production store:
+//
+//	s := bc.Seeder(bigcommerce.SeedOptions{Products: 20, Customers: 10, Orders: 30})
+//	defer s.Cleanup()
+//	err := s.Seed()
+type Seeder struct {
+	client  *Client
+	opts    SeedOptions
+	rand    *rand.Rand
+	run     string
+	created SeededResources
+}
+
+// Seeder returns a Seeder for the store of the client
+func (bc *Client) Seeder(opts SeedOptions) *Seeder {
+	if opts.Seed == 0 {
+		opts.Seed = time.Now().UnixNano()
+	}
+	if opts.Prefix == "" {
+		opts.Prefix = "seed"
+	}
+	if opts.MaxOrderLines <= 0 {
+		opts.MaxOrderLines = 3
+	}
+	r := rand.New(rand.NewSource(opts.Seed))
+	return &Seeder{
+		client: bc,
+		opts:   opts,
+		rand:   r,
+		run:    strconv.FormatInt(r.Int63n(1<<40), 36),
+	}
+}
+
+// Created returns the IDs of everything created so far and not cleaned up yet
+func (s *Seeder) Created() SeededResources {
+	return SeededResources{
+		ProductIDs:  append([]int64{}, s.created.ProductIDs...),
+		CustomerIDs: append([]int64{}, s.created.CustomerIDs...),
+		OrderIDs:    append([]int64{}, s.created.OrderIDs...),
+	}
+}
+
+// Seed creates the products, then the customers, then orders of those customers for those products.
+// On error what was created so far is kept in Created, so Cleanup still removes it
+func (s *Seeder) Seed() error {
+	for i := 0; i < s.opts.Products; i++ {
+		id, err := s.createProduct(i)
+		if err != nil {
+			return fmt.Errorf("error seeding product %d: %w", i+1, err)
+		}
+		s.created.ProductIDs = append(s.created.ProductIDs, id)
+	}
+	for i := 0; i < s.opts.Customers; i++ {
+		customer, err := s.client.CreateAccount(s.customer(i))
+		if err != nil {
+			return fmt.Errorf("error seeding customer %d: %w", i+1, err)
+		}
+		s.created.CustomerIDs = append(s.created.CustomerIDs, customer.ID)
+	}
+	if s.opts.Orders > 0 && len(s.created.ProductIDs) == 0 {
+		return fmt.Errorf("seeding orders needs products")
+	}
+	for i := 0; i < s.opts.Orders; i++ {
+		id, err := s.createOrder()
+		if err != nil {
+			return fmt.Errorf("error seeding order %d: %w", i+1, err)
+		}
+		s.created.OrderIDs = append(s.created.OrderIDs, id)
+	}
+	return nil
+}
+
+// Cleanup deletes everything the Seeder created, orders first. Resources that are already gone are skipped,
+// what fails to delete stays in Created so Cleanup can be called again
+func (s *Seeder) Cleanup() error {
+	left, err := s.client.CleanupSeeded(s.created)
+	s.created = left
+	return err
+}
+
+// CleanupSeeded deletes seeded resources, e.g. saved from Seeder.Created by an earlier run, and returns
+// those it could not delete
+func (bc *Client) CleanupSeeded(r SeededResources) (SeededResources, error) {
+	left := SeededResources{}
+	var errs []error
+	for _, id := range r.OrderIDs {
+		err := bc.sendJSON(http.MethodDelete, newURL("/v2/orders").ID(id).String(), nil, nil)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			left.OrderIDs = append(left.OrderIDs, id)
+			errs = append(errs, fmt.Errorf("error deleting seeded order %d: %w", id, err))
+		}
+	}
+	var err error
+	left.CustomerIDs, err = bc.deleteSeeded("/v3/customers", r.CustomerIDs)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	left.ProductIDs, err = bc.deleteSeeded("/v3/catalog/products", r.ProductIDs)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	return left, errors.Join(errs...)
+}
+
+// deleteSeeded deletes ids from a v3 collection with id:in batches and returns the IDs of failed batches
+func (bc *Client) deleteSeeded(path string, ids []int64) ([]int64, error) {
+	var left []int64
+	var errs []error
+	for start := 0; start < len(ids); start += seedDeleteBatchSize {
+		end := start + seedDeleteBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		url := newURL(path).Param("id:in", joinIDs(ids[start:end])).String()
+		err := bc.sendJSON(http.MethodDelete, url, nil, nil)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			left = append(left, ids[start:end]...)
+			errs = append(errs, fmt.Errorf("error deleting seeded %s: %w", strings.TrimPrefix(path, "/v3/"), err))
+		}
+	}
+	return left, errors.Join(errs...)
+}
+
+func (s *Seeder) createProduct(i int) (int64, error) {
+	name := fmt.Sprintf("%s %s %s", s.pick(seedAdjectives), s.pick(seedMaterials), s.pick(seedNouns))
+	cost := float64(200+s.rand.Intn(4800)) / 100
+	payload := map[string]interface{}{
+		// names are unique per store
+		"name":               fmt.Sprintf("%s %s-%d", name, s.run, i+1),
+		"type":               "physical",
+		"sku":                fmt.Sprintf("%s-%s-%04d", strings.ToUpper(s.opts.Prefix), strings.ToUpper(s.run), i+1),
+		"description":        "<p>" + name + ", generated test data.</p>",
+		"price":              math.Round(cost*(150+s.rand.Float64()*100)) / 100,
+		"cost_price":         cost,
+		"weight":             float64(1+s.rand.Intn(50)) / 10,
+		"is_visible":         false,
+		"inventory_tracking": "product",
+		"inventory_level":    10 + s.rand.Intn(90),
+	}
+	var ret struct {
+		Data Product `json:"data"`
+	}
+	err := s.client.sendJSON(http.MethodPost, "/v3/catalog/products", payload, &ret)
+	return ret.Data.ID, err
+}
+
+func (s *Seeder) customer(i int) *CreateAccountPayload {
+	first, last := s.pick(seedFirstNames), s.pick(seedLastNames)
+	return &CreateAccountPayload{
+		FirstName: first,
+		LastName:  last,
+		Email:     fmt.Sprintf("%s+%s-%d@example.com", strings.ToLower(s.opts.Prefix), s.run, i+1),
+		Phone:     fmt.Sprintf("06%08d", s.rand.Intn(100000000)),
+		Notes:     "Generated test data",
+		Addresses: []Address{{
+			FirstName:   first,
+			LastName:    last,
+			Address1:    fmt.Sprintf("%s %d", s.pick(seedStreets), 1+s.rand.Intn(200)),
+			City:        s.pick(seedCities),
+			PostalCode:  fmt.Sprintf("%04d %c%c", 1000+s.rand.Intn(9000), 'A'+s.rand.Intn(26), 'A'+s.rand.Intn(26)),
+			CountryCode: "NL",
+			AddressType: "residential",
+		}},
+	}
+}
+
+func (s *Seeder) createOrder() (int64, error) {
+	first, last := s.pick(seedFirstNames), s.pick(seedLastNames)
+	// without seeded customers the orders are guest orders
+	var customerID int64
+	if len(s.created.CustomerIDs) > 0 {
+		customerID = s.created.CustomerIDs[s.rand.Intn(len(s.created.CustomerIDs))]
+	}
+	lines := []map[string]interface{}{}
+	used := map[int64]bool{}
+	for n := 1 + s.rand.Intn(s.opts.MaxOrderLines); n > 0; n-- {
+		id := s.created.ProductIDs[s.rand.Intn(len(s.created.ProductIDs))]
+		if used[id] {
+			continue
+		}
+		used[id] = true
+		lines = append(lines, map[string]interface{}{"product_id": id, "quantity": 1 + s.rand.Intn(3)})
+	}
+	payload := map[string]interface{}{
+		"customer_id": customerID,
+		"status_id":   OrderStatusAwaitingFulfillment,
+		"billing_address": OrderAddress{
+			FirstName:   first,
+			LastName:    last,
+			Street1:     fmt.Sprintf("%s %d", s.pick(seedStreets), 1+s.rand.Intn(200)),
+			City:        s.pick(seedCities),
+			Zip:         fmt.Sprintf("%04d AB", 1000+s.rand.Intn(9000)),
+			Country:     "Netherlands",
+			CountryIso2: "NL",
+			Email:       fmt.Sprintf("%s+%s-order@example.com", strings.ToLower(s.opts.Prefix), s.run),
+		},
+		"products": lines,
+	}
+	var ret struct {
+		ID int64 `json:"id"`
+	}
+	err := s.client.sendJSON(http.MethodPost, "/v2/orders", payload, &ret)
+	return ret.ID, err
+}
+
+func (s *Seeder) pick(values []string) string {
+	return values[s.rand.Intn(len(values))]
+}
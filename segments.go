@@ -0,0 +1,74 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Segment is a customer segment, used to target promotions at groups of shoppers
+type Segment struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+}
+
+// GetSegments returns all customer segments
+func (bc *Client) GetSegments() ([]Segment, error) {
+	return ListPages[Segment](bc, "/v3/segments", nil)
+}
+
+// GetSegmentByName returns the segment with name, ErrNotFound if there is none
+func (bc *Client) GetSegmentByName(name string) (*Segment, error) {
+	segments, err := bc.GetSegments()
+	if err != nil {
+		return nil, err
+	}
+	for i := range segments {
+		if segments[i].Name == name {
+			return &segments[i], nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// CreateSegment creates a customer segment
+func (bc *Client) CreateSegment(segment Segment) (*Segment, error) {
+	reqJSON, err := bc.marshal([]Segment{segment})
+	if err != nil {
+		return nil, err
+	}
+	req := bc.getAPIRequest(http.MethodPost, "/v3/segments", bytes.NewReader(reqJSON))
+	res, err := bc.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil {
+		return nil, fmt.Errorf("error creating segment %s: %w %s", segment.Name, err, string(body))
+	}
+	var segmentResponse struct {
+		Data []Segment `json:"data"`
+	}
+	err = bc.unmarshal(body, &segmentResponse)
+	if err != nil {
+		return nil, err
+	}
+	if len(segmentResponse.Data) == 0 {
+		return nil, fmt.Errorf("error creating segment %s: empty response", segment.Name)
+	}
+	return &segmentResponse.Data[0], nil
+}
+
+// EnsureSegment returns the segment with name, creating it if it doesn't exist
+func (bc *Client) EnsureSegment(name, description string) (*Segment, error) {
+	segment, err := bc.GetSegmentByName(name)
+	if !errors.Is(err, ErrNotFound) {
+		return segment, err
+	}
+	return bc.CreateSegment(Segment{Name: name, Description: description})
+}
@@ -0,0 +1,132 @@
+package bigcommerce
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ShipmentLocationsNamespace is the order metafield namespace shipment locations are stored in,
+// one metafield per shipment with key "shipment_{id}" and the location ID as value
+const ShipmentLocationsNamespace = "shipment_locations"
+
+// ShipmentLocationOptions configures CreateOrderShipmentFromLocation
+type ShipmentLocationOptions struct {
+	// AdjustInventory decrements the shipped quantities at the fulfilling location
+	AdjustInventory bool
+	// RestockLocationID, when set with AdjustInventory, adds the shipped quantities back at this location,
+	// for stores where placing the order already took the stock from the default location
+	RestockLocationID int64
+	// CommentPrefix is put before the shipment comments, "Shipped from {location label}" when empty
+	CommentPrefix string
+}
+
+// CreateOrderShipmentFromLocation creates a shipment fulfilled from a location: the location is named in the
+// shipment comments, recorded in an order metafield and, when enabled, stock is adjusted at the location
+func (bc *Client) CreateOrderShipmentFromLocation(orderID, locationID int64, shipment Shipment, opts ShipmentLocationOptions) (*Shipment, error) {
+	locations, err := bc.GetLocations(map[string]string{"location_id:in": strconv.FormatInt(locationID, 10)})
+	if err != nil {
+		return nil, err
+	}
+	if len(locations) == 0 {
+		return nil, ErrNotFound
+	}
+	prefix := opts.CommentPrefix
+	if prefix == "" {
+		prefix = "Shipped from " + locations[0].Label
+	}
+	shipment.Comments = TruncateShipmentComments(strings.TrimSpace(prefix + "\n" + shipment.Comments))
+
+	created, err := bc.CreateOrderShipment(orderID, shipment)
+	if err != nil {
+		return nil, err
+	}
+	_, err = bc.CreateOrderMetafield(orderID, Metafield{
+		Namespace: ShipmentLocationsNamespace,
+		Key:       shipmentLocationKey(created.ID),
+		Value:     strconv.FormatInt(locationID, 10),
+	})
+	if err != nil {
+		return created, err
+	}
+	if !opts.AdjustInventory {
+		return created, nil
+	}
+	return created, bc.adjustShipmentLocation(orderID, locationID, opts.RestockLocationID, created)
+}
+
+// GetShipmentLocation returns the location a shipment was fulfilled from, ErrNotFound if it wasn't recorded
+func (bc *Client) GetShipmentLocation(orderID, shipmentID int64) (int64, error) {
+	locations, err := bc.GetOrderShipmentLocations(orderID)
+	if err != nil {
+		return 0, err
+	}
+	locationID, ok := locations[shipmentID]
+	if !ok {
+		return 0, ErrNotFound
+	}
+	return locationID, nil
+}
+
+// GetOrderShipmentLocations returns the recorded fulfilling location of the order's shipments, by shipment ID
+func (bc *Client) GetOrderShipmentLocations(orderID int64) (map[int64]int64, error) {
+	mfs, err := bc.GetOrderMetafields(orderID, ShipmentLocationsNamespace)
+	if err != nil {
+		return nil, err
+	}
+	ret := map[int64]int64{}
+	for _, mf := range mfs {
+		shipmentID, err := strconv.ParseInt(strings.TrimPrefix(mf.Key, "shipment_"), 10, 64)
+		if err != nil {
+			continue
+		}
+		locationID, err := strconv.ParseInt(mf.Value, 10, 64)
+		if err != nil {
+			continue
+		}
+		ret[shipmentID] = locationID
+	}
+	return ret, nil
+}
+
+// adjustShipmentLocation takes the shipped quantities from the fulfilling location
+func (bc *Client) adjustShipmentLocation(orderID, locationID, restockLocationID int64, shipment *Shipment) error {
+	products, err := bc.GetOrderProducts(orderID)
+	if err != nil {
+		return err
+	}
+	variants := map[int64]int64{}
+	for _, p := range products {
+		variants[p.ID] = p.VariantID
+	}
+	adjustment := &Adjustment{
+		Reason:    "Shipment " + strconv.FormatInt(shipment.ID, 10) + " of order " + strconv.FormatInt(orderID, 10),
+		Reference: strconv.FormatInt(orderID, 10),
+	}
+	for _, item := range shipment.Items {
+		variantID := variants[item.OrderProductId]
+		if variantID == 0 {
+			return errors.New("shipment item without variant, order product " + strconv.FormatInt(item.OrderProductId, 10))
+		}
+		adjustment.Items = append(adjustment.Items, AdjustmentItem{
+			LocationId: locationID,
+			VariantId:  variantID,
+			Quantity:   -int(item.Quantity),
+		})
+		if restockLocationID != 0 && restockLocationID != locationID {
+			adjustment.Items = append(adjustment.Items, AdjustmentItem{
+				LocationId: restockLocationID,
+				VariantId:  variantID,
+				Quantity:   int(item.Quantity),
+			})
+		}
+	}
+	if len(adjustment.Items) == 0 {
+		return nil
+	}
+	return bc.AdjustInventoryRelative(adjustment)
+}
+
+func shipmentLocationKey(shipmentID int64) string {
+	return "shipment_" + strconv.FormatInt(shipmentID, 10)
+}
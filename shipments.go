@@ -2,10 +2,11 @@ package bigcommerce
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+	"text/template"
 )
 
 type Shipment struct {
@@ -48,14 +49,12 @@ type ShipmentItem struct {
 
 // GetOrderShipments retrieves all shipments that belong to a specific order
 func (bc *Client) GetOrderShipments(orderId int64, filters map[string]string) ([]Shipment, error) {
-	var params []string
-	for k, v := range filters {
-		params = append(params, fmt.Sprintf("%s=%s", k, v))
-	}
-	url := fmt.Sprintf("/v2/orders/%d/shipments?%s", orderId, strings.Join(params, "&"))
+	return bc.getOrderShipments(newURL("/v2/orders").ID(orderId).Segment("shipments").Args(filters).String())
+}
 
+func (bc *Client) getOrderShipments(url string) ([]Shipment, error) {
 	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -70,17 +69,38 @@ func (bc *Client) GetOrderShipments(orderId int64, filters map[string]string) ([
 	}
 
 	var shipments []Shipment
-	err = json.Unmarshal(body, &shipments)
+	err = bc.unmarshal(body, &shipments)
 	if err != nil {
 		return nil, err
 	}
 	return shipments, nil
 }
 
+// GetAllOrderShipments retrieves all shipments that belong to a specific order, handling pagination
+func (bc *Client) GetAllOrderShipments(orderId int64) ([]Shipment, error) {
+	ss := []Shipment{}
+	page := 1
+	for {
+		ssp, err := bc.GetOrderShipments(orderId, map[string]string{
+			"page":  strconv.Itoa(page),
+			"limit": strconv.Itoa(v2PageLimit),
+		})
+		if err != nil {
+			return ss, err
+		}
+		ss = append(ss, ssp...)
+		if len(ssp) < v2PageLimit {
+			break
+		}
+		page++
+	}
+	return ss, nil
+}
+
 // CreateOrderShipment creates a new shipment belonging to an order.
 // If the shipment does not contain all products, bigcommerce will by default tag the order as partially done
 func (bc *Client) CreateOrderShipment(orderId int64, shipment Shipment) (*Shipment, error) {
-	url := fmt.Sprintf("/v2/orders/%d/shipments", orderId)
+	url := newURL("/v2/orders").ID(orderId).Segment("shipments").String()
 
 	// Make sure shipment doesn't have any fields that are not allowed
 	shipment = Shipment{
@@ -93,13 +113,13 @@ func (bc *Client) CreateOrderShipment(orderId int64, shipment Shipment) (*Shipme
 		Items:            shipment.Items,
 	}
 
-	reqJSON, err := json.Marshal(shipment)
+	reqJSON, err := bc.marshal(shipment)
 	if err != nil {
 		return nil, err
 	}
 
 	req := bc.getAPIRequest(http.MethodPost, url, bytes.NewReader(reqJSON))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 
 	if err != nil {
 		return nil, err
@@ -116,7 +136,7 @@ func (bc *Client) CreateOrderShipment(orderId int64, shipment Shipment) (*Shipme
 	}
 
 	var s *Shipment
-	err = json.Unmarshal(body, &s)
+	err = bc.unmarshal(body, &s)
 	if err != nil {
 		return nil, err
 	}
@@ -125,38 +145,44 @@ func (bc *Client) CreateOrderShipment(orderId int64, shipment Shipment) (*Shipme
 
 // DeleteOrderShipments deletes ALL shipments belonging to an order
 func (bc *Client) DeleteOrderShipments(orderId int64) (bool, error) {
-	url := fmt.Sprintf("/v2/orders/%d/shipments", orderId)
+	url := newURL("/v2/orders").ID(orderId).Segment("shipments").String()
 
 	req := bc.getAPIRequest(http.MethodDelete, url, nil)
-	_, err := bc.HTTPClient.Do(req)
-
+	res, err := bc.do(req)
 	if err != nil {
 		return false, err
 	}
-
+	defer res.Body.Close()
+	_, err = processBody(res)
+	if err != nil && err != ErrNoContent {
+		return false, err
+	}
 	return true, nil
 }
 
 // DeleteOrderShipment deletes a single shipment under an order
 func (bc *Client) DeleteOrderShipment(orderId int64, shipmentId int64) (bool, error) {
-	url := fmt.Sprintf("/v2/orders/%d/shipments/%d", orderId, shipmentId)
+	url := newURL("/v2/orders").ID(orderId).Segment("shipments").ID(shipmentId).String()
 
 	req := bc.getAPIRequest(http.MethodDelete, url, nil)
-	_, err := bc.HTTPClient.Do(req)
-
+	res, err := bc.do(req)
 	if err != nil {
 		return false, err
 	}
-
+	defer res.Body.Close()
+	_, err = processBody(res)
+	if err != nil && err != ErrNoContent {
+		return false, err
+	}
 	return true, nil
 }
 
 // GetOrderShipment retrieves a single shipment
 func (bc *Client) GetOrderShipment(orderId int64, shipmentId int64) (*Shipment, error) {
-	url := fmt.Sprintf("/v2/orders/%d/shipments/%d", orderId, shipmentId)
+	url := newURL("/v2/orders").ID(orderId).Segment("shipments").ID(shipmentId).String()
 
 	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -171,7 +197,7 @@ func (bc *Client) GetOrderShipment(orderId int64, shipmentId int64) (*Shipment,
 	}
 
 	var shipment *Shipment
-	err = json.Unmarshal(body, &shipment)
+	err = bc.unmarshal(body, &shipment)
 	if err != nil {
 		return nil, err
 	}
@@ -181,7 +207,7 @@ func (bc *Client) GetOrderShipment(orderId int64, shipmentId int64) (*Shipment,
 // UpdateOrderShipment updates an existing shipment belonging to an order.
 // If the shipment does not contain all products, bigcommerce will by default tag the order as partially done
 func (bc *Client) UpdateOrderShipment(orderId int64, shipment Shipment) (*Shipment, error) {
-	url := fmt.Sprintf("/v2/orders/%d/shipments/%d", orderId, shipment.ID)
+	url := newURL("/v2/orders").ID(orderId).Segment("shipments").ID(shipment.ID).String()
 
 	// Make sure shipment doesn't have any fields that are not allowed
 	shipment = Shipment{
@@ -194,13 +220,13 @@ func (bc *Client) UpdateOrderShipment(orderId int64, shipment Shipment) (*Shipme
 		Items:            shipment.Items,
 	}
 
-	reqJSON, err := json.Marshal(shipment)
+	reqJSON, err := bc.marshal(shipment)
 	if err != nil {
 		return nil, err
 	}
 
 	req := bc.getAPIRequest(http.MethodPut, url, bytes.NewReader(reqJSON))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 
 	if err != nil {
 		return nil, err
@@ -217,9 +243,108 @@ func (bc *Client) UpdateOrderShipment(orderId int64, shipment Shipment) (*Shipme
 	}
 
 	var s *Shipment
-	err = json.Unmarshal(body, &s)
+	err = bc.unmarshal(body, &s)
 	if err != nil {
 		return nil, err
 	}
 	return s, nil
 }
+
+// MaxShipmentCommentsLength is the longest shipment comment BigCommerce accepts, longer comments fail with 422
+const MaxShipmentCommentsLength = 255
+
+// RenderShipmentComments renders a text/template into a shipment comment, truncated to MaxShipmentCommentsLength
+// The template gets .Order and .Shipment, e.g. "Order {{.Order.ID}} shipped with {{.Shipment.TrackingCarrier}}"
+func RenderShipmentComments(tmpl string, order *Order, shipment *Shipment) (string, error) {
+	t, err := template.New("comments").Parse(tmpl)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	err = t.Execute(&b, struct {
+		Order    *Order
+		Shipment *Shipment
+	}{
+		Order:    order,
+		Shipment: shipment,
+	})
+	if err != nil {
+		return "", err
+	}
+	return TruncateShipmentComments(b.String()), nil
+}
+
+// TruncateShipmentComments cuts comments to MaxShipmentCommentsLength characters, ending with "..." when cut
+func TruncateShipmentComments(comments string) string {
+	comments = strings.TrimSpace(comments)
+	r := []rune(comments)
+	if len(r) <= MaxShipmentCommentsLength {
+		return comments
+	}
+	return string(r[:MaxShipmentCommentsLength-3]) + "..."
+}
+
+// CaptureFailurePolicy decides what CreateOrderShipmentAndCapture does when the payment capture fails
+type CaptureFailurePolicy int
+
+const (
+	// CaptureFailureReturnError returns the created shipment together with the capture error
+	CaptureFailureReturnError CaptureFailurePolicy = iota
+	// CaptureFailureIgnore logs the capture error and returns the shipment without error
+	CaptureFailureIgnore
+	// CaptureFailureDeleteShipment deletes the just created shipment and returns the capture error
+	CaptureFailureDeleteShipment
+)
+
+// CreateOrderShipmentAndCapture creates a shipment and captures the order's authorized payment right after,
+// onCaptureFailure decides what happens when the capture fails (the shipment was created by then)
+func (bc *Client) CreateOrderShipmentAndCapture(orderId int64, shipment Shipment, onCaptureFailure CaptureFailurePolicy) (*Shipment, error) {
+	s, err := bc.CreateOrderShipment(orderId, shipment)
+	if err != nil {
+		return nil, err
+	}
+	err = bc.CaptureOrderPayment(orderId)
+	if err == nil {
+		return s, nil
+	}
+	switch onCaptureFailure {
+	case CaptureFailureIgnore:
+		bc.logf("order %d: shipment %d created, %v", orderId, s.ID, err)
+		return s, nil
+	case CaptureFailureDeleteShipment:
+		if s.ID != 0 {
+			_, derr := bc.DeleteOrderShipment(orderId, s.ID)
+			if derr != nil {
+				return s, fmt.Errorf("%v, deleting shipment %d failed too: %v", err, s.ID, derr)
+			}
+		}
+		return nil, err
+	}
+	return s, err
+}
+
+// ResendShipmentNotification makes BigCommerce send the shipped email of an order again.
+// There is no resend endpoint, so the order status is moved to Awaiting Shipment and back,
+// which sends the status change email if it is enabled for the status in the store's order notification settings.
+// Both status changes show up in the order history
+func (bc *Client) ResendShipmentNotification(orderID int64) error {
+	var order struct {
+		StatusID int64 `json:"status_id"`
+	}
+	err := bc.getJSON(newURL("/v2/orders").ID(orderID).String(), &order)
+	if err != nil {
+		return err
+	}
+	if order.StatusID != OrderStatusShipped && order.StatusID != OrderStatusPartiallyShipped {
+		return fmt.Errorf("order %d is not shipped (status %d)", orderID, order.StatusID)
+	}
+	err = bc.UpdateOrder(orderID, &UpdateOrder{StatusID: OrderStatusAwaitingShipment})
+	if err != nil {
+		return err
+	}
+	err = bc.UpdateOrder(orderID, &UpdateOrder{StatusID: order.StatusID})
+	if err != nil {
+		return fmt.Errorf("order %d left in Awaiting Shipment, restoring status %d failed: %v", orderID, order.StatusID, err)
+	}
+	return nil
+}
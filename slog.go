@@ -0,0 +1,72 @@
+package bigcommerce
+
+import (
+	"log/slog"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// redactedHeaders are logged as REDACTED, they hold credentials
+var redactedHeaders = map[string]bool{
+	"X-Auth-Token":  true,
+	"Authorization": true,
+}
+
+// WithSlog logs every request at debug level to l, see Client.Slog
+func WithSlog(l *slog.Logger) Option {
+	return func(bc *Client) {
+		bc.Slog = l
+	}
+}
+
+// logRequest logs a request attempt to the client's Slog at debug level
+func (bc *Client) logRequest(req *http.Request, res *http.Response, err error, latency time.Duration) {
+	if bc.Slog == nil || !bc.Slog.Enabled(req.Context(), slog.LevelDebug) {
+		return
+	}
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", req.URL.String()),
+		slog.Duration("latency", latency),
+		slog.Any("headers", redactedHeader(req.Header)),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	} else {
+		attrs = append(attrs, slog.Int("status", res.StatusCode))
+	}
+	if status := bc.RateLimitStatus(); !status.UpdatedAt.IsZero() {
+		attrs = append(attrs, slog.Group("rate_limit",
+			slog.Int("requests_left", status.RequestsLeft),
+			slog.Int("requests_quota", status.RequestsQuota),
+			slog.Time("reset_at", status.ResetAt),
+		))
+	}
+	bc.Slog.LogAttrs(req.Context(), slog.LevelDebug, "bigcommerce request", attrs...)
+}
+
+// redactedHeader logs request headers with the credentials replaced
+type redactedHeader http.Header
+
+// LogValue returns the headers as a group, one attribute per header
+func (h redactedHeader) LogValue() slog.Value {
+	names := make([]string, 0, len(h))
+	for name := range h {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	attrs := make([]slog.Attr, 0, len(h))
+	for _, name := range names {
+		values := h[name]
+		value := ""
+		if len(values) > 0 {
+			value = values[0]
+		}
+		if redactedHeaders[http.CanonicalHeaderKey(name)] {
+			value = "REDACTED"
+		}
+		attrs = append(attrs, slog.String(name, value))
+	}
+	return slog.GroupValue(attrs...)
+}
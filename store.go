@@ -1,7 +1,6 @@
 package bigcommerce
 
 import (
-	"encoding/json"
 	"net/http"
 )
 
@@ -65,7 +64,7 @@ type StoreInfo struct {
 func (bc *Client) GetStoreInfo() (StoreInfo, error) {
 	var storeInfo StoreInfo
 	req := bc.getAPIRequest(http.MethodGet, "/v2/store", nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return storeInfo, err
 	}
@@ -76,6 +75,6 @@ func (bc *Client) GetStoreInfo() (StoreInfo, error) {
 		return storeInfo, err
 	}
 
-	err = json.Unmarshal(body, &storeInfo)
+	err = bc.unmarshal(body, &storeInfo)
 	return storeInfo, err
 }
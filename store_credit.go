@@ -0,0 +1,133 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// StoreCreditChange is an audit record of a store credit update made through the client
+type StoreCreditChange struct {
+	Time       time.Time `json:"time"`
+	CustomerID int64     `json:"customer_id"`
+	Previous   float64   `json:"previous"`
+	New        float64   `json:"new"`
+	Reason     string    `json:"reason,omitempty"`
+	Reference  string    `json:"reference,omitempty"` // e.g. the order or RMA the credit was issued for
+	Error      string    `json:"error,omitempty"`
+}
+
+// StoreCreditSink stores store credit changes, set Client.StoreCreditSink to keep an audit trail
+type StoreCreditSink interface {
+	RecordStoreCredit(change StoreCreditChange) error
+}
+
+// GetCustomerStoreCredit returns the store credit balance of a customer
+func (bc *Client) GetCustomerStoreCredit(customerID int64) (float64, error) {
+	url := newURL("/v3/customers").Int("id:in", customerID).Param("include", "storecredit").String()
+	req := bc.getAPIRequest(http.MethodGet, url, nil)
+	res, err := bc.do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil {
+		return 0, err
+	}
+	var ret struct {
+		Data []Customer `json:"data"`
+	}
+	err = bc.unmarshal(body, &ret)
+	if err != nil {
+		return 0, err
+	}
+	if len(ret.Data) == 0 {
+		return 0, ErrNotFound
+	}
+	total := 0.0
+	for _, sc := range ret.Data[0].StoreCreditAmounts {
+		total += sc.Amount
+	}
+	return total, nil
+}
+
+// SetCustomerStoreCredit sets the store credit balance of a customer
+// reason, reference: recorded in the StoreCreditSink, not sent to BigCommerce
+func (bc *Client) SetCustomerStoreCredit(customerID int64, amount float64, reason, reference string) error {
+	previous, err := bc.GetCustomerStoreCredit(customerID)
+	if err != nil {
+		return err
+	}
+	err = bc.putStoreCredit(customerID, amount)
+	bc.recordStoreCredit(StoreCreditChange{
+		CustomerID: customerID,
+		Previous:   previous,
+		New:        amount,
+		Reason:     reason,
+		Reference:  reference,
+	}, err)
+	return err
+}
+
+// AddCustomerStoreCredit adds amount (negative to deduct) to the store credit of a customer,
+// returning the new balance, e.g. to issue a loyalty refund as store credit
+// the balance is read and then written, so concurrent updates of the same customer can be lost
+func (bc *Client) AddCustomerStoreCredit(customerID int64, amount float64, reason, reference string) (float64, error) {
+	previous, err := bc.GetCustomerStoreCredit(customerID)
+	if err != nil {
+		return 0, err
+	}
+	balance := previous + amount
+	if balance < 0 {
+		return previous, fmt.Errorf("store credit of customer %d would become negative: %.2f", customerID, balance)
+	}
+	err = bc.putStoreCredit(customerID, balance)
+	bc.recordStoreCredit(StoreCreditChange{
+		CustomerID: customerID,
+		Previous:   previous,
+		New:        balance,
+		Reason:     reason,
+		Reference:  reference,
+	}, err)
+	if err != nil {
+		return previous, err
+	}
+	return balance, nil
+}
+
+func (bc *Client) putStoreCredit(customerID int64, amount float64) error {
+	reqJSON, err := bc.marshal([]map[string]interface{}{{
+		"id":                   customerID,
+		"store_credit_amounts": []StoreCredit{{Amount: amount}},
+	}})
+	if err != nil {
+		return err
+	}
+	req := bc.getAPIRequest(http.MethodPut, "/v3/customers", bytes.NewReader(reqJSON))
+	res, err := bc.do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil {
+		return fmt.Errorf("error updating store credit of customer %d: %w %s", customerID, err, string(body))
+	}
+	return nil
+}
+
+func (bc *Client) recordStoreCredit(change StoreCreditChange, err error) {
+	if bc.StoreCreditSink == nil {
+		return
+	}
+	change.Time = time.Now()
+	if err != nil {
+		change.Error = err.Error()
+	}
+	serr := bc.StoreCreditSink.RecordStoreCredit(change)
+	if serr != nil {
+		bc.logf("error recording store credit change: %v", serr)
+	}
+}
@@ -0,0 +1,164 @@
+package bigcommerce
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrUnknownStore is returned by requests of a StoreManager client for a store it has no credentials for
+var ErrUnknownStore = errors.New("unknown store")
+
+// StoreCredentials are what a StoreManager needs to build the client of a store
+type StoreCredentials struct {
+	StoreHash  string `json:"store_hash"`
+	XAuthToken string `json:"access_token"`
+	// ChannelID of the store's channel aware endpoints, 1 when 0
+	ChannelID int64 `json:"channel_id,omitempty"`
+}
+
+// StoreManager holds the clients of an app installed in many stores. Clients are built on first use from
+// the stored credentials, or the ones Lookup returns, and share one HTTP transport:
+//
+//	stores := bigcommerce.NewStoreManager(bigcommerce.WithLogger(logger))
+//	stores.Lookup = func(storeHash string) (bigcommerce.StoreCredentials, error) {
+//		return db.LoadInstall(storeHash)
+//	}
+//	shipment, err := stores.For(storeHash).CreateOrderShipment(orderID, shipment)
+type StoreManager struct {
+	// Lookup, when set, loads the credentials of stores that were not added
+	Lookup func(storeHash string) (StoreCredentials, error)
+	// Budget, when set, is shared by the requests to all stores, e.g. to stay within the app's own limits
+	Budget *TokenBucket
+	// StoreRequests, when more than 0, limits the requests per QuotaWindow to each store
+	StoreRequests int
+
+	opts        []Option
+	httpClient  HTTPClient
+	mu          sync.Mutex
+	credentials map[string]StoreCredentials
+	clients     map[string]*Client
+}
+
+// NewStoreManager returns an empty manager, opts are applied to every client it builds
+func NewStoreManager(opts ...Option) *StoreManager {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// all stores share the API host, keep more connections to it open
+	transport.MaxIdleConnsPerHost = 32
+	return &StoreManager{
+		opts:        opts,
+		httpClient:  &http.Client{Timeout: time.Second * 10, Transport: transport},
+		credentials: map[string]StoreCredentials{},
+		clients:     map[string]*Client{},
+	}
+}
+
+// Add sets the credentials of a store, replacing its client when the credentials changed
+func (m *StoreManager) Add(c StoreCredentials) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if old, ok := m.credentials[c.StoreHash]; ok && old != c {
+		delete(m.clients, c.StoreHash)
+	}
+	m.credentials[c.StoreHash] = c
+}
+
+// Remove forgets a store, e.g. when the app was uninstalled
+func (m *StoreManager) Remove(storeHash string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.credentials, storeHash)
+	delete(m.clients, storeHash)
+}
+
+// Stores returns the hashes of the stores with credentials, sorted
+func (m *StoreManager) Stores() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	hashes := make([]string, 0, len(m.credentials))
+	for h := range m.credentials {
+		hashes = append(hashes, h)
+	}
+	sort.Strings(hashes)
+	return hashes
+}
+
+// Client returns the client of a store, building it on first use
+func (m *StoreManager) Client(storeHash string) (*Client, error) {
+	m.mu.Lock()
+	if bc, ok := m.clients[storeHash]; ok {
+		m.mu.Unlock()
+		return bc, nil
+	}
+	c, ok := m.credentials[storeHash]
+	m.mu.Unlock()
+	if !ok {
+		if m.Lookup == nil {
+			return nil, fmt.Errorf("%w %s", ErrUnknownStore, storeHash)
+		}
+		var err error
+		c, err = m.Lookup(storeHash)
+		if err != nil {
+			return nil, fmt.Errorf("error looking up store %s: %w", storeHash, err)
+		}
+		c.StoreHash = storeHash
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	// another goroutine may have built it meanwhile
+	if bc, ok := m.clients[storeHash]; ok {
+		return bc, nil
+	}
+	bc := NewClient(storeHash, c.XAuthToken, append([]Option{WithHTTPClient(m.httpClient)}, m.opts...)...)
+	if c.ChannelID != 0 {
+		bc.ChannelID = c.ChannelID
+	}
+	switch {
+	case m.Budget != nil && m.StoreRequests > 0:
+		bc.Budget = m.Budget.Split(m.StoreRequests, QuotaWindow)
+	case m.Budget != nil:
+		bc.Budget = m.Budget
+	case m.StoreRequests > 0:
+		bc.Budget = NewTokenBucket(m.StoreRequests, QuotaWindow)
+	}
+	m.credentials[storeHash] = c
+	m.clients[storeHash] = bc
+	return bc, nil
+}
+
+// For returns the client of a store for chaining calls. For a store without credentials, or when Lookup
+// fails, the client's requests fail with that error, which wraps ErrUnknownStore
+func (m *StoreManager) For(storeHash string) *Client {
+	bc, err := m.Client(storeHash)
+	if err == nil {
+		return bc
+	}
+	return NewClient(storeHash, "", WithRetryPolicy(nil), WithMaxRetries(0), WithMiddleware(func(http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(*http.Request) (*http.Response, error) {
+			if errors.Is(err, ErrUnknownStore) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("%w: %v", ErrUnknownStore, err)
+		})
+	}))
+}
+
+// Each calls fn with the client of every store with credentials, in store hash order, and returns the errors
+// of all stores joined
+func (m *StoreManager) Each(fn func(storeHash string, bc *Client) error) error {
+	var errs []error
+	for _, h := range m.Stores() {
+		bc, err := m.Client(h)
+		if err == nil {
+			err = fn(h, bc)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("store %s: %w", h, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,246 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrQueryTooComplex is matched by GraphQLErrors when BigCommerce rejected a query for exceeding the complexity
+// limit, split the query or request fewer items per page
+var ErrQueryTooComplex = errors.New("graphql query too complex")
+
+// Is matches ErrQueryTooComplex when one of the errors is about the query complexity
+func (e GraphQLErrors) Is(target error) bool {
+	if target != ErrQueryTooComplex {
+		return false
+	}
+	for _, ge := range e {
+		if strings.Contains(strings.ToLower(ge.Message), "complexity") {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultStorefrontTokenTTL is the lifetime of the storefront tokens a GraphQLClient mints
+const defaultStorefrontTokenTTL = 24 * time.Hour
+
+// GraphQLClient runs queries and mutations against the GraphQL Storefront API of a channel, with storefront
+// tokens minted through the client as needed, e.g. for headless storefront backends. Requests go through the
+// client's HTTPClient and Middleware, but not its Budget, rate limit tracking, retries or DryRun:
+//
+//	sf := bigcommerce.NewGraphQLClient(bc, channelID)
+//	product, err := sf.ProductBySKU("SKU-1")
+type GraphQLClient struct {
+	client *Client
+	// URL is the GraphQL endpoint of the storefront, the channel's mybigcommerce.com host by default
+	URL string
+	// Tokens hands out the storefront tokens requests are sent with
+	Tokens *StorefrontTokenProvider
+	// CustomerID, when set, runs requests as this customer, e.g. to see their prices or carts.
+	// It needs customer impersonation tokens, see NewCustomerGraphQLClient
+	CustomerID int64
+}
+
+// NewGraphQLClient returns a Storefront API client for a channel, it mints tokens valid for a day
+func NewGraphQLClient(bc *Client, channelID int64) *GraphQLClient {
+	return &GraphQLClient{
+		client: bc,
+		URL:    StorefrontGraphQLURL(bc.StoreHash, channelID),
+		Tokens: bc.StorefrontTokenProvider(channelID, defaultStorefrontTokenTTL),
+	}
+}
+
+// NewCustomerGraphQLClient returns a Storefront API client for a channel that mints customer impersonation
+// tokens, set CustomerID to run requests as a customer. Only use it server side
+func NewCustomerGraphQLClient(bc *Client, channelID int64) *GraphQLClient {
+	c := NewGraphQLClient(bc, channelID)
+	c.Tokens.CustomerImpersonation = true
+	return c
+}
+
+// StorefrontGraphQLURL returns the GraphQL endpoint of a channel on its mybigcommerce.com host,
+// the default channel 1 has no channel ID in the host
+func StorefrontGraphQLURL(storeHash string, channelID int64) string {
+	if channelID <= 1 {
+		return "https://store-" + storeHash + ".mybigcommerce.com/graphql"
+	}
+	return fmt.Sprintf("https://store-%s-%d.mybigcommerce.com/graphql", storeHash, channelID)
+}
+
+// Do executes a query or mutation against the Storefront API
+// query: the GraphQL document
+// variables: GraphQL variables, may be nil
+// result: pointer to unmarshal the "data" part of the response into, may be nil
+// Errors in the response are returned as GraphQLErrors, matching ErrQueryTooComplex for queries over the
+// complexity limit. When the token was rejected a new one is minted and the request sent once more
+func (c *GraphQLClient) Do(query string, variables map[string]interface{}, result interface{}) error {
+	err := c.do(query, variables, result)
+	if errors.Is(err, ErrUnauthorized) {
+		c.Tokens.reset()
+		err = c.do(query, variables, result)
+	}
+	return err
+}
+
+func (c *GraphQLClient) do(query string, variables map[string]interface{}, result interface{}) error {
+	bc := c.client
+	token, err := c.Tokens.Token()
+	if err != nil {
+		return fmt.Errorf("error getting storefront token: %w", err)
+	}
+	reqJSON, err := bc.marshal(map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(bc.requestContext(), http.MethodPost, c.URL, bytes.NewReader(reqJSON))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+token)
+	req.Header.Add("Accept", "application/json")
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("User-Agent", bc.userAgent())
+	if c.CustomerID != 0 {
+		req.Header.Add("X-Bc-Customer-Id", strconv.FormatInt(c.CustomerID, 10))
+	}
+	// the storefront has its own rate limits and no admin scopes, only the transport is shared with the admin API
+	res, err := bc.transport().RoundTrip(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil {
+		return err
+	}
+
+	var gqlResponse struct {
+		Data   json.RawMessage `json:"data"`
+		Errors GraphQLErrors   `json:"errors"`
+	}
+	err = bc.unmarshal(body, &gqlResponse)
+	if err != nil {
+		return err
+	}
+	if len(gqlResponse.Errors) > 0 {
+		return gqlResponse.Errors
+	}
+	if result == nil || len(gqlResponse.Data) == 0 {
+		return nil
+	}
+	return bc.codec().Unmarshal(gqlResponse.Data, result)
+}
+
+// StorefrontMoney is an amount in the Storefront API
+type StorefrontMoney struct {
+	Value        float64 `json:"value"`
+	CurrencyCode string  `json:"currencyCode"`
+}
+
+// StorefrontProduct is a product as the storefront shows it, with the prices of the channel
+type StorefrontProduct struct {
+	EntityID int64  `json:"entityId"`
+	Name     string `json:"name"`
+	Sku      string `json:"sku"`
+	Path     string `json:"path"`
+	Prices   struct {
+		Price     StorefrontMoney  `json:"price"`
+		SalePrice *StorefrontMoney `json:"salePrice"`
+	} `json:"prices"`
+	Inventory struct {
+		IsInStock bool `json:"isInStock"`
+	} `json:"inventory"`
+}
+
+// ProductBySKU returns the product with a product or variant SKU, ErrNotFound if the channel has none
+func (c *GraphQLClient) ProductBySKU(sku string) (*StorefrontProduct, error) {
+	query := `query ($sku: String!) {
+  site {
+    product(sku: $sku) {
+      entityId name sku path
+      prices { price { value currencyCode } salePrice { value currencyCode } }
+      inventory { isInStock }
+    }
+  }
+}`
+	var ret struct {
+		Site struct {
+			Product *StorefrontProduct `json:"product"`
+		} `json:"site"`
+	}
+	err := c.Do(query, map[string]interface{}{"sku": sku}, &ret)
+	if err != nil {
+		return nil, err
+	}
+	if ret.Site.Product == nil {
+		return nil, fmt.Errorf("product with sku %s: %w", sku, ErrNotFound)
+	}
+	return ret.Site.Product, nil
+}
+
+// StorefrontCartItem is a line item of a StorefrontCart
+type StorefrontCartItem struct {
+	EntityID          string          `json:"entityId"`
+	ProductEntityID   int64           `json:"productEntityId"`
+	VariantEntityID   int64           `json:"variantEntityId"`
+	Sku               string          `json:"sku"`
+	Name              string          `json:"name"`
+	Quantity          int             `json:"quantity"`
+	ExtendedSalePrice StorefrontMoney `json:"extendedSalePrice"`
+}
+
+// StorefrontCart is a cart as the storefront shows it
+type StorefrontCart struct {
+	EntityID         string          `json:"entityId"`
+	CurrencyCode     string          `json:"currencyCode"`
+	IsTaxIncluded    bool            `json:"isTaxIncluded"`
+	BaseAmount       StorefrontMoney `json:"baseAmount"`
+	DiscountedAmount StorefrontMoney `json:"discountedAmount"`
+	Amount           StorefrontMoney `json:"amount"`
+	LineItems        struct {
+		PhysicalItems []StorefrontCartItem `json:"physicalItems"`
+		DigitalItems  []StorefrontCartItem `json:"digitalItems"`
+	} `json:"lineItems"`
+}
+
+// Cart returns the details of a cart, ErrNotFound if there is none with the ID
+func (c *GraphQLClient) Cart(cartID string) (*StorefrontCart, error) {
+	query := `query ($cartId: String!) {
+  site {
+    cart(entityId: $cartId) {
+      entityId currencyCode isTaxIncluded
+      baseAmount { value currencyCode }
+      discountedAmount { value currencyCode }
+      amount { value currencyCode }
+      lineItems {
+        physicalItems { entityId productEntityId variantEntityId sku name quantity extendedSalePrice { value currencyCode } }
+        digitalItems { entityId productEntityId variantEntityId sku name quantity extendedSalePrice { value currencyCode } }
+      }
+    }
+  }
+}`
+	var ret struct {
+		Site struct {
+			Cart *StorefrontCart `json:"cart"`
+		} `json:"site"`
+	}
+	err := c.Do(query, map[string]interface{}{"cartId": cartID}, &ret)
+	if err != nil {
+		return nil, err
+	}
+	if ret.Site.Cart == nil {
+		return nil, fmt.Errorf("cart %s: %w", cartID, ErrNotFound)
+	}
+	return ret.Site.Cart, nil
+}
@@ -0,0 +1,56 @@
+package bigcommerce
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGraphQLClientBypassesAdminLimits(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/graphql" || r.Header.Get("Authorization") != "Bearer storefront-token" {
+			t.Errorf("got request %s %s with authorization %q", r.Method, r.URL.Path, r.Header.Get("Authorization"))
+		}
+		w.Header().Set("X-Rate-Limit-Requests-Left", "0")
+		fmt.Fprint(w, `{"data": {"site": {"product": {"entityId": 1, "sku": "SKU-1"}}}}`)
+	}))
+	defer srv.Close()
+
+	var middlewareCalls int
+	bc := newTestClient(srv, WithMiddleware(func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			middlewareCalls++
+			return next.RoundTrip(req)
+		})
+	}))
+	bc.DryRun = true
+	bc.ThrottleBelow = 10
+	bc.Budget = NewTokenBucket(1, time.Hour)
+	bc.Budget.Wait(1) // no admin requests left
+
+	sf := NewGraphQLClient(bc, 1)
+	sf.URL = srv.URL + "/graphql"
+	sf.Tokens.current = &StorefrontToken{Token: "storefront-token", ExpiresAt: time.Now().Add(defaultStorefrontTokenTTL)}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := sf.ProductBySKU("SKU-1")
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("storefront request waited for the admin budget")
+	}
+	if middlewareCalls != 1 {
+		t.Errorf("got %d middleware calls, want 1", middlewareCalls)
+	}
+	if status := bc.RateLimitStatus(); !status.UpdatedAt.IsZero() {
+		t.Errorf("got admin rate limit status %+v from a storefront response", status)
+	}
+}
@@ -0,0 +1,199 @@
+package bigcommerce
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// maxStorefrontTokenOrigins is the most CORS origins BigCommerce accepts for a storefront token
+const maxStorefrontTokenOrigins = 2
+
+// StorefrontToken is a token for the GraphQL Storefront API, for use in browsers of the allowed origins
+type StorefrontToken struct {
+	Token              string
+	ChannelID          int64
+	ExpiresAt          time.Time
+	AllowedCorsOrigins []string
+	// CustomerImpersonation is set for tokens from CreateCustomerImpersonationToken
+	CustomerImpersonation bool
+}
+
+// ValidFor returns true if the token is still valid after d, so a frontend gets a token with time to use it
+func (t *StorefrontToken) ValidFor(d time.Duration) bool {
+	return t != nil && t.Token != "" && time.Now().Add(d).Before(t.ExpiresAt)
+}
+
+// CreateStorefrontToken mints a GraphQL Storefront API token for a channel, valid until expiresAt.
+// allowedOrigins are the origins browsers may use the token from, like "https://shop.example.com", at most 2
+func (bc *Client) CreateStorefrontToken(channelID int64, expiresAt time.Time, allowedOrigins ...string) (*StorefrontToken, error) {
+	if channelID <= 0 {
+		return nil, fmt.Errorf("storefront token channel ID is required")
+	}
+	if !expiresAt.After(time.Now()) {
+		return nil, fmt.Errorf("storefront token expiry %s is in the past", expiresAt.Format(time.RFC3339))
+	}
+	err := validateCorsOrigins(allowedOrigins)
+	if err != nil {
+		return nil, err
+	}
+	payload := struct {
+		ChannelID          int64    `json:"channel_id"`
+		ExpiresAt          int64    `json:"expires_at"`
+		AllowedCorsOrigins []string `json:"allowed_cors_origins,omitempty"`
+	}{channelID, expiresAt.Unix(), allowedOrigins}
+	var tokenResponse struct {
+		Data struct {
+			Token string `json:"token"`
+		} `json:"data"`
+	}
+	err = bc.sendJSON(http.MethodPost, "/v3/storefront/api-token", payload, &tokenResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &StorefrontToken{
+		Token:              tokenResponse.Data.Token,
+		ChannelID:          channelID,
+		ExpiresAt:          time.Unix(expiresAt.Unix(), 0),
+		AllowedCorsOrigins: allowedOrigins,
+	}, nil
+}
+
+// CreateCustomerImpersonationToken mints a customer impersonation token for a channel, valid until expiresAt.
+// Unlike storefront tokens it may only be used server side, and can act as any customer by sending
+// their ID in the X-Bc-Customer-Id header, see GraphQLClient.CustomerID. Keep it secret
+func (bc *Client) CreateCustomerImpersonationToken(channelID int64, expiresAt time.Time) (*StorefrontToken, error) {
+	if channelID <= 0 {
+		return nil, fmt.Errorf("customer impersonation token channel ID is required")
+	}
+	if !expiresAt.After(time.Now()) {
+		return nil, fmt.Errorf("customer impersonation token expiry %s is in the past", expiresAt.Format(time.RFC3339))
+	}
+	payload := struct {
+		ChannelID int64 `json:"channel_id"`
+		ExpiresAt int64 `json:"expires_at"`
+	}{channelID, expiresAt.Unix()}
+	var tokenResponse struct {
+		Data struct {
+			Token string `json:"token"`
+		} `json:"data"`
+	}
+	err := bc.sendJSON(http.MethodPost, "/v3/storefront/api-token-customer-impersonation", payload, &tokenResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &StorefrontToken{
+		Token:                 tokenResponse.Data.Token,
+		ChannelID:             channelID,
+		ExpiresAt:             time.Unix(expiresAt.Unix(), 0),
+		CustomerImpersonation: true,
+	}, nil
+}
+
+// RevokeStorefrontToken revokes a storefront token, e.g. when it leaked
+func (bc *Client) RevokeStorefrontToken(token string) error {
+	req := bc.getAPIRequest(http.MethodDelete, "/v3/storefront/api-token", nil)
+	req.Header.Set("Sf-Api-Token", token)
+	res, err := bc.do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil && err != ErrNoContent {
+		return fmt.Errorf("error revoking storefront token: %w %s", err, string(body))
+	}
+	return nil
+}
+
+// validateCorsOrigins checks origins are bare scheme and host, as browsers send them in the Origin header
+func validateCorsOrigins(origins []string) error {
+	if len(origins) > maxStorefrontTokenOrigins {
+		return fmt.Errorf("storefront tokens allow at most %d CORS origins, got %d", maxStorefrontTokenOrigins, len(origins))
+	}
+	for _, o := range origins {
+		u, err := url.Parse(o)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" ||
+			(u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+			return fmt.Errorf("invalid CORS origin %q, expected scheme and host like https://shop.example.com", o)
+		}
+	}
+	return nil
+}
+
+// StorefrontTokenProvider hands out storefront tokens that stay valid for a while, minting a new one
+// when the current one gets close to its expiry. It is safe for concurrent use:
+//
+//	tokens := bc.StorefrontTokenProvider(1, 24*time.Hour, "https://shop.example.com")
+//	http.HandleFunc("/storefront-token", func(w http.ResponseWriter, r *http.Request) {
+//		token, err := tokens.Token()
+//		...
+//	})
+type StorefrontTokenProvider struct {
+	client  *Client
+	channel int64
+	origins []string
+	// TTL is the lifetime of minted tokens
+	TTL time.Duration
+	// MinValidity is how long a handed out token is valid at least, TTL/4 when 0
+	MinValidity time.Duration
+	// CustomerImpersonation, when set, mints customer impersonation tokens instead, for server side use only
+	CustomerImpersonation bool
+
+	mu      sync.Mutex
+	current *StorefrontToken
+}
+
+// StorefrontTokenProvider returns a provider of tokens for a channel that live for ttl, usable from the origins
+func (bc *Client) StorefrontTokenProvider(channelID int64, ttl time.Duration, allowedOrigins ...string) *StorefrontTokenProvider {
+	return &StorefrontTokenProvider{
+		client:  bc,
+		channel: channelID,
+		origins: allowedOrigins,
+		TTL:     ttl,
+	}
+}
+
+// Token returns a token valid for at least MinValidity
+func (p *StorefrontTokenProvider) Token() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	minValidity := p.MinValidity
+	if minValidity == 0 {
+		minValidity = p.TTL / 4
+	}
+	if p.current.ValidFor(minValidity) {
+		return p.current.Token, nil
+	}
+	var token *StorefrontToken
+	var err error
+	if p.CustomerImpersonation {
+		token, err = p.client.CreateCustomerImpersonationToken(p.channel, time.Now().Add(p.TTL))
+	} else {
+		token, err = p.client.CreateStorefrontToken(p.channel, time.Now().Add(p.TTL), p.origins...)
+	}
+	if err != nil {
+		return "", err
+	}
+	p.current = token
+	return token.Token, nil
+}
+
+// ExpiresAt returns the expiry of the current token, zero before the first Token call
+func (p *StorefrontTokenProvider) ExpiresAt() time.Time {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.current == nil {
+		return time.Time{}
+	}
+	return p.current.ExpiresAt
+}
+
+// reset drops the current token, so the next Token call mints a new one
+func (p *StorefrontTokenProvider) reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.current = nil
+}
@@ -1,7 +1,6 @@
 package bigcommerce
 
 import (
-	"encoding/json"
 	"net/http"
 	"time"
 )
@@ -353,7 +352,7 @@ func (bc *Client) GetActiveThemeConfig() (*ThemeConfig, error) {
 // GetThemes returns a list of all store themes
 func (bc *Client) GetThemes() ([]Theme, error) {
 	req := bc.getAPIRequest(http.MethodGet, "/v3/themes", nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -367,7 +366,7 @@ func (bc *Client) GetThemes() ([]Theme, error) {
 	var ret struct {
 		Data []Theme `json:"data"`
 	}
-	err = json.Unmarshal(body, &ret)
+	err = bc.unmarshal(body, &ret)
 	return ret.Data, err
 
 }
@@ -375,7 +374,7 @@ func (bc *Client) GetThemes() ([]Theme, error) {
 // GetThemeConfig returns the configuration for a specific theme by theme UUID
 func (bc *Client) GetThemeConfig(uuid string) (*ThemeConfig, error) {
 	req := bc.getAPIRequest(http.MethodGet, "/v3/themes/"+uuid+"/configurations", nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -389,6 +388,6 @@ func (bc *Client) GetThemeConfig(uuid string) (*ThemeConfig, error) {
 	var ret struct {
 		Data []ThemeConfig `json:"data"`
 	}
-	err = json.Unmarshal(body, &ret)
+	err = bc.unmarshal(body, &ret)
 	return &ret.Data[0], err
 }
@@ -0,0 +1,126 @@
+package bigcommerce
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Tracer starts a span for every API call, set it with WithTracer. It is shaped so an OpenTelemetry tracer
+// takes a few lines to adapt, without this package depending on OpenTelemetry:
+//
+//	type otelTracer struct{ t trace.Tracer }
+//
+//	func (o otelTracer) Start(ctx context.Context, name string) (context.Context, bigcommerce.Span) {
+//		ctx, span := o.t.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+//		return ctx, otelSpan{span}
+//	}
+//
+// The span covers the call with its retries, the request is sent with the context Start returned
+type Tracer interface {
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a span started by a Tracer
+type Span interface {
+	// SetAttribute sets a string, int or int64 attribute
+	SetAttribute(key string, value interface{})
+	// RecordError marks the span as failed
+	RecordError(err error)
+	End()
+}
+
+// Span attributes set on every API call
+const (
+	SpanAttributeMethod     = "http.method"
+	SpanAttributeStatusCode = "http.status_code"
+	SpanAttributeResource   = "bigcommerce.resource"
+	SpanAttributeOrderID    = "bigcommerce.order_id"
+	SpanAttributeRetries    = "bigcommerce.retries"
+)
+
+// WithTracer starts a span for every API call with t
+func WithTracer(t Tracer) Option {
+	return func(bc *Client) {
+		bc.Tracer = t
+	}
+}
+
+// traceRequest starts the span of a call, it returns the request bound to the span's context
+// and a function ending the span with the outcome of the call
+func (bc *Client) traceRequest(req *http.Request) (*http.Request, func(res *http.Response, retries int, err error)) {
+	if bc.Tracer == nil {
+		return req, func(*http.Response, int, error) {}
+	}
+	resource := resourceName(req.URL.Path)
+	ctx, span := bc.Tracer.Start(req.Context(), "BigCommerce "+req.Method+" "+resource)
+	span.SetAttribute(SpanAttributeMethod, req.Method)
+	span.SetAttribute(SpanAttributeResource, resource)
+	if orderID := pathOrderID(req.URL.Path); orderID != 0 {
+		span.SetAttribute(SpanAttributeOrderID, orderID)
+	}
+	return req.WithContext(ctx), func(res *http.Response, retries int, err error) {
+		span.SetAttribute(SpanAttributeRetries, retries)
+		if res != nil {
+			span.SetAttribute(SpanAttributeStatusCode, res.StatusCode)
+			if err == nil && res.StatusCode >= 400 {
+				err = &APIError{StatusCode: res.StatusCode, Status: res.Status, Method: req.Method, URL: req.URL.String()}
+			}
+		}
+		if err != nil {
+			span.RecordError(err)
+		}
+		span.End()
+	}
+}
+
+// codeCollections are the collections addressed by a code instead of an ID, the code is the rest of the path
+// as decoded codes may contain slashes
+var codeCollections = map[string]bool{"coupons": true, "gift-certificates": true}
+
+// resourceName returns the API path without the store prefix and with IDs and codes replaced,
+// e.g. "/v2/orders/{id}/shipments" for "/stores/abc/v2/orders/100/shipments"
+// and "/v3/checkouts/{id}/coupons/{code}" for "/stores/abc/v3/checkouts/<uuid>/coupons/SUMMER"
+func resourceName(path string) string {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(segments) > 2 && segments[0] == "stores" {
+		segments = segments[2:]
+	}
+	for i, s := range segments {
+		if isPathID(s) {
+			segments[i] = "{id}"
+			continue
+		}
+		if i > 0 && codeCollections[segments[i-1]] && s != "" {
+			segments = append(segments[:i], "{code}")
+			break
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+// isPathID returns true for numeric IDs and UUIDs, like cart and checkout IDs
+func isPathID(s string) bool {
+	if s == "" {
+		return false
+	}
+	if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return true
+	}
+	return len(s) == 36 && strings.Count(s, "-") == 4
+}
+
+// pathOrderID returns the order ID of an order path, 0 for other paths
+func pathOrderID(path string) int64 {
+	segments := strings.Split(path, "/")
+	for i := 0; i+1 < len(segments); i++ {
+		if segments[i] == "orders" {
+			id, err := strconv.ParseInt(segments[i+1], 10, 64)
+			if err == nil {
+				return id
+			}
+		}
+	}
+	return 0
+}
@@ -0,0 +1,27 @@
+package bigcommerce
+
+import (
+	"testing"
+)
+
+func TestResourceName(t *testing.T) {
+	checkout := "/stores/abc/v3/checkouts/6c9d2f1e-3b5a-4c7d-8e9f-0a1b2c3d4e5f"
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/stores/abc/v2/orders/100/shipments", "/v2/orders/{id}/shipments"},
+		{"/stores/abc/v2/orders/100/coupons", "/v2/orders/{id}/coupons"},
+		{"/stores/abc/v3/coupons/7", "/v3/coupons/{id}"},
+		{checkout + "/coupons", "/v3/checkouts/{id}/coupons"},
+		{checkout + "/coupons/SUMMER10", "/v3/checkouts/{id}/coupons/{code}"},
+		{checkout + "/coupons/A/B", "/v3/checkouts/{id}/coupons/{code}"},
+		{checkout + "/gift-certificates/GC-XYZ", "/v3/checkouts/{id}/gift-certificates/{code}"},
+		{"/stores/abc/v3/catalog/products", "/v3/catalog/products"},
+	}
+	for _, tt := range tests {
+		if got := resourceName(tt.path); got != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.path, got, tt.want)
+		}
+	}
+}
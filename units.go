@@ -0,0 +1,184 @@
+package bigcommerce
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Weight and dimension units understood by the unit converters
+const (
+	UnitOunces      = "oz"
+	UnitPounds      = "lb"
+	UnitGrams       = "g"
+	UnitKilograms   = "kg"
+	UnitTonnes      = "t"
+	UnitInches      = "in"
+	UnitMillimeters = "mm"
+	UnitCentimeters = "cm"
+	UnitMeters      = "m"
+)
+
+// UnitSystem is a pair of weight and dimension units, e.g. {"kg", "cm"}
+type UnitSystem struct {
+	Weight    string `json:"weight"`
+	Dimension string `json:"dimension"`
+}
+
+// grams per unit
+var weightUnits = map[string]float64{
+	UnitOunces:    28.349523125,
+	UnitPounds:    453.59237,
+	UnitGrams:     1,
+	UnitKilograms: 1000,
+	UnitTonnes:    1000000,
+}
+
+// centimeters per unit
+var dimensionUnits = map[string]float64{
+	UnitInches:      2.54,
+	UnitMillimeters: 0.1,
+	UnitCentimeters: 1,
+	UnitMeters:      100,
+}
+
+// unit names as BigCommerce returns them in store info
+var unitAliases = map[string]string{
+	"ounces":      UnitOunces,
+	"lbs":         UnitPounds,
+	"pounds":      UnitPounds,
+	"grams":       UnitGrams,
+	"kgs":         UnitKilograms,
+	"kilograms":   UnitKilograms,
+	"tonnes":      UnitTonnes,
+	"inches":      UnitInches,
+	"millimeters": UnitMillimeters,
+	"centimeters": UnitCentimeters,
+	"meters":      UnitMeters,
+}
+
+// NormalizeUnit returns the short unit name for a BigCommerce unit name, e.g. "KGS" -> "kg"
+func NormalizeUnit(unit string) string {
+	u := strings.ToLower(strings.TrimSpace(unit))
+	if short, ok := unitAliases[u]; ok {
+		return short
+	}
+	return u
+}
+
+// ConvertWeight converts value between weight units
+func ConvertWeight(value float64, from, to string) (float64, error) {
+	f, ok := weightUnits[NormalizeUnit(from)]
+	if !ok {
+		return 0, fmt.Errorf("unknown weight unit %s", from)
+	}
+	t, ok := weightUnits[NormalizeUnit(to)]
+	if !ok {
+		return 0, fmt.Errorf("unknown weight unit %s", to)
+	}
+	return value * f / t, nil
+}
+
+// ConvertDimension converts value between length units
+func ConvertDimension(value float64, from, to string) (float64, error) {
+	f, ok := dimensionUnits[NormalizeUnit(from)]
+	if !ok {
+		return 0, fmt.Errorf("unknown dimension unit %s", from)
+	}
+	t, ok := dimensionUnits[NormalizeUnit(to)]
+	if !ok {
+		return 0, fmt.Errorf("unknown dimension unit %s", to)
+	}
+	return value * f / t, nil
+}
+
+// GetStoreUnits returns the weight and dimension units the store uses, cached after the first call
+func (bc *Client) GetStoreUnits() (UnitSystem, error) {
+	s := bc.sharedState()
+	s.unitsMu.Lock()
+	defer s.unitsMu.Unlock()
+	if s.storeUnits != nil {
+		return *s.storeUnits, nil
+	}
+	info, err := bc.GetStoreInfo()
+	if err != nil {
+		return UnitSystem{}, err
+	}
+	units := UnitSystem{
+		Weight:    NormalizeUnit(info.WeightUnits),
+		Dimension: NormalizeUnit(info.DimensionUnits),
+	}
+	s.storeUnits = &units
+	return units, nil
+}
+
+// ConvertUnits converts product and variant weights and dimensions between unit systems
+func (p *Product) ConvertUnits(from, to UnitSystem) error {
+	var err error
+	if p.Weight, err = ConvertWeight(p.Weight, from.Weight, to.Weight); err != nil {
+		return err
+	}
+	for _, d := range []*float64{&p.Width, &p.Height, &p.Depth} {
+		if *d, err = ConvertDimension(*d, from.Dimension, to.Dimension); err != nil {
+			return err
+		}
+	}
+	for i := range p.Variants {
+		if err = p.Variants[i].ConvertUnits(from, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ConvertUnits converts variant weights and dimensions between unit systems
+func (v *Variant) ConvertUnits(from, to UnitSystem) error {
+	var err error
+	if v.Weight, err = ConvertWeight(v.Weight, from.Weight, to.Weight); err != nil {
+		return err
+	}
+	if v.CalculatedWeight, err = ConvertWeight(v.CalculatedWeight, from.Weight, to.Weight); err != nil {
+		return err
+	}
+	for _, d := range []*float64{&v.Width, &v.Height, &v.Depth} {
+		if *d, err = ConvertDimension(*d, from.Dimension, to.Dimension); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// normalizeProducts converts products to bc.TargetUnits, if set
+func (bc *Client) normalizeProducts(products []Product) error {
+	if bc.TargetUnits == nil || len(products) == 0 {
+		return nil
+	}
+	from, err := bc.GetStoreUnits()
+	if err != nil {
+		return err
+	}
+	for i := range products {
+		err = products[i].ConvertUnits(from, *bc.TargetUnits)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// normalizeVariants converts variants to bc.TargetUnits, if set
+func (bc *Client) normalizeVariants(variants []Variant) error {
+	if bc.TargetUnits == nil || len(variants) == 0 {
+		return nil
+	}
+	from, err := bc.GetStoreUnits()
+	if err != nil {
+		return err
+	}
+	for i := range variants {
+		err = variants[i].ConvertUnits(from, *bc.TargetUnits)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package bigcommerce
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestConvertUnits(t *testing.T) {
+	tests := []struct {
+		value    float64
+		from, to string
+		want     float64
+	}{
+		{16, "Ounces", UnitPounds, 1},
+		{1, UnitKilograms, UnitGrams, 1000},
+		{1, "LBS", UnitKilograms, 0.45359237},
+		{1, "Inches", UnitCentimeters, 2.54},
+		{100, UnitCentimeters, UnitMeters, 1},
+	}
+	for _, tt := range tests {
+		convert := ConvertWeight
+		if _, ok := dimensionUnits[NormalizeUnit(tt.from)]; ok {
+			convert = ConvertDimension
+		}
+		got, err := convert(tt.value, tt.from, tt.to)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("%g %s in %s: got %g, want %g", tt.value, tt.from, tt.to, got, tt.want)
+		}
+	}
+	if _, err := ConvertWeight(1, "stone", UnitKilograms); err == nil {
+		t.Error("got no error for an unknown unit")
+	}
+}
+
+// unitsServer serves a store in pounds and inches with product 1 and its variant 2
+func unitsServer(storeRequests *int32) *httptest.Server {
+	variant := `{"id": 2, "product_id": 1, "weight": 2, "width": 10, "height": 1, "depth": 1}`
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/stores/store/v2/store":
+			atomic.AddInt32(storeRequests, 1)
+			time.Sleep(5 * time.Millisecond)
+			fmt.Fprint(w, `{"id": "store", "weight_units": "LBS", "dimension_units": "Inches"}`)
+		case "/stores/store/v3/catalog/products/1/variants/2":
+			fmt.Fprintf(w, `{"data": %s}`, variant)
+		case "/stores/store/v3/catalog/products/1/variants":
+			fmt.Fprintf(w, `{"data": [%s], "meta": {"pagination": {"total": 1, "count": 1, "per_page": 50, "current_page": 1, "total_pages": 1}}}`, variant)
+		case "/stores/store/v3/catalog/products":
+			fmt.Fprintf(w, `{"data": [{"id": 1, "weight": 1, "width": 1, "height": 1, "depth": 1, "variants": [%s]}],
+				"meta": {"pagination": {"total": 1, "count": 1, "per_page": 250, "current_page": 1, "total_pages": 1}}}`, variant)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestVariantGettersNormalizeUnits(t *testing.T) {
+	var storeRequests int32
+	srv := unitsServer(&storeRequests)
+	defer srv.Close()
+	bc := newTestClient(srv)
+	bc.TargetUnits = &UnitSystem{Weight: UnitKilograms, Dimension: UnitCentimeters}
+
+	check := func(name string, v Variant) {
+		t.Helper()
+		if math.Abs(v.Weight-0.90718474) > 1e-9 || math.Abs(v.Width-25.4) > 1e-9 {
+			t.Errorf("%s: got weight %g and width %g, want 0.907 kg and 25.4 cm", name, v.Weight, v.Width)
+		}
+	}
+	v, err := bc.GetVariant(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("GetVariant", *v)
+	vs, err := bc.GetProductVariants(1)
+	if err != nil || len(vs) != 1 {
+		t.Fatalf("got %d variants, error %v", len(vs), err)
+	}
+	check("GetProductVariants", vs[0])
+	vs, err = bc.ListVariantsModifiedSince(time.Now().Add(-time.Hour))
+	if err != nil || len(vs) != 1 {
+		t.Fatalf("got %d variants, error %v", len(vs), err)
+	}
+	check("ListVariantsModifiedSince", vs[0])
+}
+
+func TestGetStoreUnitsConcurrent(t *testing.T) {
+	var storeRequests int32
+	srv := unitsServer(&storeRequests)
+	defer srv.Close()
+	bc := newTestClient(srv)
+	bc.TargetUnits = &UnitSystem{Weight: UnitKilograms, Dimension: UnitCentimeters}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := bc.GetVariant(1, 2); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if storeRequests != 1 {
+		t.Errorf("got %d store info requests, want the units fetched once", storeRequests)
+	}
+}
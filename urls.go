@@ -0,0 +1,124 @@
+package bigcommerce
+
+import (
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// urlBuilder builds API paths with query strings, builders are pooled
+// to keep allocations down in sync loops making many requests
+type urlBuilder struct {
+	buf      []byte
+	hasQuery bool
+	// keys are the query keys added with Param and Int, Args and Values skip them
+	keys []string
+}
+
+var urlBuilderPool = sync.Pool{
+	New: func() interface{} {
+		return &urlBuilder{buf: make([]byte, 0, 128)}
+	},
+}
+
+// newURL returns a pooled builder starting with path, call String to get the URL and release the builder
+func newURL(path string) *urlBuilder {
+	u := urlBuilderPool.Get().(*urlBuilder)
+	u.buf = append(u.buf[:0], path...)
+	u.hasQuery = false
+	u.keys = u.keys[:0]
+	for i := 0; i < len(path); i++ {
+		if path[i] == '?' {
+			u.hasQuery = true
+			break
+		}
+	}
+	return u
+}
+
+// Segment appends "/" + s to the path
+func (u *urlBuilder) Segment(s string) *urlBuilder {
+	u.buf = append(u.buf, '/')
+	u.buf = append(u.buf, s...)
+	return u
+}
+
+// ID appends "/" + id to the path
+func (u *urlBuilder) ID(id int64) *urlBuilder {
+	u.buf = append(u.buf, '/')
+	u.buf = strconv.AppendInt(u.buf, id, 10)
+	return u
+}
+
+// Param adds a query parameter as is, for keys and values known not to need escaping
+func (u *urlBuilder) Param(key, value string) *urlBuilder {
+	u.key(key)
+	u.buf = append(u.buf, key...)
+	u.buf = append(u.buf, '=')
+	u.buf = append(u.buf, value...)
+	return u
+}
+
+// Int adds an integer query parameter
+func (u *urlBuilder) Int(key string, value int64) *urlBuilder {
+	u.key(key)
+	u.buf = append(u.buf, key...)
+	u.buf = append(u.buf, '=')
+	u.buf = strconv.AppendInt(u.buf, value, 10)
+	return u
+}
+
+// key starts a query parameter and remembers its key
+func (u *urlBuilder) key(key string) {
+	if u.hasQuery {
+		u.buf = append(u.buf, '&')
+	} else {
+		u.buf = append(u.buf, '?')
+		u.hasQuery = true
+	}
+	u.keys = append(u.keys, key)
+}
+
+// has returns true if a parameter with key was added with Param or Int
+func (u *urlBuilder) has(key string) bool {
+	for _, k := range u.keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// Args adds query parameters from a filters map, escaping keys and values like url.Values.
+// Keys added before with Param or Int, like the page of paging loops, are skipped
+func (u *urlBuilder) Args(args map[string]string) *urlBuilder {
+	for k, v := range args {
+		if k = url.QueryEscape(k); !u.has(k) {
+			u.Param(k, url.QueryEscape(v))
+		}
+	}
+	return u
+}
+
+// Values adds query parameters, escaping keys and values. Keys added before with Param or Int are skipped
+func (u *urlBuilder) Values(q url.Values) *urlBuilder {
+	for k, vs := range q {
+		k = url.QueryEscape(k)
+		if u.has(k) {
+			continue
+		}
+		for _, v := range vs {
+			u.Param(k, url.QueryEscape(v))
+		}
+	}
+	return u
+}
+
+// String returns the built URL and puts the builder back in the pool, the builder must not be used after
+func (u *urlBuilder) String() string {
+	s := string(u.buf)
+	if cap(u.buf) <= 4096 {
+		urlBuilderPool.Put(u)
+	}
+	return s
+}
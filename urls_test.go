@@ -0,0 +1,85 @@
+package bigcommerce
+
+import (
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestURLBuilder(t *testing.T) {
+	date := time.Date(2024, 5, 1, 12, 0, 0, 0, time.FixedZone("", 2*3600)).Format(time.RFC1123Z)
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"path", newURL("/v2/orders").ID(100).Segment("shipments").String(), "/v2/orders/100/shipments"},
+		{"params", newURL("/v3/catalog/products").Int("page", 2).Param("id:in", "1,2").String(), "/v3/catalog/products?page=2&id:in=1,2"},
+		{"existing query", newURL("/v3/catalog/products?include=variants").Int("page", 1).String(), "/v3/catalog/products?include=variants&page=1"},
+		{"args escaped", newURL("/v2/orders").Args(map[string]string{"min_date_modified": date}).String(), "/v2/orders?min_date_modified=Wed%2C+01+May+2024+12%3A00%3A00+%2B0200"},
+		{"arg keys escaped", newURL("/v3/catalog/products").Args(map[string]string{"sku:in": "A&B"}).String(), "/v3/catalog/products?sku%3Ain=A%26B"},
+		{"values", newURL("/v3/metafields").Values(url.Values{"namespace": {"my app"}}).String(), "/v3/metafields?namespace=my+app"},
+		{"args don't repeat page", newURL("/v3/catalog/products").Int("page", 2).Args(map[string]string{"page": "5"}).String(), "/v3/catalog/products?page=2"},
+		{"values don't repeat limit", newURL("/v3/metafields").Int("limit", 50).Values(url.Values{"limit": {"10"}, "id": {"1", "2"}}).String(), "/v3/metafields?limit=50&id=1&id=2"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, tt.got, tt.want)
+		}
+	}
+
+	// escaped args read back as they were passed
+	args := map[string]string{"date_modified:min": "2024-05-01T12:00:00+02:00", "keyword": "100% cotton", "name": "a=b&c"}
+	u, err := url.Parse(newURL("/v3/catalog/products").Args(args).String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range args {
+		if got := u.Query().Get(k); got != v {
+			t.Errorf("got %s=%q, want %q", k, got, v)
+		}
+	}
+}
+
+var benchmarkURL string
+
+// BenchmarkURL compares building a list URL with the pooled builder, with url.Values and with concatenation
+func BenchmarkURL(b *testing.B) {
+	args := map[string]string{"include": "variants,images", "date_modified:min": "2024-05-01T12:00:00Z"}
+	b.Run("Builder", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			benchmarkURL = newURL("/v3/catalog/products").ID(int64(i)).Segment("variants").Int("page", 2).Args(args).String()
+		}
+	})
+	b.Run("URLValues", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			q := url.Values{}
+			q.Set("page", "2")
+			for k, v := range args {
+				q.Set(k, v)
+			}
+			benchmarkURL = "/v3/catalog/products/" + strconv.FormatInt(int64(i), 10) + "/variants?" + q.Encode()
+		}
+	})
+	b.Run("Concatenation", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			s := "/v3/catalog/products/" + strconv.FormatInt(int64(i), 10) + "/variants?page=2"
+			for k, v := range args {
+				s += "&" + url.QueryEscape(k) + "=" + url.QueryEscape(v)
+			}
+			benchmarkURL = s
+		}
+	})
+}
+
+// BenchmarkURLPath measures building a path without query, the most common case
+func BenchmarkURLPath(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchmarkURL = newURL("/v2/orders").ID(int64(i)).Segment("shipments").String()
+	}
+}
@@ -0,0 +1,133 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// variantBatchSize is the number of variants sent per product update by CreateVariantCombinations
+const variantBatchSize = 50
+
+// VariantOption is an option and its values to combine, e.g. {"Size", ["S", "M", "L"]}
+type VariantOption struct {
+	Name   string
+	Values []string
+}
+
+// VariantOptionValue is the option value of a variant by name, BigCommerce creates missing options and values
+type VariantOptionValue struct {
+	OptionDisplayName string `json:"option_display_name"`
+	Label             string `json:"label"`
+}
+
+// VariantCombination is a variant to create
+type VariantCombination struct {
+	Sku            string               `json:"sku"`
+	OptionValues   []VariantOptionValue `json:"option_values"`
+	Price          float64              `json:"price,omitempty"`
+	InventoryLevel int                  `json:"inventory_level,omitempty"`
+}
+
+var skuUnsafeRe = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// GenerateVariantCombinations returns all combinations of the option values (size × color ...),
+// in option order with the last option changing fastest.
+// skuPattern builds the SKU of each combination, "{sku}" is replaced with baseSku, "{n}" with the
+// 1-based index of the combination and "{OptionName}" with the option value, upper cased with spaces
+// and symbols replaced by dashes, e.g. "{sku}-{Color}-{Size}" gives "TEE-DARK-BLUE-XL".
+// When skuPattern is empty the option values are appended to baseSku
+func GenerateVariantCombinations(baseSku string, options []VariantOption, skuPattern string) []VariantCombination {
+	if len(options) == 0 {
+		return []VariantCombination{}
+	}
+	if skuPattern == "" {
+		skuPattern = "{sku}"
+		for _, o := range options {
+			skuPattern += "-{" + o.Name + "}"
+		}
+	}
+	combos := [][]VariantOptionValue{{}}
+	for _, o := range options {
+		next := [][]VariantOptionValue{}
+		for _, c := range combos {
+			for _, v := range o.Values {
+				combo := append(append([]VariantOptionValue{}, c...), VariantOptionValue{OptionDisplayName: o.Name, Label: v})
+				next = append(next, combo)
+			}
+		}
+		combos = next
+	}
+	ret := make([]VariantCombination, len(combos))
+	for i, c := range combos {
+		sku := strings.ReplaceAll(skuPattern, "{sku}", baseSku)
+		sku = strings.ReplaceAll(sku, "{n}", strconv.Itoa(i+1))
+		for _, ov := range c {
+			sku = strings.ReplaceAll(sku, "{"+ov.OptionDisplayName+"}", strings.ToUpper(strings.Trim(skuUnsafeRe.ReplaceAllString(ov.Label, "-"), "-")))
+		}
+		ret[i] = VariantCombination{Sku: sku, OptionValues: c}
+	}
+	return ret
+}
+
+// CreateVariantCombinations adds variants to a product in batches, the options they use are created as needed
+func (bc *Client) CreateVariantCombinations(productID int64, combinations []VariantCombination) error {
+	url := newURL("/v3/catalog/products").ID(productID).String()
+	for start := 0; start < len(combinations); start += variantBatchSize {
+		end := start + variantBatchSize
+		if end > len(combinations) {
+			end = len(combinations)
+		}
+		reqJSON, err := bc.marshal(map[string]interface{}{"variants": combinations[start:end]})
+		if err != nil {
+			return err
+		}
+		req := bc.getAPIRequest(http.MethodPut, url, bytes.NewReader(reqJSON))
+		res, err := bc.do(req)
+		if err != nil {
+			return err
+		}
+		body, err := processBody(res)
+		res.Body.Close()
+		if err != nil {
+			return fmt.Errorf("error creating variants %d-%d of product %d: %w %s", start, end-1, productID, err, string(body))
+		}
+	}
+	return nil
+}
+
+// CreateProductVariants generates all combinations of options and creates them on the product,
+// returning the created combinations, see GenerateVariantCombinations for skuPattern
+func (bc *Client) CreateProductVariants(productID int64, baseSku string, options []VariantOption, skuPattern string) ([]VariantCombination, error) {
+	combinations := GenerateVariantCombinations(baseSku, options, skuPattern)
+	return combinations, bc.CreateVariantCombinations(productID, combinations)
+}
+
+// GetProductVariants returns all variants of a product, in bc.TargetUnits if set
+func (bc *Client) GetProductVariants(productID int64) ([]Variant, error) {
+	variants, err := ListPages[Variant](bc, newURL("/v3/catalog/products").ID(productID).Segment("variants").String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return variants, bc.normalizeVariants(variants)
+}
+
+// GetVariant returns a variant of a product, in bc.TargetUnits if set
+func (bc *Client) GetVariant(productID, variantID int64) (*Variant, error) {
+	var variantResponse struct {
+		Data Variant `json:"data"`
+	}
+	err := bc.getJSON(newURL("/v3/catalog/products").ID(productID).Segment("variants").ID(variantID).String(), &variantResponse)
+	if err != nil {
+		return nil, err
+	}
+	variants := []Variant{variantResponse.Data}
+	err = bc.normalizeVariants(variants)
+	if err != nil {
+		return nil, err
+	}
+	return &variants[0], nil
+}
@@ -74,31 +74,7 @@ func GetWebhookPayload(r *http.Request) (*WebhookPayload, []byte, error) {
 }
 
 func (bc *Client) GetWebhooks() ([]Webhook, error) {
-	url := "/v3/hooks?limit=250"
-
-	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	defer res.Body.Close()
-	body, err := processBody(res)
-	if err != nil {
-		return nil, err
-	}
-
-	var webhooksResponse struct {
-		Data []Webhook `json:"data"`
-		Meta struct {
-			Pagination Pagination `json:"pagination"`
-		} `json:"meta"`
-	}
-	err = json.Unmarshal(body, &webhooksResponse)
-	if err != nil {
-		return nil, err
-	}
-	return webhooksResponse.Data, nil
+	return ListPages[Webhook](bc, "/v3/hooks", nil)
 }
 
 // CreateWebhook creates a new webhook or activates it if it already exists but inactive
@@ -115,14 +91,14 @@ func (bc *Client) CreateWebhook(scope, destination string, headers map[string]st
 				return webhook.ID, nil
 			}
 			req := bc.getAPIRequest(http.MethodPut, url+"/"+strconv.FormatInt(webhook.ID, 10), strings.NewReader(`{"is_active": true}`))
-			res, err := bc.HTTPClient.Do(req)
+			res, err := bc.do(req)
 			if err != nil {
 				return 0, err
 			}
 			defer res.Body.Close()
 			body, err := processBody(res)
 			if err != nil {
-				return 0, fmt.Errorf("error processing response body: %v %s", err, string(body))
+				return 0, fmt.Errorf("error processing response body: %w %s", err, string(body))
 			}
 			return webhook.ID, nil
 		}
@@ -139,10 +115,10 @@ func (bc *Client) CreateWebhook(scope, destination string, headers map[string]st
 	if headers != nil {
 		payload.Headers = headers
 	}
-	reqJSON, _ := json.Marshal(payload)
+	reqJSON, _ := bc.marshal(payload)
 
 	req := bc.getAPIRequest(http.MethodPost, url, bytes.NewReader(reqJSON))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return 0, err
 	}
@@ -150,10 +126,10 @@ func (bc *Client) CreateWebhook(scope, destination string, headers map[string]st
 	defer res.Body.Close()
 	body, err := processBody(res)
 	if err != nil {
-		return 0, fmt.Errorf("error processing response body: %v %s (%s)", err, string(body), string(reqJSON))
+		return 0, fmt.Errorf("error processing response body: %w %s (%s)", err, string(body), string(reqJSON))
 	}
 	var respWebhook Webhook
-	err = json.Unmarshal(body, &respWebhook)
+	err = bc.unmarshal(body, &respWebhook)
 	if err != nil {
 		return 0, err
 	}
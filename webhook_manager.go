@@ -0,0 +1,164 @@
+package bigcommerce
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Webhook delivery verification methods, combine them to accept either during a migration
+const (
+	// WebhookVerifyLegacy checks a custom header registered with the webhook, e.g. holding the client secret
+	WebhookVerifyLegacy = 1 << iota
+	// WebhookVerifySignature checks the webhook-signature HMAC header
+	WebhookVerifySignature
+)
+
+// Webhook signature headers
+const (
+	WebhookIDHeader        = "webhook-id"
+	WebhookTimestampHeader = "webhook-timestamp"
+	WebhookSignatureHeader = "webhook-signature"
+)
+
+// ErrWebhookSignature is returned when a webhook delivery fails verification
+var ErrWebhookSignature = errors.New("invalid webhook signature")
+
+// DefaultWebhookTolerance is how old a signed delivery may be
+const DefaultWebhookTolerance = 5 * time.Minute
+
+// WebhookManager registers webhooks for one destination and verifies their deliveries
+type WebhookManager struct {
+	Client      *Client
+	Destination string
+	// Secret is the key deliveries are signed with, usually the app client secret, "whsec_" prefixed keys are base64 decoded
+	Secret string
+	// LegacyHeader and LegacyValue are sent with every delivery, they are set on the webhooks by Register
+	LegacyHeader string
+	LegacyValue  string
+	// Verify is a combination of WebhookVerify flags, a delivery is accepted if any enabled check passes
+	Verify int
+	// Tolerance is the maximum age of signed deliveries, DefaultWebhookTolerance when 0
+	Tolerance time.Duration
+}
+
+// NewWebhookManager returns a manager verifying signed deliveries with secret
+func NewWebhookManager(client *Client, destination, secret string) *WebhookManager {
+	return &WebhookManager{
+		Client:      client,
+		Destination: destination,
+		Secret:      secret,
+		Verify:      WebhookVerifySignature,
+	}
+}
+
+// Register creates (or reactivates) webhooks for scopes, returning their IDs
+func (m *WebhookManager) Register(scopes ...string) ([]int64, error) {
+	var headers map[string]string
+	if m.LegacyHeader != "" {
+		headers = map[string]string{m.LegacyHeader: m.LegacyValue}
+	}
+	ids := []int64{}
+	for _, scope := range scopes {
+		id, err := m.Client.CreateWebhook(scope, m.Destination, headers)
+		if err != nil {
+			return ids, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// VerifyRequest reads and verifies a webhook delivery, returning the payload and the raw body
+func (m *WebhookManager) VerifyRequest(r *http.Request) (*WebhookPayload, []byte, error) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	r.Body.Close()
+	err = m.VerifyDelivery(r.Header, body)
+	if err != nil {
+		return nil, body, err
+	}
+	var payload WebhookPayload
+	err = json.Unmarshal(body, &payload)
+	if err != nil {
+		return nil, body, err
+	}
+	return &payload, body, nil
+}
+
+// VerifyDelivery checks the headers and body of a delivery with the enabled methods
+func (m *WebhookManager) VerifyDelivery(header http.Header, body []byte) error {
+	err := ErrWebhookSignature
+	if m.Verify&WebhookVerifySignature != 0 {
+		tolerance := m.Tolerance
+		if tolerance == 0 {
+			tolerance = DefaultWebhookTolerance
+		}
+		err = VerifyWebhookSignature(m.Secret, header, body, tolerance)
+		if err == nil {
+			return nil
+		}
+	}
+	if m.Verify&WebhookVerifyLegacy != 0 && m.LegacyHeader != "" {
+		got := header.Get(m.LegacyHeader)
+		if got != "" && subtle.ConstantTimeCompare([]byte(got), []byte(m.LegacyValue)) == 1 {
+			return nil
+		}
+	}
+	return err
+}
+
+// VerifyWebhookSignature checks the webhook-signature header, an HMAC-SHA256 of "{webhook-id}.{webhook-timestamp}.{body}",
+// and that the timestamp is within tolerance (0 skips the timestamp check)
+func VerifyWebhookSignature(secret string, header http.Header, body []byte, tolerance time.Duration) error {
+	id := header.Get(WebhookIDHeader)
+	ts := header.Get(WebhookTimestampHeader)
+	signatures := header.Get(WebhookSignatureHeader)
+	if id == "" || ts == "" || signatures == "" {
+		return ErrWebhookSignature
+	}
+	if tolerance > 0 {
+		sec, err := strconv.ParseInt(ts, 10, 64)
+		if err != nil {
+			return ErrWebhookSignature
+		}
+		age := time.Since(time.Unix(sec, 0))
+		if age > tolerance || age < -tolerance {
+			return ErrWebhookSignature
+		}
+	}
+	key := []byte(secret)
+	if strings.HasPrefix(secret, "whsec_") {
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(secret, "whsec_"))
+		if err != nil {
+			return err
+		}
+		key = decoded
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(id + "." + ts + "."))
+	mac.Write(body)
+	expected := mac.Sum(nil)
+	// space separated "v1,<base64>" entries, one per active secret
+	for _, s := range strings.Fields(signatures) {
+		parts := strings.SplitN(s, ",", 2)
+		if len(parts) != 2 || parts[0] != "v1" {
+			continue
+		}
+		sig, err := base64.StdEncoding.DecodeString(parts[1])
+		if err == nil && hmac.Equal(sig, expected) {
+			return nil
+		}
+	}
+	return ErrWebhookSignature
+}
@@ -0,0 +1,89 @@
+package bigcommerce
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// signDelivery returns the signature headers of a delivery signed with key
+func signDelivery(key []byte, id string, at time.Time, body string) http.Header {
+	ts := strconv.FormatInt(at.Unix(), 10)
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(id + "." + ts + "." + body))
+	h := http.Header{}
+	h.Set(WebhookIDHeader, id)
+	h.Set(WebhookTimestampHeader, ts)
+	h.Set(WebhookSignatureHeader, "v1,"+base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	return h
+}
+
+func TestWebhookManagerVerifyDelivery(t *testing.T) {
+	body := `{"scope": "store/order/created", "store_id": "1", "data": {"type": "order", "id": 100}}`
+	secret := "app-secret"
+	whsec := "whsec_" + base64.StdEncoding.EncodeToString([]byte("decoded-key"))
+	now := time.Now()
+	tests := []struct {
+		name    string
+		secret  string
+		header  http.Header
+		body    string
+		verify  int
+		wantErr bool
+	}{
+		{"signed", secret, signDelivery([]byte(secret), "msg_1", now, body), body, WebhookVerifySignature, false},
+		{"whsec key", whsec, signDelivery([]byte("decoded-key"), "msg_1", now, body), body, WebhookVerifySignature, false},
+		{"tampered body", secret, signDelivery([]byte(secret), "msg_1", now, body), strings.Replace(body, "100", "101", 1), WebhookVerifySignature, true},
+		{"wrong secret", secret, signDelivery([]byte("other"), "msg_1", now, body), body, WebhookVerifySignature, true},
+		{"too old", secret, signDelivery([]byte(secret), "msg_1", now.Add(-time.Hour), body), body, WebhookVerifySignature, true},
+		{"from the future", secret, signDelivery([]byte(secret), "msg_1", now.Add(time.Hour), body), body, WebhookVerifySignature, true},
+		{"no headers", secret, http.Header{}, body, WebhookVerifySignature, true},
+		{"legacy header", secret, http.Header{"X-Legacy": {"legacy-value"}}, body, WebhookVerifyLegacy | WebhookVerifySignature, false},
+		{"legacy disabled", secret, http.Header{"X-Legacy": {"legacy-value"}}, body, WebhookVerifySignature, true},
+		{"wrong legacy value", secret, http.Header{"X-Legacy": {"other"}}, body, WebhookVerifyLegacy, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewWebhookManager(nil, "https://example.com/webhooks", tt.secret)
+			m.Verify = tt.verify
+			m.LegacyHeader, m.LegacyValue = "X-Legacy", "legacy-value"
+
+			r := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(tt.body))
+			for k, v := range tt.header {
+				r.Header[k] = v
+			}
+			payload, _, err := m.VerifyRequest(r)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrWebhookSignature) {
+				t.Errorf("got error %v, want ErrWebhookSignature", err)
+			}
+			if err == nil && (payload.Scope != "store/order/created" || payload.Data.ID != 100) {
+				t.Errorf("got payload %+v", payload)
+			}
+		})
+	}
+}
+
+func TestVerifyWebhookSignatureRotatedSecrets(t *testing.T) {
+	body := `{}`
+	h := signDelivery([]byte("new"), "msg_1", time.Now(), body)
+	old := signDelivery([]byte("old"), "msg_1", time.Now(), body)
+	h.Set(WebhookSignatureHeader, old.Get(WebhookSignatureHeader)+" "+h.Get(WebhookSignatureHeader))
+	for _, secret := range []string{"old", "new"} {
+		if err := VerifyWebhookSignature(secret, h, []byte(body), DefaultWebhookTolerance); err != nil {
+			t.Errorf("secret %s: %v", secret, err)
+		}
+	}
+	if err := VerifyWebhookSignature("other", h, []byte(body), 0); !errors.Is(err, ErrWebhookSignature) {
+		t.Errorf("got error %v for an unknown secret, want ErrWebhookSignature", err)
+	}
+}
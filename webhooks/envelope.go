@@ -0,0 +1,168 @@
+// Package webhooks verifies and parses BigCommerce webhook deliveries
+package webhooks
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Envelope is the standard body of a webhook delivery, Data depends on the scope
+type Envelope struct {
+	Scope     string          `json:"scope"`
+	StoreID   string          `json:"store_id"`
+	Data      json.RawMessage `json:"data"`
+	Hash      string          `json:"hash"`
+	CreatedAt int64           `json:"created_at"`
+	// Producer is "stores/{store_hash}"
+	Producer         string `json:"producer"`
+	SignedPayloadJWT string `json:"signed_payload_jwt,omitempty"`
+
+	deliveryID string
+}
+
+// StoreHash returns the hash of the store that sent the delivery
+func (e *Envelope) StoreHash() string {
+	return strings.TrimPrefix(e.Producer, "stores/")
+}
+
+// Time returns when the event happened
+func (e *Envelope) Time() time.Time {
+	return time.Unix(e.CreatedAt, 0)
+}
+
+// DeliveryID identifies the event, redeliveries of the same event have the same ID.
+// For verified envelopes it is the jti of the token
+func (e *Envelope) DeliveryID() string {
+	if e.deliveryID != "" {
+		return e.deliveryID
+	}
+	return e.Scope + "|" + e.Hash + "|" + strconv.FormatInt(e.CreatedAt, 10)
+}
+
+// Resource returns the resource of the scope, e.g. "order" for "store/order/statusUpdated"
+func (e *Envelope) Resource() string {
+	parts := strings.Split(e.Scope, "/")
+	if len(parts) < 3 {
+		return ""
+	}
+	return parts[1]
+}
+
+// Decode decodes the data of the delivery into v
+func (e *Envelope) Decode(v interface{}) error {
+	return json.Unmarshal(e.Data, v)
+}
+
+// ResourceData is the data of most scopes, the type and ID of the changed resource
+type ResourceData struct {
+	Type string `json:"type"`
+	ID   int64  `json:"id"`
+}
+
+// StatusChange is the data of store/order/statusUpdated
+type StatusChange struct {
+	PreviousStatusID int64 `json:"previous_status_id"`
+	NewStatusID      int64 `json:"new_status_id"`
+}
+
+// OrderData is the data of store/order/* scopes
+type OrderData struct {
+	Type    string        `json:"type"`
+	ID      int64         `json:"id"`
+	Status  *StatusChange `json:"status,omitempty"`
+	Message *struct {
+		OrderMessageID int64 `json:"order_message_id"`
+	} `json:"message,omitempty"`
+	Refund *struct {
+		RefundID int64 `json:"refund_id"`
+	} `json:"refund,omitempty"`
+}
+
+// ShipmentData is the data of store/shipment/* scopes
+type ShipmentData struct {
+	Type    string `json:"type"`
+	ID      int64  `json:"id"`
+	OrderID int64  `json:"orderId"`
+}
+
+// CartData is the data of store/cart/* scopes, cart IDs are UUIDs
+type CartData struct {
+	Type     string `json:"type"`
+	ID       string `json:"id"`
+	CartID   string `json:"cartId,omitempty"`
+	OrderID  int64  `json:"orderId,omitempty"`
+	CouponID string `json:"couponId,omitempty"`
+}
+
+// InventoryChange is the inventory part of product and SKU inventory scopes
+type InventoryChange struct {
+	ProductID int64   `json:"product_id"`
+	VariantID int64   `json:"variant_id"`
+	Method    string  `json:"method"`
+	Value     float64 `json:"value"`
+}
+
+// ProductData is the data of store/product/* scopes
+type ProductData struct {
+	Type      string           `json:"type"`
+	ID        int64            `json:"id"`
+	Inventory *InventoryChange `json:"inventory,omitempty"`
+}
+
+// SkuData is the data of store/sku/* scopes
+type SkuData struct {
+	Type string `json:"type"`
+	ID   int64  `json:"id"`
+	Sku  struct {
+		ProductID int64 `json:"product_id"`
+		VariantID int64 `json:"variant_id"`
+	} `json:"sku"`
+	Inventory *InventoryChange `json:"inventory,omitempty"`
+}
+
+// CustomerData is the data of store/customer/* scopes, address scopes set Address
+type CustomerData struct {
+	Type    string `json:"type"`
+	ID      int64  `json:"id"`
+	Address *struct {
+		CustomerID int64 `json:"customer_id"`
+	} `json:"address,omitempty"`
+}
+
+// Order returns the data of an order delivery
+func (e *Envelope) Order() (*OrderData, error) {
+	var d OrderData
+	return &d, e.Decode(&d)
+}
+
+// Shipment returns the data of a shipment delivery
+func (e *Envelope) Shipment() (*ShipmentData, error) {
+	var d ShipmentData
+	return &d, e.Decode(&d)
+}
+
+// Cart returns the data of a cart delivery
+func (e *Envelope) Cart() (*CartData, error) {
+	var d CartData
+	return &d, e.Decode(&d)
+}
+
+// Product returns the data of a product delivery
+func (e *Envelope) Product() (*ProductData, error) {
+	var d ProductData
+	return &d, e.Decode(&d)
+}
+
+// Sku returns the data of a SKU delivery
+func (e *Envelope) Sku() (*SkuData, error) {
+	var d SkuData
+	return &d, e.Decode(&d)
+}
+
+// Customer returns the data of a customer delivery
+func (e *Envelope) Customer() (*CustomerData, error) {
+	var d CustomerData
+	return &d, e.Decode(&d)
+}
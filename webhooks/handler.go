@@ -0,0 +1,278 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Common webhook scopes
+const (
+	ScopeOrderCreated       = "store/order/created"
+	ScopeOrderUpdated       = "store/order/updated"
+	ScopeOrderStatusUpdated = "store/order/statusUpdated"
+	ScopeShipmentCreated    = "store/shipment/created"
+	ScopeShipmentUpdated    = "store/shipment/updated"
+	ScopeProductUpdated     = "store/product/updated"
+	ScopeSkuInventory       = "store/sku/inventory/updated"
+	ScopeCartConverted      = "store/cart/converted"
+	ScopeCustomerCreated    = "store/customer/created"
+)
+
+// HandlerFunc handles a verified delivery
+type HandlerFunc func(ctx context.Context, e *Envelope) error
+
+// ErrorMode is how a Handler answers a delivery a callback failed on
+type ErrorMode int
+
+const (
+	// AckOnError answers 200 and only reports the error, BigCommerce does not redeliver
+	AckOnError ErrorMode = iota
+	// RetryOnError answers 500 so BigCommerce redelivers the event later
+	RetryOnError
+)
+
+// Handler is an http.Handler that verifies deliveries and dispatches them to the callbacks of their scope:
+//
+//	h := webhooks.NewHandler(webhooks.NewVerifier(clientID, clientSecret))
+//	h.OnOrderCreated(func(ctx context.Context, e *webhooks.Envelope, order *webhooks.OrderData) error {
+//		return importOrder(ctx, e.StoreHash(), order.ID)
+//	})
+//	http.Handle("/webhooks", h)
+//
+// Deliveries without callbacks are acknowledged and dropped
+type Handler struct {
+	Verifier *Verifier
+	// ErrorMode is how deliveries are answered when a callback fails, callbacks are not retried in async mode
+	ErrorMode ErrorMode
+	// OnError, when set, gets every rejected delivery and callback error, they are logged otherwise.
+	// e is nil when the delivery could not be parsed
+	OnError func(e *Envelope, err error)
+	// Workers, when more than 0, handles deliveries in that many goroutines and answers them right away
+	Workers int
+	// QueueSize is how many deliveries wait for a worker, more are answered 503 to be redelivered later.
+	// 100 when 0
+	QueueSize int
+
+	mu        sync.RWMutex
+	callbacks map[string][]HandlerFunc
+	start     sync.Once
+	queue     chan *Envelope
+	wg        sync.WaitGroup
+}
+
+// NewHandler returns a Handler verifying deliveries with v
+func NewHandler(v *Verifier) *Handler {
+	return &Handler{Verifier: v, callbacks: map[string][]HandlerFunc{}}
+}
+
+// On registers fn for a scope, like "store/order/created". "store/order/*" matches all order scopes and "*"
+// matches all scopes. Callbacks of the exact scope run first, in the order they were registered
+func (h *Handler) On(scope string, fn HandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.callbacks == nil {
+		h.callbacks = map[string][]HandlerFunc{}
+	}
+	h.callbacks[scope] = append(h.callbacks[scope], fn)
+}
+
+// OnOrderCreated registers fn for store/order/created
+func (h *Handler) OnOrderCreated(fn func(ctx context.Context, e *Envelope, order *OrderData) error) {
+	h.On(ScopeOrderCreated, orderFunc(fn))
+}
+
+// OnOrderUpdated registers fn for store/order/updated
+func (h *Handler) OnOrderUpdated(fn func(ctx context.Context, e *Envelope, order *OrderData) error) {
+	h.On(ScopeOrderUpdated, orderFunc(fn))
+}
+
+// OnOrderStatusUpdated registers fn for store/order/statusUpdated, order.Status has the old and new status
+func (h *Handler) OnOrderStatusUpdated(fn func(ctx context.Context, e *Envelope, order *OrderData) error) {
+	h.On(ScopeOrderStatusUpdated, orderFunc(fn))
+}
+
+// OnShipmentCreated registers fn for store/shipment/created
+func (h *Handler) OnShipmentCreated(fn func(ctx context.Context, e *Envelope, shipment *ShipmentData) error) {
+	h.On(ScopeShipmentCreated, shipmentFunc(fn))
+}
+
+// OnShipmentUpdated registers fn for store/shipment/updated
+func (h *Handler) OnShipmentUpdated(fn func(ctx context.Context, e *Envelope, shipment *ShipmentData) error) {
+	h.On(ScopeShipmentUpdated, shipmentFunc(fn))
+}
+
+// OnProductUpdated registers fn for store/product/updated
+func (h *Handler) OnProductUpdated(fn func(ctx context.Context, e *Envelope, product *ProductData) error) {
+	h.On(ScopeProductUpdated, func(ctx context.Context, e *Envelope) error {
+		d, err := e.Product()
+		if err != nil {
+			return err
+		}
+		return fn(ctx, e, d)
+	})
+}
+
+// OnSkuInventoryUpdated registers fn for store/sku/inventory/updated
+func (h *Handler) OnSkuInventoryUpdated(fn func(ctx context.Context, e *Envelope, sku *SkuData) error) {
+	h.On(ScopeSkuInventory, func(ctx context.Context, e *Envelope) error {
+		d, err := e.Sku()
+		if err != nil {
+			return err
+		}
+		return fn(ctx, e, d)
+	})
+}
+
+// OnCartConverted registers fn for store/cart/converted, cart.OrderID is the created order
+func (h *Handler) OnCartConverted(fn func(ctx context.Context, e *Envelope, cart *CartData) error) {
+	h.On(ScopeCartConverted, func(ctx context.Context, e *Envelope) error {
+		d, err := e.Cart()
+		if err != nil {
+			return err
+		}
+		return fn(ctx, e, d)
+	})
+}
+
+// OnCustomerCreated registers fn for store/customer/created
+func (h *Handler) OnCustomerCreated(fn func(ctx context.Context, e *Envelope, customer *CustomerData) error) {
+	h.On(ScopeCustomerCreated, func(ctx context.Context, e *Envelope) error {
+		d, err := e.Customer()
+		if err != nil {
+			return err
+		}
+		return fn(ctx, e, d)
+	})
+}
+
+func orderFunc(fn func(ctx context.Context, e *Envelope, order *OrderData) error) HandlerFunc {
+	return func(ctx context.Context, e *Envelope) error {
+		d, err := e.Order()
+		if err != nil {
+			return err
+		}
+		return fn(ctx, e, d)
+	}
+}
+
+func shipmentFunc(fn func(ctx context.Context, e *Envelope, shipment *ShipmentData) error) HandlerFunc {
+	return func(ctx context.Context, e *Envelope) error {
+		d, err := e.Shipment()
+		if err != nil {
+			return err
+		}
+		return fn(ctx, e, d)
+	}
+}
+
+// ServeHTTP verifies a delivery and dispatches it. Invalid deliveries are answered 401, duplicate and
+// stale ones 200 so they are not redelivered
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	e, err := h.Verifier.Verify(r)
+	if errors.Is(err, ErrDuplicateDelivery) {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if err != nil {
+		h.reportError(e, err)
+		if errors.Is(err, ErrStaleDelivery) {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if h.Workers > 0 {
+		h.start.Do(h.startWorkers)
+		select {
+		case h.queue <- e:
+			w.WriteHeader(http.StatusOK)
+		default:
+			h.Verifier.Forget(e)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		return
+	}
+	err = h.Dispatch(r.Context(), e)
+	if err != nil {
+		h.reportError(e, err)
+		if h.ErrorMode == RetryOnError {
+			h.Verifier.Forget(e)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// Dispatch calls the callbacks for the scope of e, stopping at the first error
+func (h *Handler) Dispatch(ctx context.Context, e *Envelope) error {
+	for _, fn := range h.callbacksFor(e.Scope) {
+		err := fn(ctx, e)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close stops the workers after the queued deliveries have been handled, call it after the server shut down
+func (h *Handler) Close() {
+	h.start.Do(func() {})
+	if h.queue != nil {
+		close(h.queue)
+		h.wg.Wait()
+	}
+}
+
+func (h *Handler) callbacksFor(scope string) []HandlerFunc {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	fns := append([]HandlerFunc{}, h.callbacks[scope]...)
+	for pattern, patternFns := range h.callbacks {
+		if pattern == "*" || (strings.HasSuffix(pattern, "/*") && strings.HasPrefix(scope, strings.TrimSuffix(pattern, "*"))) {
+			fns = append(fns, patternFns...)
+		}
+	}
+	return fns
+}
+
+func (h *Handler) startWorkers() {
+	size := h.QueueSize
+	if size == 0 {
+		size = 100
+	}
+	h.queue = make(chan *Envelope, size)
+	for i := 0; i < h.Workers; i++ {
+		h.wg.Add(1)
+		go func() {
+			defer h.wg.Done()
+			for e := range h.queue {
+				err := h.Dispatch(context.Background(), e)
+				if err != nil {
+					h.reportError(e, err)
+				}
+			}
+		}()
+	}
+}
+
+func (h *Handler) reportError(e *Envelope, err error) {
+	if h.OnError != nil {
+		h.OnError(e, err)
+		return
+	}
+	if e != nil {
+		log.Printf("webhook %s from %s: %v", e.Scope, e.Producer, err)
+		return
+	}
+	log.Printf("webhook: %v", err)
+}
@@ -0,0 +1,203 @@
+package webhooks
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jwt"
+)
+
+// DefaultTolerance is how old a delivery may be before it is rejected as stale
+const DefaultTolerance = 5 * time.Minute
+
+var (
+	// ErrInvalidSignature is returned when the signed_payload_jwt is missing, not signed with the client
+	// secret, or does not match the delivery
+	ErrInvalidSignature = errors.New("invalid webhook signed payload")
+	// ErrStaleDelivery is returned for deliveries older than the tolerance
+	ErrStaleDelivery = errors.New("stale webhook delivery")
+	// ErrDuplicateDelivery is returned for deliveries that were verified before
+	ErrDuplicateDelivery = errors.New("duplicate webhook delivery")
+)
+
+// DeliveryStore remembers verified deliveries to reject duplicates
+type DeliveryStore interface {
+	// Seen records id until expires and returns true if it was recorded before
+	Seen(id string, expires time.Time) bool
+	// Forget removes id, so a redelivery of a failed delivery is accepted
+	Forget(id string)
+}
+
+// Verifier verifies webhook deliveries of an app:
+//
+//	v := webhooks.NewVerifier(clientID, clientSecret)
+//	http.HandleFunc("/webhooks", func(w http.ResponseWriter, r *http.Request) {
+//		e, err := v.Verify(r)
+//		if errors.Is(err, webhooks.ErrDuplicateDelivery) {
+//			return // already handled, a 200 stops the retries
+//		}
+//		...
+//	})
+type Verifier struct {
+	// ClientID is the expected audience of the token
+	ClientID string
+	// ClientSecret is the key the token is signed with
+	ClientSecret string
+	// Tolerance is the maximum age of a delivery, DefaultTolerance when 0
+	Tolerance time.Duration
+	// Deliveries rejects duplicates, nil accepts them
+	Deliveries DeliveryStore
+}
+
+// NewVerifier returns a Verifier that remembers deliveries in memory
+func NewVerifier(clientID, clientSecret string) *Verifier {
+	return &Verifier{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Deliveries:   NewMemoryDeliveryStore(),
+	}
+}
+
+// Verify reads the body of a delivery, verifies it and returns the envelope. The token is taken from the
+// signed_payload_jwt field of the body, or else from an "Authorization: Bearer" header
+func (v *Verifier) Verify(r *http.Request) (*Envelope, error) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body.Close()
+	return v.VerifyBody(r.Header, body)
+}
+
+// VerifyBody verifies a delivery that has already been read. The token must carry the store as subject,
+// iat, exp, a jti identifying the delivery and a hash claim of the scope and data, see PayloadHash.
+// The returned envelope's producer, hash, time and delivery ID are those of the token
+func (v *Verifier) VerifyBody(header http.Header, body []byte) (*Envelope, error) {
+	var e Envelope
+	err := json.Unmarshal(body, &e)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing webhook delivery: %w", err)
+	}
+	signed := e.SignedPayloadJWT
+	if signed == "" {
+		signed = strings.TrimPrefix(header.Get("Authorization"), "Bearer ")
+	}
+	if signed == "" {
+		return nil, ErrInvalidSignature
+	}
+	tolerance := v.Tolerance
+	if tolerance == 0 {
+		tolerance = DefaultTolerance
+	}
+	opts := []jwt.ParseOption{
+		jwt.WithVerify(jwa.HS256, []byte(v.ClientSecret)),
+		jwt.WithValidate(true),
+		jwt.WithAcceptableSkew(time.Minute),
+		jwt.WithIssuer("bc"),
+		jwt.WithRequiredClaim(jwt.SubjectKey),
+		jwt.WithRequiredClaim(jwt.IssuedAtKey),
+		jwt.WithRequiredClaim(jwt.ExpirationKey),
+		jwt.WithRequiredClaim(jwt.JwtIDKey),
+		jwt.WithRequiredClaim("hash"),
+	}
+	if v.ClientID != "" {
+		opts = append(opts, jwt.WithAudience(v.ClientID))
+	}
+	token, err := jwt.ParseString(signed, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	// only the claims are signed: the token must be for this delivery's store, scope and data,
+	// its age and ID come from the claims rather than the body
+	if e.Producer != "" && token.Subject() != e.Producer {
+		return nil, fmt.Errorf("%w: token is for %s, delivery from %s", ErrInvalidSignature, token.Subject(), e.Producer)
+	}
+	hash, _ := token.Get("hash")
+	if s, ok := hash.(string); !ok || !hmac.Equal([]byte(s), []byte(PayloadHash(e.Scope, e.Data))) {
+		return nil, fmt.Errorf("%w: token hash does not match the delivery", ErrInvalidSignature)
+	}
+	e.Producer = token.Subject()
+	e.Hash = hash.(string)
+	e.CreatedAt = token.IssuedAt().Unix()
+	e.deliveryID = token.JwtID()
+
+	age := time.Since(token.IssuedAt())
+	if age > tolerance || age < -tolerance {
+		return nil, fmt.Errorf("%w: created %s ago", ErrStaleDelivery, age.Round(time.Second))
+	}
+	if v.Deliveries != nil {
+		expires := token.IssuedAt().Add(tolerance)
+		if token.Expiration().After(expires) {
+			expires = token.Expiration()
+		}
+		if v.Deliveries.Seen(e.DeliveryID(), expires) {
+			return &e, ErrDuplicateDelivery
+		}
+	}
+	return &e, nil
+}
+
+// PayloadHash returns the hash the hash claim of a delivery's token must have: the hex SHA-256 of the scope,
+// a newline and the data without insignificant whitespace
+func PayloadHash(scope string, data json.RawMessage) string {
+	var compact bytes.Buffer
+	if json.Compact(&compact, data) != nil {
+		compact.Reset()
+		compact.Write(data)
+	}
+	sum := sha256.Sum256(append([]byte(scope+"\n"), compact.Bytes()...))
+	return hex.EncodeToString(sum[:])
+}
+
+// Forget makes the verifier accept a redelivery of e, call it when handling e failed
+func (v *Verifier) Forget(e *Envelope) {
+	if v.Deliveries != nil {
+		v.Deliveries.Forget(e.DeliveryID())
+	}
+}
+
+// MemoryDeliveryStore is a DeliveryStore for a single process
+type MemoryDeliveryStore struct {
+	mu   sync.Mutex
+	seen map[string]time.Time
+}
+
+// NewMemoryDeliveryStore returns an empty MemoryDeliveryStore
+func NewMemoryDeliveryStore() *MemoryDeliveryStore {
+	return &MemoryDeliveryStore{seen: map[string]time.Time{}}
+}
+
+// Seen records id until expires, dropping expired ids
+func (s *MemoryDeliveryStore) Seen(id string, expires time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	for k, exp := range s.seen {
+		if exp.Before(now) {
+			delete(s.seen, k)
+		}
+	}
+	if _, ok := s.seen[id]; ok {
+		return true
+	}
+	s.seen[id] = expires
+	return false
+}
+
+// Forget removes id
+func (s *MemoryDeliveryStore) Forget(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.seen, id)
+}
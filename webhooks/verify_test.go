@@ -0,0 +1,171 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jwt"
+)
+
+const (
+	testClientID = "client"
+	testSecret   = "secret"
+	testScope    = "store/order/created"
+	testData     = `{"type": "order", "id": 100}`
+)
+
+// signToken returns a token like BigCommerce sends, edit changes the claims before signing
+func signToken(t *testing.T, secret string, edit func(tok jwt.Token)) string {
+	t.Helper()
+	tok := jwt.New()
+	now := time.Now()
+	claims := map[string]interface{}{
+		jwt.IssuerKey:     "bc",
+		jwt.AudienceKey:   testClientID,
+		jwt.SubjectKey:    "stores/abc",
+		jwt.IssuedAtKey:   now,
+		jwt.ExpirationKey: now.Add(5 * time.Minute),
+		jwt.JwtIDKey:      "delivery-1",
+		"hash":            PayloadHash(testScope, json.RawMessage(testData)),
+	}
+	for k, v := range claims {
+		if err := tok.Set(k, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if edit != nil {
+		edit(tok)
+	}
+	signed, err := jwt.Sign(tok, jwa.HS256, []byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(signed)
+}
+
+// delivery returns a delivery body with the token
+func delivery(scope, data, producer string, createdAt int64, token string) []byte {
+	b, _ := json.Marshal(map[string]interface{}{
+		"scope":              scope,
+		"store_id":           "1",
+		"data":               json.RawMessage(data),
+		"hash":               "unsigned",
+		"created_at":         createdAt,
+		"producer":           producer,
+		"signed_payload_jwt": token,
+	})
+	return b
+}
+
+func newTestVerifier() *Verifier {
+	return NewVerifier(testClientID, testSecret)
+}
+
+func TestVerifyBody(t *testing.T) {
+	now := time.Now().Unix()
+	tests := []struct {
+		name string
+		body []byte
+		want error
+	}{
+		{"valid", delivery(testScope, testData, "stores/abc", now, signToken(t, testSecret, nil)), nil},
+		{"whitespace in data", delivery(testScope, `{ "type":"order",  "id":100 }`, "stores/abc", now, signToken(t, testSecret, nil)), nil},
+		{"producer left out", delivery(testScope, testData, "", now, signToken(t, testSecret, nil)), nil},
+		{"no token", delivery(testScope, testData, "stores/abc", now, ""), ErrInvalidSignature},
+		{"wrong secret", delivery(testScope, testData, "stores/abc", now, signToken(t, "other", nil)), ErrInvalidSignature},
+		{"wrong audience", delivery(testScope, testData, "stores/abc", now, signToken(t, testSecret, func(tok jwt.Token) {
+			tok.Set(jwt.AudienceKey, "other")
+		})), ErrInvalidSignature},
+		{"forged data", delivery(testScope, `{"type": "order", "id": 999}`, "stores/abc", now, signToken(t, testSecret, nil)), ErrInvalidSignature},
+		{"forged scope", delivery("store/order/archived", testData, "stores/abc", now, signToken(t, testSecret, nil)), ErrInvalidSignature},
+		{"other store", delivery(testScope, testData, "stores/other", now, signToken(t, testSecret, nil)), ErrInvalidSignature},
+		{"expired", delivery(testScope, testData, "stores/abc", now, signToken(t, testSecret, func(tok jwt.Token) {
+			tok.Set(jwt.IssuedAtKey, time.Now().Add(-time.Hour))
+			tok.Set(jwt.ExpirationKey, time.Now().Add(-50*time.Minute))
+		})), ErrInvalidSignature},
+		{"stale", delivery(testScope, testData, "stores/abc", now, signToken(t, testSecret, func(tok jwt.Token) {
+			tok.Set(jwt.IssuedAtKey, time.Now().Add(-time.Hour))
+		})), ErrStaleDelivery},
+	}
+	for _, claim := range []string{jwt.SubjectKey, jwt.IssuedAtKey, jwt.ExpirationKey, jwt.JwtIDKey, "hash"} {
+		claim := claim
+		tests = append(tests, struct {
+			name string
+			body []byte
+			want error
+		}{"without " + claim, delivery(testScope, testData, "stores/abc", now, signToken(t, testSecret, func(tok jwt.Token) {
+			tok.Remove(claim)
+		})), ErrInvalidSignature})
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := newTestVerifier().VerifyBody(http.Header{}, tt.body)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("got error %v, want %v", err, tt.want)
+			}
+			if err == nil && (e.Producer != "stores/abc" || e.DeliveryID() != "delivery-1") {
+				t.Errorf("got producer %q and delivery ID %q from the body instead of the token", e.Producer, e.DeliveryID())
+			}
+		})
+	}
+}
+
+func TestVerifyBodyUsesSignedTime(t *testing.T) {
+	// a captured token replayed with a fresh created_at is still as old as its iat
+	token := signToken(t, testSecret, func(tok jwt.Token) {
+		tok.Set(jwt.IssuedAtKey, time.Now().Add(-time.Hour))
+	})
+	_, err := newTestVerifier().VerifyBody(http.Header{}, delivery(testScope, testData, "stores/abc", time.Now().Unix(), token))
+	if !errors.Is(err, ErrStaleDelivery) {
+		t.Fatalf("got error %v, want ErrStaleDelivery", err)
+	}
+
+	iat := time.Now().Add(-time.Minute).Truncate(time.Second)
+	token = signToken(t, testSecret, func(tok jwt.Token) {
+		tok.Set(jwt.IssuedAtKey, iat)
+	})
+	e, err := newTestVerifier().VerifyBody(http.Header{}, delivery(testScope, testData, "stores/abc", 0, token))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !e.Time().Equal(iat) {
+		t.Errorf("got time %s, want the token's iat %s", e.Time(), iat)
+	}
+}
+
+func TestVerifyBodyReplay(t *testing.T) {
+	v := newTestVerifier()
+	token := signToken(t, testSecret, nil)
+	_, err := v.VerifyBody(http.Header{}, delivery(testScope, testData, "stores/abc", time.Now().Unix(), token))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the same token with another created_at or hash in the body is the same delivery
+	_, err = v.VerifyBody(http.Header{}, delivery(testScope, testData, "stores/abc", time.Now().Unix()+30, token))
+	if !errors.Is(err, ErrDuplicateDelivery) {
+		t.Fatalf("got error %v, want ErrDuplicateDelivery", err)
+	}
+
+	e, _ := v.VerifyBody(http.Header{}, delivery(testScope, testData, "stores/abc", time.Now().Unix(), token))
+	v.Forget(e)
+	_, err = v.VerifyBody(http.Header{}, delivery(testScope, testData, "stores/abc", time.Now().Unix(), token))
+	if err != nil {
+		t.Fatalf("got error %v after Forget, want nil", err)
+	}
+}
+
+func TestVerifyAuthorizationHeader(t *testing.T) {
+	body := delivery(testScope, testData, "stores/abc", time.Now().Unix(), "")
+	r := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(string(body)))
+	r.Header.Set("Authorization", "Bearer "+signToken(t, testSecret, nil))
+	_, err := newTestVerifier().Verify(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+}